@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_EpochInfos_FullMethodName   = "/evmos.epochs.v1.Query/EpochInfos"
-	Query_CurrentEpoch_FullMethodName = "/evmos.epochs.v1.Query/CurrentEpoch"
+	Query_EpochInfos_FullMethodName     = "/evmos.epochs.v1.Query/EpochInfos"
+	Query_CurrentEpoch_FullMethodName   = "/evmos.epochs.v1.Query/CurrentEpoch"
+	Query_NextEpochStart_FullMethodName = "/evmos.epochs.v1.Query/NextEpochStart"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +32,9 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// NextEpochStart provide the earliest start time of the next epoch of
+	// specified identifier
+	NextEpochStart(ctx context.Context, in *QueryNextEpochStartRequest, opts ...grpc.CallOption) (*QueryNextEpochStartResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +63,15 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) NextEpochStart(ctx context.Context, in *QueryNextEpochStartRequest, opts ...grpc.CallOption) (*QueryNextEpochStartResponse, error) {
+	out := new(QueryNextEpochStartResponse)
+	err := c.cc.Invoke(ctx, Query_NextEpochStart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -67,6 +80,9 @@ type QueryServer interface {
 	EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// NextEpochStart provide the earliest start time of the next epoch of
+	// specified identifier
+	NextEpochStart(context.Context, *QueryNextEpochStartRequest) (*QueryNextEpochStartResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -80,6 +96,9 @@ func (UnimplementedQueryServer) EpochInfos(context.Context, *QueryEpochsInfoRequ
 func (UnimplementedQueryServer) CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
+func (UnimplementedQueryServer) NextEpochStart(context.Context, *QueryNextEpochStartRequest) (*QueryNextEpochStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextEpochStart not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +148,24 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NextEpochStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextEpochStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextEpochStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NextEpochStart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextEpochStart(ctx, req.(*QueryNextEpochStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +181,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
+		{
+			MethodName: "NextEpochStart",
+			Handler:    _Query_NextEpochStart_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/epochs/v1/query.proto",
@@ -693,6 +693,7 @@ var (
 	fd_EventClawback_funder      protoreflect.FieldDescriptor
 	fd_EventClawback_account     protoreflect.FieldDescriptor
 	fd_EventClawback_destination protoreflect.FieldDescriptor
+	fd_EventClawback_coins       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -701,6 +702,7 @@ func init() {
 	fd_EventClawback_funder = md_EventClawback.Fields().ByName("funder")
 	fd_EventClawback_account = md_EventClawback.Fields().ByName("account")
 	fd_EventClawback_destination = md_EventClawback.Fields().ByName("destination")
+	fd_EventClawback_coins = md_EventClawback.Fields().ByName("coins")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventClawback)(nil)
@@ -786,6 +788,12 @@ func (x *fastReflection_EventClawback) Range(f func(protoreflect.FieldDescriptor
 			return
 		}
 	}
+	if x.Coins != "" {
+		value := protoreflect.ValueOfString(x.Coins)
+		if !f(fd_EventClawback_coins, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -807,6 +815,8 @@ func (x *fastReflection_EventClawback) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Account != ""
 	case "evmos.vesting.v1.EventClawback.destination":
 		return x.Destination != ""
+	case "evmos.vesting.v1.EventClawback.coins":
+		return x.Coins != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.vesting.v1.EventClawback"))
@@ -829,6 +839,8 @@ func (x *fastReflection_EventClawback) Clear(fd protoreflect.FieldDescriptor) {
 		x.Account = ""
 	case "evmos.vesting.v1.EventClawback.destination":
 		x.Destination = ""
+	case "evmos.vesting.v1.EventClawback.coins":
+		x.Coins = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.vesting.v1.EventClawback"))
@@ -854,6 +866,9 @@ func (x *fastReflection_EventClawback) Get(descriptor protoreflect.FieldDescript
 	case "evmos.vesting.v1.EventClawback.destination":
 		value := x.Destination
 		return protoreflect.ValueOfString(value)
+	case "evmos.vesting.v1.EventClawback.coins":
+		value := x.Coins
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.vesting.v1.EventClawback"))
@@ -880,6 +895,8 @@ func (x *fastReflection_EventClawback) Set(fd protoreflect.FieldDescriptor, valu
 		x.Account = value.Interface().(string)
 	case "evmos.vesting.v1.EventClawback.destination":
 		x.Destination = value.Interface().(string)
+	case "evmos.vesting.v1.EventClawback.coins":
+		x.Coins = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.vesting.v1.EventClawback"))
@@ -906,6 +923,8 @@ func (x *fastReflection_EventClawback) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field account of message evmos.vesting.v1.EventClawback is not mutable"))
 	case "evmos.vesting.v1.EventClawback.destination":
 		panic(fmt.Errorf("field destination of message evmos.vesting.v1.EventClawback is not mutable"))
+	case "evmos.vesting.v1.EventClawback.coins":
+		panic(fmt.Errorf("field coins of message evmos.vesting.v1.EventClawback is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.vesting.v1.EventClawback"))
@@ -925,6 +944,8 @@ func (x *fastReflection_EventClawback) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfString("")
 	case "evmos.vesting.v1.EventClawback.destination":
 		return protoreflect.ValueOfString("")
+	case "evmos.vesting.v1.EventClawback.coins":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.vesting.v1.EventClawback"))
@@ -1006,6 +1027,10 @@ func (x *fastReflection_EventClawback) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Coins)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1035,6 +1060,13 @@ func (x *fastReflection_EventClawback) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Coins) > 0 {
+			i -= len(x.Coins)
+			copy(dAtA[i:], x.Coins)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Coins)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if len(x.Destination) > 0 {
 			i -= len(x.Destination)
 			copy(dAtA[i:], x.Destination)
@@ -1201,6 +1233,38 @@ func (x *fastReflection_EventClawback) ProtoMethods() *protoiface.Methods {
 				}
 				x.Destination = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Coins = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1784,153 +1848,774 @@ func (x *fastReflection_EventUpdateVestingFunder) ProtoMethods() *protoiface.Met
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: evmos/vesting/v1/events.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_EventAmendVestingSchedule            protoreflect.MessageDescriptor
+	fd_EventAmendVestingSchedule_funder     protoreflect.FieldDescriptor
+	fd_EventAmendVestingSchedule_account    protoreflect.FieldDescriptor
+	fd_EventAmendVestingSchedule_start_time protoreflect.FieldDescriptor
+	fd_EventAmendVestingSchedule_end_time   protoreflect.FieldDescriptor
 )
 
-// EventCreateClawbackVestingAccount defines the event type
-// for creating a clawback vesting account
-type EventCreateClawbackVestingAccount struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_evmos_vesting_v1_events_proto_init()
+	md_EventAmendVestingSchedule = File_evmos_vesting_v1_events_proto.Messages().ByName("EventAmendVestingSchedule")
+	fd_EventAmendVestingSchedule_funder = md_EventAmendVestingSchedule.Fields().ByName("funder")
+	fd_EventAmendVestingSchedule_account = md_EventAmendVestingSchedule.Fields().ByName("account")
+	fd_EventAmendVestingSchedule_start_time = md_EventAmendVestingSchedule.Fields().ByName("start_time")
+	fd_EventAmendVestingSchedule_end_time = md_EventAmendVestingSchedule.Fields().ByName("end_time")
+}
 
-	// sender is the address of the sender
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// coins to be vested
-	Coins string `protobuf:"bytes,2,opt,name=coins,proto3" json:"coins,omitempty"`
-	// start_time is the time when the coins start to vest
-	StartTime string `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	// merge
-	Merge string `protobuf:"bytes,4,opt,name=merge,proto3" json:"merge,omitempty"`
-	// account address of recipient
-	Account string `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+var _ protoreflect.Message = (*fastReflection_EventAmendVestingSchedule)(nil)
+
+type fastReflection_EventAmendVestingSchedule EventAmendVestingSchedule
+
+func (x *EventAmendVestingSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventAmendVestingSchedule)(x)
 }
 
-func (x *EventCreateClawbackVestingAccount) Reset() {
-	*x = EventCreateClawbackVestingAccount{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_evmos_vesting_v1_events_proto_msgTypes[0]
+func (x *EventAmendVestingSchedule) slowProtoReflect() protoreflect.Message {
+	mi := &file_evmos_vesting_v1_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *EventCreateClawbackVestingAccount) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_EventAmendVestingSchedule_messageType fastReflection_EventAmendVestingSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_EventAmendVestingSchedule_messageType{}
 
-func (*EventCreateClawbackVestingAccount) ProtoMessage() {}
+type fastReflection_EventAmendVestingSchedule_messageType struct{}
 
-// Deprecated: Use EventCreateClawbackVestingAccount.ProtoReflect.Descriptor instead.
-func (*EventCreateClawbackVestingAccount) Descriptor() ([]byte, []int) {
-	return file_evmos_vesting_v1_events_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_EventAmendVestingSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventAmendVestingSchedule)(nil)
 }
-
-func (x *EventCreateClawbackVestingAccount) GetSender() string {
-	if x != nil {
-		return x.Sender
-	}
-	return ""
+func (x fastReflection_EventAmendVestingSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventAmendVestingSchedule)
 }
-
-func (x *EventCreateClawbackVestingAccount) GetCoins() string {
-	if x != nil {
-		return x.Coins
-	}
-	return ""
+func (x fastReflection_EventAmendVestingSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventAmendVestingSchedule
 }
 
-func (x *EventCreateClawbackVestingAccount) GetStartTime() string {
-	if x != nil {
-		return x.StartTime
-	}
-	return ""
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventAmendVestingSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventAmendVestingSchedule
 }
 
-func (x *EventCreateClawbackVestingAccount) GetMerge() string {
-	if x != nil {
-		return x.Merge
-	}
-	return ""
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventAmendVestingSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_EventAmendVestingSchedule_messageType
 }
 
-func (x *EventCreateClawbackVestingAccount) GetAccount() string {
-	if x != nil {
-		return x.Account
-	}
-	return ""
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventAmendVestingSchedule) New() protoreflect.Message {
+	return new(fastReflection_EventAmendVestingSchedule)
 }
 
-// EventClawback defines the event type for clawback
-type EventClawback struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// funder is the address of the funder
-	Funder string `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
-	// account is the address of the account
-	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	// destination is the address of the destination
-	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventAmendVestingSchedule) Interface() protoreflect.ProtoMessage {
+	return (*EventAmendVestingSchedule)(x)
 }
 
-func (x *EventClawback) Reset() {
-	*x = EventClawback{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_evmos_vesting_v1_events_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventAmendVestingSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Funder != "" {
+		value := protoreflect.ValueOfString(x.Funder)
+		if !f(fd_EventAmendVestingSchedule_funder, value) {
+			return
+		}
+	}
+	if x.Account != "" {
+		value := protoreflect.ValueOfString(x.Account)
+		if !f(fd_EventAmendVestingSchedule_account, value) {
+			return
+		}
+	}
+	if x.StartTime != "" {
+		value := protoreflect.ValueOfString(x.StartTime)
+		if !f(fd_EventAmendVestingSchedule_start_time, value) {
+			return
+		}
+	}
+	if x.EndTime != "" {
+		value := protoreflect.ValueOfString(x.EndTime)
+		if !f(fd_EventAmendVestingSchedule_end_time, value) {
+			return
+		}
 	}
 }
 
-func (x *EventClawback) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*EventClawback) ProtoMessage() {}
-
-// Deprecated: Use EventClawback.ProtoReflect.Descriptor instead.
-func (*EventClawback) Descriptor() ([]byte, []int) {
-	return file_evmos_vesting_v1_events_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *EventClawback) GetFunder() string {
-	if x != nil {
-		return x.Funder
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventAmendVestingSchedule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "evmos.vesting.v1.EventAmendVestingSchedule.funder":
+		return x.Funder != ""
+	case "evmos.vesting.v1.EventAmendVestingSchedule.account":
+		return x.Account != ""
+	case "evmos.vesting.v1.EventAmendVestingSchedule.start_time":
+		return x.StartTime != ""
+	case "evmos.vesting.v1.EventAmendVestingSchedule.end_time":
+		return x.EndTime != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.vesting.v1.EventAmendVestingSchedule"))
+		}
+		panic(fmt.Errorf("message evmos.vesting.v1.EventAmendVestingSchedule does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-func (x *EventClawback) GetAccount() string {
-	if x != nil {
-		return x.Account
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAmendVestingSchedule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "evmos.vesting.v1.EventAmendVestingSchedule.funder":
+		x.Funder = ""
+	case "evmos.vesting.v1.EventAmendVestingSchedule.account":
+		x.Account = ""
+	case "evmos.vesting.v1.EventAmendVestingSchedule.start_time":
+		x.StartTime = ""
+	case "evmos.vesting.v1.EventAmendVestingSchedule.end_time":
+		x.EndTime = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.vesting.v1.EventAmendVestingSchedule"))
+		}
+		panic(fmt.Errorf("message evmos.vesting.v1.EventAmendVestingSchedule does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-func (x *EventClawback) GetDestination() string {
-	if x != nil {
-		return x.Destination
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventAmendVestingSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "evmos.vesting.v1.EventAmendVestingSchedule.funder":
+		value := x.Funder
+		return protoreflect.ValueOfString(value)
+	case "evmos.vesting.v1.EventAmendVestingSchedule.account":
+		value := x.Account
+		return protoreflect.ValueOfString(value)
+	case "evmos.vesting.v1.EventAmendVestingSchedule.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfString(value)
+	case "evmos.vesting.v1.EventAmendVestingSchedule.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.vesting.v1.EventAmendVestingSchedule"))
+		}
+		panic(fmt.Errorf("message evmos.vesting.v1.EventAmendVestingSchedule does not contain field %s", descriptor.FullName()))
 	}
-	return ""
 }
 
-// EventUpdateVestingFunder defines the event type for updating the vesting funder
-type EventUpdateVestingFunder struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAmendVestingSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "evmos.vesting.v1.EventAmendVestingSchedule.funder":
+		x.Funder = value.Interface().(string)
+	case "evmos.vesting.v1.EventAmendVestingSchedule.account":
+		x.Account = value.Interface().(string)
+	case "evmos.vesting.v1.EventAmendVestingSchedule.start_time":
+		x.StartTime = value.Interface().(string)
+	case "evmos.vesting.v1.EventAmendVestingSchedule.end_time":
+		x.EndTime = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.vesting.v1.EventAmendVestingSchedule"))
+		}
+		panic(fmt.Errorf("message evmos.vesting.v1.EventAmendVestingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAmendVestingSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evmos.vesting.v1.EventAmendVestingSchedule.funder":
+		panic(fmt.Errorf("field funder of message evmos.vesting.v1.EventAmendVestingSchedule is not mutable"))
+	case "evmos.vesting.v1.EventAmendVestingSchedule.account":
+		panic(fmt.Errorf("field account of message evmos.vesting.v1.EventAmendVestingSchedule is not mutable"))
+	case "evmos.vesting.v1.EventAmendVestingSchedule.start_time":
+		panic(fmt.Errorf("field start_time of message evmos.vesting.v1.EventAmendVestingSchedule is not mutable"))
+	case "evmos.vesting.v1.EventAmendVestingSchedule.end_time":
+		panic(fmt.Errorf("field end_time of message evmos.vesting.v1.EventAmendVestingSchedule is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.vesting.v1.EventAmendVestingSchedule"))
+		}
+		panic(fmt.Errorf("message evmos.vesting.v1.EventAmendVestingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventAmendVestingSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "evmos.vesting.v1.EventAmendVestingSchedule.funder":
+		return protoreflect.ValueOfString("")
+	case "evmos.vesting.v1.EventAmendVestingSchedule.account":
+		return protoreflect.ValueOfString("")
+	case "evmos.vesting.v1.EventAmendVestingSchedule.start_time":
+		return protoreflect.ValueOfString("")
+	case "evmos.vesting.v1.EventAmendVestingSchedule.end_time":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: evmos.vesting.v1.EventAmendVestingSchedule"))
+		}
+		panic(fmt.Errorf("message evmos.vesting.v1.EventAmendVestingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventAmendVestingSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in evmos.vesting.v1.EventAmendVestingSchedule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventAmendVestingSchedule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAmendVestingSchedule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventAmendVestingSchedule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventAmendVestingSchedule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventAmendVestingSchedule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Funder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Account)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StartTime)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EndTime)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventAmendVestingSchedule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.EndTime) > 0 {
+			i -= len(x.EndTime)
+			copy(dAtA[i:], x.EndTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EndTime)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.StartTime) > 0 {
+			i -= len(x.StartTime)
+			copy(dAtA[i:], x.StartTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StartTime)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Account) > 0 {
+			i -= len(x.Account)
+			copy(dAtA[i:], x.Account)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Account)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Funder) > 0 {
+			i -= len(x.Funder)
+			copy(dAtA[i:], x.Funder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Funder)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventAmendVestingSchedule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAmendVestingSchedule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAmendVestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Funder = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Account = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StartTime = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EndTime = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: evmos/vesting/v1/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventCreateClawbackVestingAccount defines the event type
+// for creating a clawback vesting account
+type EventCreateClawbackVestingAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sender is the address of the sender
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// coins to be vested
+	Coins string `protobuf:"bytes,2,opt,name=coins,proto3" json:"coins,omitempty"`
+	// start_time is the time when the coins start to vest
+	StartTime string `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// merge
+	Merge string `protobuf:"bytes,4,opt,name=merge,proto3" json:"merge,omitempty"`
+	// account address of recipient
+	Account string `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *EventCreateClawbackVestingAccount) Reset() {
+	*x = EventCreateClawbackVestingAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmos_vesting_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventCreateClawbackVestingAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCreateClawbackVestingAccount) ProtoMessage() {}
+
+// Deprecated: Use EventCreateClawbackVestingAccount.ProtoReflect.Descriptor instead.
+func (*EventCreateClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return file_evmos_vesting_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventCreateClawbackVestingAccount) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *EventCreateClawbackVestingAccount) GetCoins() string {
+	if x != nil {
+		return x.Coins
+	}
+	return ""
+}
+
+func (x *EventCreateClawbackVestingAccount) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *EventCreateClawbackVestingAccount) GetMerge() string {
+	if x != nil {
+		return x.Merge
+	}
+	return ""
+}
+
+func (x *EventCreateClawbackVestingAccount) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+// EventClawback defines the event type for clawback
+type EventClawback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// funder is the address of the funder
+	Funder string `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
+	// account is the address of the account
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// destination is the address of the destination
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	// coins is the amount of unvested coins clawed back
+	Coins string `protobuf:"bytes,4,opt,name=coins,proto3" json:"coins,omitempty"`
+}
+
+func (x *EventClawback) Reset() {
+	*x = EventClawback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmos_vesting_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventClawback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventClawback) ProtoMessage() {}
+
+// Deprecated: Use EventClawback.ProtoReflect.Descriptor instead.
+func (*EventClawback) Descriptor() ([]byte, []int) {
+	return file_evmos_vesting_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventClawback) GetFunder() string {
+	if x != nil {
+		return x.Funder
+	}
+	return ""
+}
+
+func (x *EventClawback) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *EventClawback) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *EventClawback) GetCoins() string {
+	if x != nil {
+		return x.Coins
+	}
+	return ""
+}
+
+// EventUpdateVestingFunder defines the event type for updating the vesting funder
+type EventUpdateVestingFunder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
 	// funder is the address of the funder
 	Funder string `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
@@ -1981,6 +2666,71 @@ func (x *EventUpdateVestingFunder) GetNewFunder() string {
 	return ""
 }
 
+// EventAmendVestingSchedule defines the event type for amending the lockup
+// and vesting schedules of a clawback vesting account
+type EventAmendVestingSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// funder is the address of the funder
+	Funder string `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
+	// account is the address of the account
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// start_time is the time the amended periods are relative to
+	StartTime string `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the new end time of the account
+	EndTime string `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *EventAmendVestingSchedule) Reset() {
+	*x = EventAmendVestingSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evmos_vesting_v1_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventAmendVestingSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventAmendVestingSchedule) ProtoMessage() {}
+
+// Deprecated: Use EventAmendVestingSchedule.ProtoReflect.Descriptor instead.
+func (*EventAmendVestingSchedule) Descriptor() ([]byte, []int) {
+	return file_evmos_vesting_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventAmendVestingSchedule) GetFunder() string {
+	if x != nil {
+		return x.Funder
+	}
+	return ""
+}
+
+func (x *EventAmendVestingSchedule) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *EventAmendVestingSchedule) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *EventAmendVestingSchedule) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
 var File_evmos_vesting_v1_events_proto protoreflect.FileDescriptor
 
 var file_evmos_vesting_v1_events_proto_rawDesc = []byte{
@@ -1997,20 +2747,30 @@ var file_evmos_vesting_v1_events_proto_rawDesc = []byte{
 	0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63,
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x63, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x61,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x79, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6c, 0x61,
 	0x77, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a,
 	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
 	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
 	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x18, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x46,
-	0x75, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x66,
-	0x75, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x77,
-	0x46, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x42, 0xb2, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x69,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x22,
+	0x6b, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x75, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x75, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x46, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x87, 0x01, 0x0a,
+	0x19, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x75,
+	0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x75, 0x6e, 0x64,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x42, 0xb2, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x65,
 	0x76, 0x6d, 0x6f, 0x73, 0x2e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42,
 	0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2b,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
@@ -2037,11 +2797,12 @@ func file_evmos_vesting_v1_events_proto_rawDescGZIP() []byte {
 	return file_evmos_vesting_v1_events_proto_rawDescData
 }
 
-var file_evmos_vesting_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_evmos_vesting_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_evmos_vesting_v1_events_proto_goTypes = []interface{}{
 	(*EventCreateClawbackVestingAccount)(nil), // 0: evmos.vesting.v1.EventCreateClawbackVestingAccount
 	(*EventClawback)(nil),                     // 1: evmos.vesting.v1.EventClawback
 	(*EventUpdateVestingFunder)(nil),          // 2: evmos.vesting.v1.EventUpdateVestingFunder
+	(*EventAmendVestingSchedule)(nil),         // 3: evmos.vesting.v1.EventAmendVestingSchedule
 }
 var file_evmos_vesting_v1_events_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -2093,6 +2854,18 @@ func file_evmos_vesting_v1_events_proto_init() {
 				return nil
 			}
 		}
+		file_evmos_vesting_v1_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventAmendVestingSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2100,7 +2873,7 @@ func file_evmos_vesting_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_evmos_vesting_v1_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -23,6 +23,7 @@ const (
 	Msg_Clawback_FullMethodName                     = "/evmos.vesting.v1.Msg/Clawback"
 	Msg_UpdateVestingFunder_FullMethodName          = "/evmos.vesting.v1.Msg/UpdateVestingFunder"
 	Msg_ConvertVestingAccount_FullMethodName        = "/evmos.vesting.v1.Msg/ConvertVestingAccount"
+	Msg_AmendVestingSchedule_FullMethodName         = "/evmos.vesting.v1.Msg/AmendVestingSchedule"
 )
 
 // MsgClient is the client API for Msg service.
@@ -32,13 +33,17 @@ type MsgClient interface {
 	// CreateClawbackVestingAccount creats a vesting account that is subject to
 	// clawback and the configuration of vesting and lockup schedules.
 	CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error)
-	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
+	// Clawback removes the unvested tokens, or a part of them, from a
+	// ClawbackVestingAccount.
 	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
 	// UpdateVestingFunder updates the funder address of an existing
 	// ClawbackVestingAccount.
 	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to a Eth account
 	ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error)
+	// AmendVestingSchedule replaces the future lockup and/or vesting periods of
+	// an existing ClawbackVestingAccount without modifying the vested amount.
+	AmendVestingSchedule(ctx context.Context, in *MsgAmendVestingSchedule, opts ...grpc.CallOption) (*MsgAmendVestingScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -85,6 +90,15 @@ func (c *msgClient) ConvertVestingAccount(ctx context.Context, in *MsgConvertVes
 	return out, nil
 }
 
+func (c *msgClient) AmendVestingSchedule(ctx context.Context, in *MsgAmendVestingSchedule, opts ...grpc.CallOption) (*MsgAmendVestingScheduleResponse, error) {
+	out := new(MsgAmendVestingScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_AmendVestingSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -92,13 +106,17 @@ type MsgServer interface {
 	// CreateClawbackVestingAccount creats a vesting account that is subject to
 	// clawback and the configuration of vesting and lockup schedules.
 	CreateClawbackVestingAccount(context.Context, *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error)
-	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
+	// Clawback removes the unvested tokens, or a part of them, from a
+	// ClawbackVestingAccount.
 	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
 	// UpdateVestingFunder updates the funder address of an existing
 	// ClawbackVestingAccount.
 	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to a Eth account
 	ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error)
+	// AmendVestingSchedule replaces the future lockup and/or vesting periods of
+	// an existing ClawbackVestingAccount without modifying the vested amount.
+	AmendVestingSchedule(context.Context, *MsgAmendVestingSchedule) (*MsgAmendVestingScheduleResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -118,6 +136,9 @@ func (UnimplementedMsgServer) UpdateVestingFunder(context.Context, *MsgUpdateVes
 func (UnimplementedMsgServer) ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertVestingAccount not implemented")
 }
+func (UnimplementedMsgServer) AmendVestingSchedule(context.Context, *MsgAmendVestingSchedule) (*MsgAmendVestingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendVestingSchedule not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -203,6 +224,24 @@ func _Msg_ConvertVestingAccount_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AmendVestingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendVestingSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendVestingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AmendVestingSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendVestingSchedule(ctx, req.(*MsgAmendVestingSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -226,6 +265,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertVestingAccount",
 			Handler:    _Msg_ConvertVestingAccount_Handler,
 		},
+		{
+			MethodName: "AmendVestingSchedule",
+			Handler:    _Msg_AmendVestingSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/vesting/v1/tx.proto",
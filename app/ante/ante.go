@@ -21,6 +21,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
 )
 
 // NewAnteHandler returns an ante handler responsible for attempting to route an
@@ -41,9 +43,15 @@ func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
 				case "/ethermint.evm.v1.ExtensionOptionsEthereumTx":
 					// handle as *evmtypes.MsgEthereumTx
 					anteHandler = newEVMAnteHandler(options)
+				case evmtypes.BatchTxExtensionOption:
+					// handle as *evmtypes.MsgEthereumTx executed as an atomic batch
+					anteHandler = newEVMBatchAnteHandler(options)
 				case "/ethermint.types.v1.ExtensionOptionsWeb3Tx":
 					// handle as normal Cosmos SDK tx, except signature is checked for EIP712 representation
 					anteHandler = newLegacyCosmosAnteHandlerEip712(options)
+				case "/ethermint.types.v1.ExtensionOptionsEIP712":
+					// handle as normal Cosmos SDK tx, except signature is checked for the versioned EIP712 representation
+					anteHandler = newCosmosAnteHandlerEip712(options)
 				case "/ethermint.types.v1.ExtensionOptionDynamicFeeTx":
 					// cosmos-sdk tx with dynamic fee extension
 					anteHandler = newCosmosAnteHandler(options)
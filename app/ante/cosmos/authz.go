@@ -32,6 +32,9 @@ const maxNestedMsgs = 7
 type AuthzLimiterDecorator struct {
 	// disabledMsgTypes is the type urls of the msgs to block.
 	disabledMsgTypes []string
+	// scopedMsgTypes is the type urls of the msgs that can't be granted through a
+	// GenericAuthorization, as their grants must restrict what the grantee can do.
+	scopedMsgTypes []string
 }
 
 // NewAuthzLimiterDecorator creates a decorator to block certain msg types from being granted or executed within authz.
@@ -41,6 +44,14 @@ func NewAuthzLimiterDecorator(disabledMsgTypes ...string) AuthzLimiterDecorator
 	}
 }
 
+// WithScopedMsgTypes returns a copy of the decorator which also blocks the grants of
+// the given msg types with a GenericAuthorization, so that they can only be granted
+// through their own authorization type, e.g. ContractCallAuthorization.
+func (ald AuthzLimiterDecorator) WithScopedMsgTypes(scopedMsgTypes ...string) AuthzLimiterDecorator {
+	ald.scopedMsgTypes = scopedMsgTypes
+	return ald
+}
+
 func (ald AuthzLimiterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	if err := ald.checkDisabledMsgs(tx.GetMsgs(), false, 1); err != nil {
 		return ctx, errorsmod.Wrapf(errortypes.ErrUnauthorized, err.Error())
@@ -80,6 +91,9 @@ func (ald AuthzLimiterDecorator) checkDisabledMsgs(msgs []sdk.Msg, isAuthzInnerM
 			if ald.isDisabledMsg(url) {
 				return fmt.Errorf("found disabled msg type: %s", url)
 			}
+			if _, ok := authorization.(*authz.GenericAuthorization); ok && isMsgTypeIn(url, ald.scopedMsgTypes) {
+				return fmt.Errorf("msg type %s cannot be granted with a generic authorization", url)
+			}
 		default:
 			url := sdk.MsgTypeURL(msg)
 			if isAuthzInnerMsg && ald.isDisabledMsg(url) {
@@ -93,8 +107,13 @@ func (ald AuthzLimiterDecorator) checkDisabledMsgs(msgs []sdk.Msg, isAuthzInnerM
 // isDisabledMsg returns true if the given message is in the list of restricted
 // messages from the AnteHandler.
 func (ald AuthzLimiterDecorator) isDisabledMsg(msgTypeURL string) bool {
-	for _, disabledType := range ald.disabledMsgTypes {
-		if msgTypeURL == disabledType {
+	return isMsgTypeIn(msgTypeURL, ald.disabledMsgTypes)
+}
+
+// isMsgTypeIn returns true if the msg type url is in the given list.
+func isMsgTypeIn(msgTypeURL string, msgTypes []string) bool {
+	for _, msgType := range msgTypes {
+		if msgTypeURL == msgType {
 			return true
 		}
 	}
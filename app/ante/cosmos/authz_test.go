@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -38,8 +39,16 @@ func TestAuthzLimiterDecorator(t *testing.T) {
 	decorator := cosmosante.NewAuthzLimiterDecorator(
 		sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
 		sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+	).WithScopedMsgTypes(
+		sdk.MsgTypeURL(&evmtypes.MsgCallContract{}),
 	)
 
+	contract := utiltx.GenerateAddress()
+	contractCallAuth := evmtypes.NewContractCallAuthorization(evmtypes.AllowedContractCall{
+		Contract:  contract.Hex(),
+		Selectors: []string{"0xa9059cbb"},
+	})
+
 	testCases := []struct {
 		name        string
 		msgs        []sdk.Msg
@@ -126,6 +135,48 @@ func TestAuthzLimiterDecorator(t *testing.T) {
 			false,
 			sdkerrors.ErrUnauthorized,
 		},
+		{
+			"enabled msg - MsgGrant contains a scoped msg with its own authorization",
+			[]sdk.Msg{
+				newMsgGrant(
+					testAddresses[0],
+					testAddresses[1],
+					contractCallAuth,
+					&distantFuture,
+				),
+			},
+			false,
+			nil,
+		},
+		{
+			"disabled msg - MsgGrant contains a scoped msg with a generic authorization",
+			[]sdk.Msg{
+				newMsgGrant(
+					testAddresses[0],
+					testAddresses[1],
+					authz.NewGenericAuthorization(sdk.MsgTypeURL(&evmtypes.MsgCallContract{})),
+					&distantFuture,
+				),
+			},
+			false,
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"allowed msg - when a MsgExec contains a scoped msg",
+			[]sdk.Msg{
+				newMsgExec(
+					testAddresses[1],
+					[]sdk.Msg{evmtypes.NewMsgCallContract(
+						testAddresses[0],
+						contract,
+						common.FromHex("0xa9059cbb"),
+						math.ZeroInt(),
+						100000,
+					)}),
+			},
+			false,
+			nil,
+		},
 		{
 			"allowed msg - when a MsgExec contains a non blocked msg",
 			[]sdk.Msg{
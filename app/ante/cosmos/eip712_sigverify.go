@@ -0,0 +1,221 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package cosmos
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/secp256k1"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/hetu-project/hetu/v1/crypto/ethsecp256k1"
+	"github.com/hetu-project/hetu/v1/ethereum/eip712"
+	"github.com/hetu-project/hetu/v1/types"
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+// Eip712SigVerificationDecorator verifies the signature of a Cosmos tx signed
+// over its EIP-712 representation, using the encoding version selected by the
+// ExtensionOptionsEIP712 extension option. Note, the decorator will not get
+// executed on ReCheck.
+//
+// CONTRACT: Pubkeys are set in context for all signers before this decorator runs
+// CONTRACT: Tx must implement SigVerifiableTx interface
+type Eip712SigVerificationDecorator struct {
+	ak evmtypes.AccountKeeper
+}
+
+// NewEip712SigVerificationDecorator creates a new Eip712SigVerificationDecorator
+func NewEip712SigVerificationDecorator(ak evmtypes.AccountKeeper) Eip712SigVerificationDecorator {
+	return Eip712SigVerificationDecorator{
+		ak: ak,
+	}
+}
+
+// AnteHandle handles validation of EIP-712 signed cosmos txs.
+// it is not run on RecheckTx
+func (svd Eip712SigVerificationDecorator) AnteHandle(ctx sdk.Context,
+	tx sdk.Tx,
+	simulate bool,
+	next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	// no need to verify signatures on recheck tx
+	if ctx.IsReCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	authSignTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "tx %T doesn't implement the authsigning.Tx interface", tx)
+	}
+
+	sigs, err := authSignTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signerAddrs, err := authSignTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	// EIP712 allows just one signature
+	if len(sigs) != 1 {
+		return ctx, errorsmod.Wrapf(
+			errortypes.ErrTooManySignatures,
+			"invalid number of signers (%d);  EIP712 signatures allows just one signature",
+			len(sigs),
+		)
+	}
+
+	// check that signer length and signature length are the same
+	if len(sigs) != len(signerAddrs) {
+		return ctx, errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "invalid number of signers;  expected: %d, got %d", len(signerAddrs), len(sigs))
+	}
+
+	sig := sigs[0]
+
+	acc, err := authante.GetSignerAcc(ctx, svd.ak, signerAddrs[0])
+	if err != nil {
+		return ctx, err
+	}
+
+	pubKey := acc.GetPubKey()
+	if !simulate && pubKey == nil {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidPubKey, "pubkey on account is not set")
+	}
+
+	if sig.Sequence != acc.GetSequence() {
+		return ctx, errorsmod.Wrapf(
+			errortypes.ErrWrongSequence,
+			"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+		)
+	}
+
+	chainID := ctx.ChainID()
+
+	var accNum uint64
+	if ctx.BlockHeight() != 0 {
+		accNum = acc.GetAccountNumber()
+	}
+
+	signerData := authsigning.SignerData{
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      acc.GetSequence(),
+	}
+
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := VerifyEip712Signature(pubKey, signerData, sig.Data, authSignTx); err != nil {
+		errMsg := fmt.Errorf("signature verification failed; please verify account number (%d) and chain-id (%s): %w", accNum, chainID, err)
+		return ctx, errorsmod.Wrap(errortypes.ErrUnauthorized, errMsg.Error())
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// VerifyEip712Signature verifies the [R||S||V] signature of the EIP-712 typed
+// data of the tx, built with the encoding version of its ExtensionOptionsEIP712.
+// The sign bytes are defined by the extension option, so the sign mode of the
+// signature is not used.
+func VerifyEip712Signature(
+	pubKey cryptotypes.PubKey,
+	signerData authsigning.SignerData,
+	sigData signing.SignatureData,
+	tx authsigning.Tx,
+) error {
+	data, ok := sigData.(*signing.SingleSignatureData)
+	if !ok {
+		return errorsmod.Wrapf(errortypes.ErrTooManySignatures, "unexpected SignatureData %T", sigData)
+	}
+
+	txWithExtensions, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return errorsmod.Wrap(errortypes.ErrUnknownExtensionOptions, "tx doesnt contain any extensions")
+	}
+	opts := txWithExtensions.GetExtensionOptions()
+	if len(opts) != 1 {
+		return errorsmod.Wrap(errortypes.ErrUnknownExtensionOptions, "tx doesnt contain expected amount of extension options")
+	}
+
+	extOpt, ok := opts[0].GetCachedValue().(*types.ExtensionOptionsEIP712)
+	if !ok {
+		return errorsmod.Wrap(errortypes.ErrUnknownExtensionOptions, "unknown extension option")
+	}
+
+	var typedData apitypes.TypedData
+	switch extOpt.Version {
+	case eip712.ProtoEncodingVersion:
+		txData, err := eip712.NewProtoTxData(tx, signerData.ChainID, signerData.AccountNumber, signerData.Sequence)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to get EIP-712 tx data")
+		}
+
+		if typedData, err = eip712.ProtoWrapTxToTypedData(txData); err != nil {
+			return errorsmod.Wrap(err, "failed to create EIP-712 typed data from tx")
+		}
+	default:
+		return errorsmod.Wrapf(errortypes.ErrNotSupported, "unsupported EIP-712 encoding version %d", extOpt.Version)
+	}
+
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return err
+	}
+
+	if len(data.Signature) != ethcrypto.SignatureLength {
+		return errorsmod.Wrap(errortypes.ErrorInvalidSigner, "signature length doesn't match typical [R||S||V] signature 65 bytes")
+	}
+
+	// Remove the recovery offset if needed (ie. Metamask eip712 signature)
+	signature := make([]byte, ethcrypto.SignatureLength)
+	copy(signature, data.Signature)
+	if signature[ethcrypto.RecoveryIDOffset] == 27 || signature[ethcrypto.RecoveryIDOffset] == 28 {
+		signature[ethcrypto.RecoveryIDOffset] -= 27
+	}
+
+	recoveredPubKey, err := secp256k1.RecoverPubkey(sigHash, signature)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to recover signer from sig")
+	}
+
+	ecPubKey, err := ethcrypto.UnmarshalPubkey(recoveredPubKey)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to unmarshal recovered signer pubkey")
+	}
+
+	pk := &ethsecp256k1.PubKey{
+		Key: ethcrypto.CompressPubkey(ecPubKey),
+	}
+
+	if !pubKey.Equals(pk) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "signer pubkey %s is different from transaction pubkey %s", pk, pubKey)
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package cosmos_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	cosmosante "github.com/hetu-project/hetu/v1/app/ante/cosmos"
+	"github.com/hetu-project/hetu/v1/encoding"
+	"github.com/hetu-project/hetu/v1/ethereum/eip712"
+	utiltx "github.com/hetu-project/hetu/v1/testutil/tx"
+	"github.com/hetu-project/hetu/v1/types"
+	"github.com/hetu-project/hetu/v1/utils"
+)
+
+func TestVerifyEip712Signature(t *testing.T) {
+	const chainID = "hetu_560000-1"
+
+	from, priv := utiltx.NewAccAddressAndKey()
+	to := utiltx.GenerateAddress()
+	amount := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, math.NewInt(100)))
+
+	encodingConfig := encoding.MakeConfig()
+	signerData := authsigning.SignerData{ChainID: chainID, AccountNumber: 3, Sequence: 1}
+
+	testCases := []struct {
+		name     string
+		version  uint32
+		malleate func(sig []byte, signerData *authsigning.SignerData)
+		expPass  bool
+	}{
+		{
+			"pass - heterogeneous messages",
+			eip712.ProtoEncodingVersion,
+			func([]byte, *authsigning.SignerData) {},
+			true,
+		},
+		{
+			"fail - unsupported version",
+			eip712.ProtoEncodingVersion + 1,
+			func([]byte, *authsigning.SignerData) {},
+			false,
+		},
+		{
+			"fail - other sequence",
+			eip712.ProtoEncodingVersion,
+			func(_ []byte, signerData *authsigning.SignerData) {
+				signerData.Sequence++
+			},
+			false,
+		},
+		{
+			"fail - other chain",
+			eip712.ProtoEncodingVersion,
+			func(_ []byte, signerData *authsigning.SignerData) {
+				signerData.ChainID = "hetu_560000-2"
+			},
+			false,
+		},
+		{
+			"fail - tampered signature",
+			eip712.ProtoEncodingVersion,
+			func(sig []byte, _ *authsigning.SignerData) {
+				sig[0] ^= 0xff
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder, ok := encodingConfig.TxConfig.NewTxBuilder().(authtx.ExtensionOptionsTxBuilder)
+			require.True(t, ok)
+
+			require.NoError(t, builder.SetMsgs(
+				banktypes.NewMsgSend(from, to.Bytes(), amount),
+				stakingtypes.NewMsgDelegate(from.String(), sdk.ValAddress(to.Bytes()).String(), amount[0]),
+			))
+			builder.SetFeeAmount(amount)
+			builder.SetGasLimit(200000)
+
+			option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionsEIP712{Version: tc.version})
+			require.NoError(t, err)
+			builder.SetExtensionOptions(option)
+
+			txData, err := eip712.NewProtoTxData(builder.GetTx(), signerData.ChainID, signerData.AccountNumber, signerData.Sequence)
+			require.NoError(t, err)
+			typedData, err := eip712.ProtoWrapTxToTypedData(txData)
+			require.NoError(t, err)
+			sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+			require.NoError(t, err)
+
+			ecdsaKey, err := priv.ToECDSA()
+			require.NoError(t, err)
+			sig, err := crypto.Sign(sigHash, ecdsaKey)
+			require.NoError(t, err)
+			sig[crypto.RecoveryIDOffset] += 27
+
+			verifySignerData := signerData
+			tc.malleate(sig, &verifySignerData)
+
+			sigData := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: sig}
+			err = cosmosante.VerifyEip712Signature(priv.PubKey(), verifySignerData, sigData, builder.GetTx())
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package cosmos
+
+import (
+	"context"
+	"fmt"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	errorsmod "cosmossdk.io/errors"
+	txsigning "cosmossdk.io/x/tx/signing"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	evmante "github.com/hetu-project/hetu/v1/app/ante/evm"
+	"github.com/hetu-project/hetu/v1/crypto/webauthn"
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+// secp256r1SignatureSize is the size of a raw secp256r1 signature (r || s)
+const secp256r1SignatureSize = 64
+
+// SigVerificationDecorator verifies all signatures for a tx and returns an error
+// if any are invalid. It extends the SDK SigVerificationDecorator so that
+// secp256r1 (passkey) signers can authorize a tx either with a raw signature
+// over the sign bytes or with a WebAuthn assertion whose challenge is the
+// SHA-256 hash of the sign bytes, signed by a passkey of the relying party set
+// in the EVM params.
+// Note, the SigVerificationDecorator will not check signatures on ReCheck.
+//
+// CONTRACT: Pubkeys are set in context for all signers before this decorator runs
+// CONTRACT: Tx must implement SigVerifiableTx interface
+type SigVerificationDecorator struct {
+	ak              evmtypes.AccountKeeper
+	evmKeeper       evmante.EVMKeeper
+	signModeHandler *txsigning.HandlerMap
+}
+
+// NewSigVerificationDecorator creates a new SigVerificationDecorator
+func NewSigVerificationDecorator(
+	ak evmtypes.AccountKeeper,
+	ek evmante.EVMKeeper,
+	signModeHandler *txsigning.HandlerMap,
+) SigVerificationDecorator {
+	return SigVerificationDecorator{
+		ak:              ak,
+		evmKeeper:       ek,
+		signModeHandler: signModeHandler,
+	}
+}
+
+// AnteHandle handles validation of Cosmos SDK transactions signatures.
+func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "invalid transaction type")
+	}
+
+	// stdSigs contains the sequence number, account number, and signatures.
+	// When simulating, this would just be a 0-length slice.
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	// check that signer length and signature length are the same
+	if len(sigs) != len(signers) {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(sigs))
+	}
+
+	for i, sig := range sigs {
+		acc, err := authante.GetSignerAcc(ctx, svd.ak, signers[i])
+		if err != nil {
+			return ctx, err
+		}
+
+		// retrieve pubkey
+		pubKey := acc.GetPubKey()
+		if !simulate && pubKey == nil {
+			return ctx, errorsmod.Wrap(errortypes.ErrInvalidPubKey, "pubkey on account is not set")
+		}
+
+		// Check account sequence number.
+		if sig.Sequence != acc.GetSequence() {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrWrongSequence,
+				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+			)
+		}
+
+		// no need to verify signatures on simulation or recheck tx
+		if simulate || ctx.IsReCheckTx() || !ctx.IsSigverifyTx() {
+			continue
+		}
+
+		// retrieve signer data
+		genesis := ctx.BlockHeight() == 0
+		chainID := ctx.ChainID()
+		var accNum uint64
+		if !genesis {
+			accNum = acc.GetAccountNumber()
+		}
+
+		anyPk, err := codectypes.NewAnyWithValue(pubKey)
+		if err != nil {
+			return ctx, err
+		}
+
+		signerData := txsigning.SignerData{
+			Address:       acc.GetAddress().String(),
+			ChainID:       chainID,
+			AccountNumber: accNum,
+			Sequence:      acc.GetSequence(),
+			PubKey: &anypb.Any{
+				TypeUrl: anyPk.TypeUrl,
+				Value:   anyPk.Value,
+			},
+		}
+
+		adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+		if !ok {
+			return ctx, fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+		}
+
+		if err := svd.verifySignature(ctx, pubKey, signerData, sig.Data, adaptableTx.GetSigningTxData()); err != nil {
+			errMsg := fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s): (%s)", accNum, chainID, err.Error())
+			return ctx, errorsmod.Wrap(errortypes.ErrUnauthorized, errMsg)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// verifySignature verifies a single signature. WebAuthn assertions of
+// secp256r1 signers are verified against the sign bytes of the tx, while every
+// other signature is verified by the SDK.
+func (svd SigVerificationDecorator) verifySignature(
+	ctx context.Context,
+	pubKey cryptotypes.PubKey,
+	signerData txsigning.SignerData,
+	sigData signing.SignatureData,
+	txData txsigning.TxData,
+) error {
+	passkey, ok := pubKey.(*secp256r1.PubKey)
+	single, isSingle := sigData.(*signing.SingleSignatureData)
+	if !ok || !isSingle || len(single.Signature) == secp256r1SignatureSize {
+		return authsigning.VerifySignature(ctx, pubKey, signerData, sigData, svd.signModeHandler, txData)
+	}
+
+	assertion, err := webauthn.ParseAssertion(single.Signature)
+	if err != nil {
+		return err
+	}
+
+	// the internal and API sign mode enums share the same values
+	signBytes, err := svd.signModeHandler.GetSignBytes(ctx, signingv1beta1.SignMode(single.SignMode), signerData, txData)
+	if err != nil {
+		return err
+	}
+
+	rpID := svd.evmKeeper.GetParams(sdk.UnwrapSDKContext(ctx)).WebAuthnRPID
+	return assertion.Verify(passkey, signBytes, rpID)
+}
@@ -0,0 +1,78 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package evm
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+// EthBatchDecorator validates the messages of an atomic batch of ethereum
+// transactions and marks the context with the batch id, so that the EVM keeper
+// reverts the whole batch if any of the messages fails.
+type EthBatchDecorator struct{}
+
+// NewEthBatchDecorator creates a new EthBatchDecorator
+func NewEthBatchDecorator() EthBatchDecorator {
+	return EthBatchDecorator{}
+}
+
+// AnteHandle checks that the batch has at least two messages signed by the same
+// sender with consecutive nonces. It must run after the signature verification,
+// which populates the sender of the messages.
+func (ebd EthBatchDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) < 2 {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "batch requires at least 2 messages, got %d", len(msgs))
+	}
+
+	var (
+		sender common.Address
+		nonce  uint64
+	)
+	for i, msg := range msgs {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "failed to unpack tx data any for tx %d", i)
+		}
+
+		from := common.BytesToAddress(msgEthTx.GetFrom())
+		if i == 0 {
+			sender, nonce = from, txData.GetNonce()
+			continue
+		}
+
+		if from != sender {
+			return ctx, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "batch tx %d sender %s doesn't match %s", i, from, sender)
+		}
+		// #nosec G701 -- i is bounded by the number of messages
+		if expected := nonce + uint64(i); txData.GetNonce() != expected {
+			return ctx, errorsmod.Wrapf(errortypes.ErrInvalidSequence, "batch tx %d nonce %d, expected %d", i, txData.GetNonce(), expected)
+		}
+	}
+
+	batchID, _ := evmtypes.BatchID(msgs)
+	return next(evmtypes.WithBatchID(ctx, batchID), tx, simulate)
+}
@@ -0,0 +1,104 @@
+package evm_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmante "github.com/hetu-project/hetu/v1/app/ante/evm"
+	"github.com/hetu-project/hetu/v1/testutil"
+	utiltx "github.com/hetu-project/hetu/v1/testutil/tx"
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+func (suite *AnteTestSuite) TestEthBatchDecorator() {
+	dec := evmante.NewEthBatchDecorator()
+	sender := utiltx.GenerateAddress()
+	other := utiltx.GenerateAddress()
+
+	newMsg := func(nonce uint64) *evmtypes.MsgEthereumTx {
+		return evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  suite.app.EvmKeeper.ChainID(),
+			Nonce:    nonce,
+			Amount:   big.NewInt(10),
+			GasLimit: 21000,
+			GasPrice: big.NewInt(1),
+			To:       &other,
+		})
+	}
+
+	buildTx := func(msgs ...*evmtypes.MsgEthereumTx) sdk.Tx {
+		tx, err := evmtypes.BuildBatchTx(suite.clientCtx.TxConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom, msgs...)
+		suite.Require().NoError(err)
+		// the senders are populated by the signature verification
+		for _, msg := range msgs {
+			msg.From = sender.Hex()
+		}
+		return tx
+	}
+
+	testCases := []struct {
+		name    string
+		tx      func() sdk.Tx
+		expPass bool
+	}{
+		{
+			"fail - single message",
+			func() sdk.Tx {
+				msg := newMsg(0)
+				msg.From = sender.Hex()
+				return msg
+			},
+			false,
+		},
+		{
+			"fail - different senders",
+			func() sdk.Tx {
+				msgs := []*evmtypes.MsgEthereumTx{newMsg(0), newMsg(1)}
+				tx := buildTx(msgs...)
+				msgs[1].From = other.Hex()
+				return tx
+			},
+			false,
+		},
+		{
+			"fail - nonce gap",
+			func() sdk.Tx {
+				return buildTx(newMsg(0), newMsg(2))
+			},
+			false,
+		},
+		{
+			"fail - nonce repeated",
+			func() sdk.Tx {
+				return buildTx(newMsg(3), newMsg(4), newMsg(4))
+			},
+			false,
+		},
+		{
+			"success - consecutive nonces",
+			func() sdk.Tx {
+				return buildTx(newMsg(5), newMsg(6), newMsg(7))
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tx := tc.tx()
+			ctx, err := dec.AnteHandle(suite.ctx, tx, false, testutil.NextFn)
+
+			batchID, ok := evmtypes.BatchIDFromContext(ctx)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(ok)
+				expID, _ := evmtypes.BatchID(tx.GetMsgs())
+				suite.Require().Equal(expID, batchID)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(ok)
+			}
+		})
+	}
+}
@@ -84,7 +84,7 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 			return ctx, errorsmod.Wrap(errortypes.ErrInvalidAddress, "from address cannot be empty")
 		}
 
-		// check whether the sender address is EOA
+		// check whether the sender address is EOA, unless it is impersonated on a dev chain
 		fromAddr := common.BytesToAddress(from)
 		acct := avd.evmKeeper.GetAccount(ctx, fromAddr)
 
@@ -92,7 +92,7 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 			acc := avd.ak.NewAccountWithAddress(ctx, from)
 			avd.ak.SetAccount(ctx, acc)
 			acct = statedb.NewEmptyAccount()
-		} else if acct.IsContract() {
+		} else if acct.IsContract() && !avd.isDelegated(ctx, acct) && !avd.evmKeeper.IsImpersonated(fromAddr) {
 			return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType,
 				"the sender is not EOA: address %s, codeHash <%s>", fromAddr, acct.CodeHash)
 		}
@@ -104,6 +104,13 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 	return next(ctx, tx, simulate)
 }
 
+// isDelegated returns true if the code of the account is a delegation designator
+// set by a set code transaction, such accounts are still EOAs.
+func (avd EthAccountVerificationDecorator) isDelegated(ctx sdk.Context, acct *statedb.Account) bool {
+	_, ok := evmtypes.ParseDelegation(avd.evmKeeper.GetCode(ctx, common.BytesToHash(acct.CodeHash)))
+	return ok
+}
+
 // EthGasConsumeDecorator validates enough intrinsic gas for the transaction and
 // gas consumption.
 type EthGasConsumeDecorator struct {
@@ -312,7 +319,7 @@ func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 			BaseFee:     baseFee,
 		}
 
-		coreMsg, err := msgEthTx.AsMessage(signer, baseFee)
+		coreMsg, err := ctd.evmKeeper.AsMessage(msgEthTx, signer, baseFee)
 		if err != nil {
 			return ctx, errorsmod.Wrapf(
 				err,
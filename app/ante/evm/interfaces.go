@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 
@@ -41,6 +42,9 @@ type EVMKeeper interface { //nolint: revive
 	ResetTransientGasUsed(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
 	GetParams(ctx sdk.Context) evmtypes.Params
+	IsImpersonated(addr common.Address) bool
+	ImpersonatedSender(tx *ethtypes.Transaction) (common.Address, bool)
+	AsMessage(msg *evmtypes.MsgEthereumTx, signer ethtypes.Signer, baseFee *big.Int) (core.Message, error)
 }
 
 type FeeMarketKeeper interface {
@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	ethante "github.com/hetu-project/hetu/v1/app/ante/evm"
 	"github.com/hetu-project/hetu/v1/testutil"
@@ -82,3 +83,56 @@ func (suite *AnteTestSuite) TestEthSigVerificationDecorator() {
 	}
 	suite.evmParamsOption = nil
 }
+
+func (suite *AnteTestSuite) TestEthSigVerificationDecoratorSetCodeTx() {
+	addr, privKey := testutiltx.NewAddrKey()
+	to := testutiltx.GenerateAddress()
+
+	testCases := []struct {
+		name    string
+		chainID *big.Int
+		expPass bool
+	}{
+		{"successful signature verification", nil, true},
+		{"invalid, signed for another chain", big.NewInt(560002), false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			chainID := suite.app.EvmKeeper.ChainID()
+			if tc.chainID != nil {
+				chainID = tc.chainID
+			}
+
+			_, authKey := testutiltx.NewAddrKey()
+			key, err := authKey.ToECDSA()
+			suite.Require().NoError(err)
+			auth := evmtypes.NewSetCodeAuthorization(chainID, to, 0)
+			suite.Require().NoError(auth.Sign(key))
+
+			tx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+				ChainID:           chainID,
+				Nonce:             1,
+				To:                &to,
+				Amount:            big.NewInt(10),
+				GasLimit:          100000,
+				GasFeeCap:         big.NewInt(1),
+				GasTipCap:         big.NewInt(1),
+				Accesses:          &ethtypes.AccessList{},
+				AuthorizationList: []evmtypes.SetCodeAuthorization{auth},
+			})
+			tx.From = addr.Hex()
+			suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), testutiltx.NewSigner(privKey)))
+
+			dec := ethante.NewEthSigVerificationDecorator(suite.app.EvmKeeper)
+			_, err = dec.AnteHandle(suite.ctx, tx, false, testutil.NextFn)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(addr.Hex(), tx.From)
+			} else {
+				suite.Require().ErrorIs(err, errortypes.ErrInvalidChainID)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
 )
@@ -64,7 +65,29 @@ func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 				"rejected unprotected Ethereum transaction. Please EIP155 sign your transaction to protect it against replay-attacks")
 		}
 
-		sender, err := signer.Sender(ethTx)
+		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return ctx, errorsmod.Wrap(err, "failed to unpack tx data")
+		}
+
+		// go-ethereum signers don't support set code transactions
+		var sender common.Address
+		if setCodeTx, ok := txData.(*evmtypes.SetCodeTx); ok {
+			// the sender is recovered with the tx chain id, reject the ones
+			// signed for another network to prevent replays
+			if txChainID := setCodeTx.GetChainID(); txChainID == nil || txChainID.Cmp(chainID) != 0 {
+				return ctx, errorsmod.Wrapf(
+					errortypes.ErrInvalidChainID,
+					"invalid chain id for set code transaction; expected %s, got %s", chainID, txChainID,
+				)
+			}
+			sender, err = setCodeTx.Sender()
+		} else if from, ok := esvd.evmKeeper.ImpersonatedSender(ethTx); ok {
+			// the txs of the accounts impersonated by a dev chain aren't signed
+			sender = from
+		} else {
+			sender, err = signer.Sender(ethTx)
+		}
 		if err != nil {
 			return ctx, errorsmod.Wrapf(
 				errortypes.ErrorInvalidSigner,
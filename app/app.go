@@ -144,7 +144,9 @@ import (
 	"github.com/hetu-project/hetu/v1/ethereum/eip712"
 	srvflags "github.com/hetu-project/hetu/v1/server/flags"
 	evmostypes "github.com/hetu-project/hetu/v1/types"
+	"github.com/hetu-project/hetu/v1/versiondb"
 	"github.com/hetu-project/hetu/v1/x/evm"
+	"github.com/hetu-project/hetu/v1/x/evm/fork"
 	evmkeeper "github.com/hetu-project/hetu/v1/x/evm/keeper"
 	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
 	"github.com/hetu-project/hetu/v1/x/feemarket"
@@ -176,8 +178,14 @@ import (
 	"github.com/hetu-project/hetu/v1/x/ibc/transfer"
 	transferkeeper "github.com/hetu-project/hetu/v1/x/ibc/transfer/keeper"
 
+	ics20precompile "github.com/hetu-project/hetu/v1/precompiles/ics20"
+	p256precompile "github.com/hetu-project/hetu/v1/precompiles/p256"
+
 	// memiavlstore "github.com/crypto-org-chain/cronos/store"
 
+	"github.com/ethereum/go-ethereum/core/vm"
+	ethparams "github.com/ethereum/go-ethereum/params"
+
 	// Force-load the tracer engines to trigger registration due to Go-Ethereum v1.10.15 changes
 	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
@@ -334,6 +342,9 @@ type Evmos struct {
 	sm *module.SimulationManager
 
 	tpsCounter *tpsCounter
+
+	// development controls of a chain forked from a remote network
+	forkController *fork.Controller
 }
 
 // SimulationManager implements runtime.AppI
@@ -566,7 +577,14 @@ func NewEvmos(
 		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey], authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper,
 		tracer, app.GetSubspace(evmtypes.ModuleName),
-		nil,
+		[]evmkeeper.CustomContractFn{
+			func(_ sdk.Context, _ ethparams.Rules) vm.PrecompiledContract {
+				return ics20precompile.NewPrecompile(app.TransferKeeper, app.IBCKeeper.ChannelKeeper, app.BankKeeper, app.EvmKeeper)
+			},
+			func(_ sdk.Context, _ ethparams.Rules) vm.PrecompiledContract {
+				return p256precompile.NewPrecompile()
+			},
+		},
 	)
 
 	// Create IBC Keeper
@@ -632,7 +650,10 @@ func NewEvmos(
 		app.AccountKeeper, app.BankKeeper, app.EvmKeeper, app.StakingKeeper,
 	)
 
-	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
+	epochsKeeper := epochskeeper.NewKeeper(
+		appCodec, keys[epochstypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.InflationKeeper,
+	)
 	app.EpochsKeeper = *epochsKeeper.SetHooks(
 		epochskeeper.NewMultiEpochHooks(
 			// insert epoch hooks receivers here
@@ -934,6 +955,22 @@ func NewEvmos(
 	app.MountTransientStores(tkeys)
 	app.MountMemoryStores(memKeys)
 
+	// fork the EVM state of a remote network on a local dev chain if enabled
+	if endpoint := cast.ToString(appOpts.Get(srvflags.ForkFrom)); endpoint != "" {
+		if err := app.setupFork(endpoint, cast.ToInt64(appOpts.Get(srvflags.ForkHeight))); err != nil {
+			tmos.Exit(fmt.Sprintf("failed to setup fork: %s", err))
+		}
+	}
+
+	// serve the historical queries from the versioned state store if enabled
+	var versionDB *versiondb.StreamingService
+	if cast.ToBool(appOpts.Get(srvflags.VersionDBEnable)) {
+		var err error
+		if versionDB, err = app.setupVersionDB(homePath, appOpts, keys, tkeys, memKeys); err != nil {
+			tmos.Exit(fmt.Sprintf("failed to setup versiondb: %s", err))
+		}
+	}
+
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetPreBlocker(app.PreBlocker)
@@ -944,7 +981,7 @@ func NewEvmos(
 	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted)
 	app.setPostHandler()
 	app.SetEndBlocker(app.EndBlocker)
-	app.setupUpgradeHandlers()
+	app.setupUpgradeHandlers(versionDB)
 
 	// At startup, after all modules have been registered, check that all prot
 	// annotations are correct.
@@ -963,6 +1000,12 @@ func NewEvmos(
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		if versionDB != nil {
+			if err := versionDB.Init(app.LastBlockHeight()); err != nil {
+				tmos.Exit(fmt.Sprintf("failed to initialize versiondb: %s", err))
+			}
+		}
 	}
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
@@ -1046,6 +1089,9 @@ func (app *Evmos) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
 // The DeliverTx method is intentionally decomposed to calculate the transactions per second.
 func (app *Evmos) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.ResponseFinalizeBlock, err error) {
 	defer func() {
+		if err != nil {
+			return
+		}
 		// TODO: Record the count along with the code and or reason so as to display
 		// in the transactions per second live dashboards.
 		for _, txRes := range res.TxResults {
@@ -1073,7 +1119,18 @@ func (app *Evmos) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abc
 }
 
 func (app *Evmos) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
-	return app.mm.PreBlock(ctx)
+	res, err := app.mm.PreBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// apply the account overrides requested through the JSON-RPC of a forked chain
+	if app.forkController != nil {
+		if err := app.forkController.ApplyOverrides(ctx, app.EvmKeeper); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
 // LoadHeight loads state at a particular height
@@ -1315,7 +1372,7 @@ func initParamsKeeper(
 	return paramsKeeper
 }
 
-func (app *Evmos) setupUpgradeHandlers() {
+func (app *Evmos) setupUpgradeHandlers(versionDB *versiondb.StreamingService) {
 	// v0 upgrade handler
 	// app.UpgradeKeeper.SetUpgradeHandler(
 	// 	v0.UpgradeName,
@@ -1349,5 +1406,10 @@ func (app *Evmos) setupUpgradeHandlers() {
 	if storeUpgrades != nil {
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, storeUpgrades))
+
+		// the versioned store doesn't see the deleted and renamed stores through the listeners
+		if versionDB != nil {
+			versionDB.SetStoreUpgrades(upgradeInfo.Height, storeUpgrades)
+		}
 	}
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/ibc-go/v8/testing/simapp"
 
@@ -91,14 +92,16 @@ func EthSetupWithDB(isCheckTx bool, patchGenesis func(*Evmos, simapp.GenesisStat
 		}
 
 		// Initialize the chain
-		app.InitChain(
+		if _, err := app.InitChain(
 			&abci.RequestInitChain{
-				ChainId:         "hetu_560000-1",
+				ChainId:         utils.MainnetChainID + "-1",
 				Validators:      []abci.ValidatorUpdate{},
 				ConsensusParams: DefaultConsensusParams,
 				AppStateBytes:   stateBytes,
 			},
-		)
+		); err != nil {
+			panic(err)
+		}
 	}
 
 	return app
@@ -169,6 +172,10 @@ func genesisStateWithValSet(codec codec.Codec, genesisState simapp.GenesisState,
 	stakingGenesis := stakingtypes.NewGenesisState(stakingtypes.DefaultParams(), validators, delegations)
 	genesisState[stakingtypes.ModuleName] = codec.MustMarshalJSON(stakingGenesis)
 
+	// the distribution module is not part of the module basics, set its genesis for
+	// the fee pool and the rewards of the delegations checked by the invariants
+	genesisState[distrtypes.ModuleName] = codec.MustMarshalJSON(distrtypes.DefaultGenesisState())
+
 	totalSupply := sdk.NewCoins()
 	for _, b := range balances {
 		// add genesis acc tokens to total supply
@@ -19,6 +19,7 @@ package app
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
@@ -29,8 +30,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/ibc-go/v8/testing/simapp"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethparams "github.com/ethereum/go-ethereum/params"
 
 	"github.com/hetu-project/hetu/v1/encoding"
+	evmostypes "github.com/hetu-project/hetu/v1/types"
 )
 
 // NewDefaultGenesisState generates the default state for the application.
@@ -80,6 +85,57 @@ func (app *Evmos) ExportAppStateAndValidators(
 	}, nil
 }
 
+// ExportEthGenesis exports the state of the accounts as a geth genesis: the chain config, the
+// block gas limit and base fee, and the balances in the EVM denomination, nonces, code and
+// storage of the accounts.
+func (app *Evmos) ExportEthGenesis(chainID *big.Int) *core.Genesis {
+	ctx := app.NewContextLegacy(true, tmproto.Header{Height: app.LastBlockHeight()})
+	params := app.EvmKeeper.GetParams(ctx)
+
+	alloc := make(core.GenesisAlloc)
+	app.AccountKeeper.IterateAccounts(ctx, func(acc sdk.AccountI) bool {
+		// the accounts with a longer address, like the interchain accounts, aren't reachable from the EVM
+		if len(acc.GetAddress()) != common.AddressLength {
+			return false
+		}
+
+		address := common.BytesToAddress(acc.GetAddress())
+		account := core.GenesisAccount{
+			Balance: app.BankKeeper.GetBalance(ctx, acc.GetAddress(), params.EvmDenom).Amount.BigInt(),
+			Nonce:   acc.GetSequence(),
+		}
+
+		if ethAcc, ok := acc.(evmostypes.EthAccountI); ok {
+			account.Code = app.EvmKeeper.GetCode(ctx, ethAcc.GetCodeHash())
+			app.EvmKeeper.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+				if account.Storage == nil {
+					account.Storage = make(map[common.Hash]common.Hash)
+				}
+				account.Storage[key] = value
+				return true
+			})
+		}
+
+		if account.Balance.Sign() != 0 || account.Nonce != 0 || len(account.Code) != 0 || len(account.Storage) != 0 {
+			alloc[address] = account
+		}
+		return false
+	})
+
+	gasLimit := ethparams.GenesisGasLimit
+	if consensusParams := app.GetConsensusParams(ctx); consensusParams.Block != nil && consensusParams.Block.MaxGas > 0 {
+		gasLimit = uint64(consensusParams.Block.MaxGas)
+	}
+
+	return &core.Genesis{
+		Config:     params.ChainConfig.EthereumConfig(chainID),
+		GasLimit:   gasLimit,
+		Difficulty: new(big.Int),
+		BaseFee:    app.FeeMarketKeeper.GetBaseFee(ctx),
+		Alloc:      alloc,
+	}
+}
+
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 //
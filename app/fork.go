@@ -0,0 +1,42 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package app
+
+import (
+	"github.com/hetu-project/hetu/v1/x/evm/fork"
+)
+
+// setupFork loads the EVM state from the remote node on first read and
+// installs the development controls of the forked chain.
+func (app *Evmos) setupFork(endpoint string, height int64) error {
+	source, err := fork.NewGRPCSource(endpoint, height, app.interfaceRegistry)
+	if err != nil {
+		return err
+	}
+
+	app.EvmKeeper.SetForkSource(source)
+	app.forkController = fork.NewController(source)
+	app.EvmKeeper.SetImpersonationHook(app.forkController.IsImpersonated)
+
+	app.Logger().Info("forking the EVM state from a remote node", "endpoint", endpoint, "height", source.Height())
+	return nil
+}
+
+// ForkController returns the development controls of the chain, nil if it
+// isn't forked from a remote network.
+func (app *Evmos) ForkController() *fork.Controller {
+	return app.forkController
+}
@@ -38,6 +38,7 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/hetu-project/hetu/v1/encoding"
@@ -178,6 +179,10 @@ func GenesisStateWithValSet(app *Evmos, genesisState simapp.GenesisState,
 	stakingGenesis := stakingtypes.NewGenesisState(stakingParams, validators, delegations)
 	genesisState[stakingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(stakingGenesis)
 
+	// the distribution module is not part of the module basics, set its genesis for
+	// the fee pool and the rewards of the delegations checked by the invariants
+	genesisState[distrtypes.ModuleName] = app.AppCodec().MustMarshalJSON(distrtypes.DefaultGenesisState())
+
 	totalSupply := sdk.NewCoins()
 	for _, b := range balances {
 		// add genesis acc tokens to total supply
@@ -0,0 +1,65 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package app
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	"github.com/hetu-project/hetu/v1/versiondb"
+)
+
+// setupVersionDB writes the changes of the persistent stores to the versioned state store
+// on commit, and serves the historical queries from it.
+func (app *Evmos) setupVersionDB(
+	homePath string,
+	appOpts servertypes.AppOptions,
+	keys map[string]*storetypes.KVStoreKey,
+	tkeys map[string]*storetypes.TransientStoreKey,
+	memKeys map[string]*storetypes.MemoryStoreKey,
+) (*versiondb.StreamingService, error) {
+	db, err := versiondb.OpenDB(homePath, sdkserver.GetAppDBBackend(appOpts))
+	if err != nil {
+		return nil, err
+	}
+	store := versiondb.NewStore(db)
+
+	exposedKeys := make([]storetypes.StoreKey, 0, len(keys))
+	for _, key := range keys {
+		exposedKeys = append(exposedKeys, key)
+	}
+	app.CommitMultiStore().AddListeners(exposedKeys)
+
+	service := versiondb.NewStreamingService(store, app.Logger())
+	streamingManager := app.StreamingManager()
+	streamingManager.ABCIListeners = append(streamingManager.ABCIListeners, service)
+	app.SetStreamingManager(streamingManager)
+
+	// the transient and memory stores aren't versioned
+	delegatedKeys := make([]storetypes.StoreKey, 0, len(tkeys)+len(memKeys))
+	for _, key := range tkeys {
+		delegatedKeys = append(delegatedKeys, key)
+	}
+	for _, key := range memKeys {
+		delegatedKeys = append(delegatedKeys, key)
+	}
+
+	app.SetQueryMultiStore(versiondb.NewMultiStore(app.CommitMultiStore(), store, keys, delegatedKeys))
+	return service, nil
+}
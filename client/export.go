@@ -17,6 +17,7 @@ package client
 
 import (
 	"bufio"
+	"crypto/ecdsa"
 	"fmt"
 	"strings"
 
@@ -46,47 +47,12 @@ func UnsafeExportEthKeyCommand() *cobra.Command {
 				return err
 			}
 
-			decryptPassword := ""
-			conf := true
-
-			inBuf := bufio.NewReader(cmd.InOrStdin())
-			switch clientCtx.Keyring.Backend() {
-			case keyring.BackendFile:
-				decryptPassword, err = input.GetPassword(
-					"**WARNING this is an unsafe way to export your unencrypted private key**\nEnter key password:",
-					inBuf)
-			case keyring.BackendOS:
-				conf, err = input.GetConfirmation(
-					"**WARNING** this is an unsafe way to export your unencrypted private key, are you sure?",
-					inBuf, cmd.ErrOrStderr())
-			}
-			if err != nil || !conf {
-				return err
-			}
-
-			// Exports private key from keybase using password
-			armor, err := clientCtx.Keyring.ExportPrivKeyArmor(args[0], decryptPassword)
-			if err != nil {
-				return err
-			}
-
-			privKey, algo, err := crypto.UnarmorDecryptPrivKey(armor, decryptPassword)
-			if err != nil {
-				return err
-			}
-
-			if algo != ethsecp256k1.KeyType {
-				return fmt.Errorf("invalid key algorithm, got %s, expected %s", algo, ethsecp256k1.KeyType)
-			}
-
-			// Converts key to Evmos secp256k1 implementation
-			ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
-			if !ok {
-				return fmt.Errorf("invalid private key type %T, expected %T", privKey, &ethsecp256k1.PrivKey{})
-			}
-
-			key, err := ethPrivKey.ToECDSA()
-			if err != nil {
+			key, err := exportEthPrivKey(
+				cmd, clientCtx, args[0],
+				"**WARNING this is an unsafe way to export your unencrypted private key**\nEnter key password:",
+				"**WARNING** this is an unsafe way to export your unencrypted private key, are you sure?",
+			)
+			if err != nil || key == nil {
 				return err
 			}
 
@@ -100,3 +66,50 @@ func UnsafeExportEthKeyCommand() *cobra.Command {
 		},
 	}
 }
+
+// exportEthPrivKey decrypts and returns the eth_secp256k1 private key with the
+// given name, prompting for the key password on the file backend or for a
+// confirmation on the OS backend. It returns a nil key if not confirmed.
+func exportEthPrivKey(
+	cmd *cobra.Command,
+	clientCtx client.Context,
+	name, passwordPrompt, confirmPrompt string,
+) (*ecdsa.PrivateKey, error) {
+	decryptPassword := ""
+	conf := true
+
+	var err error
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	switch clientCtx.Keyring.Backend() {
+	case keyring.BackendFile:
+		decryptPassword, err = input.GetPassword(passwordPrompt, inBuf)
+	case keyring.BackendOS:
+		conf, err = input.GetConfirmation(confirmPrompt, inBuf, cmd.ErrOrStderr())
+	}
+	if err != nil || !conf {
+		return nil, err
+	}
+
+	// Exports private key from keybase using password
+	armor, err := clientCtx.Keyring.ExportPrivKeyArmor(name, decryptPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, algo, err := crypto.UnarmorDecryptPrivKey(armor, decryptPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	if algo != ethsecp256k1.KeyType {
+		return nil, fmt.Errorf("invalid key algorithm, got %s, expected %s", algo, ethsecp256k1.KeyType)
+	}
+
+	// Converts key to Evmos secp256k1 implementation
+	ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("invalid private key type %T, expected %T", privKey, &ethsecp256k1.PrivKey{})
+	}
+
+	return ethPrivKey.ToECDSA()
+}
@@ -70,6 +70,7 @@ The pass backend requires GnuPG: https://gnupg.org/
 		panic(err)
 	}
 
+	clientkeys.AddDerivationFlags(addCmd)
 	addCmd.RunE = runAddCmd
 
 	cmd.AddCommand(
@@ -83,9 +84,12 @@ The pass backend requires GnuPG: https://gnupg.org/
 		keys.RenameKeyCommand(),
 		keys.ParseKeyStringCommand(),
 		keys.MigrateCommand(),
+		clientkeys.DeriveAddressesCommand(),
 		flags.LineBreak,
 		UnsafeExportEthKeyCommand(),
 		UnsafeImportKeyCommand(),
+		ImportKeystoreCommand(),
+		ExportKeystoreCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
@@ -18,12 +18,15 @@ package keys
 import (
 	"bufio"
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"sort"
 
 	cryptohd "github.com/hetu-project/hetu/v1/crypto/hd"
+	"github.com/hetu-project/hetu/v1/crypto/slip39"
 
 	bip39 "github.com/cosmos/go-bip39"
 	"github.com/spf13/cobra"
@@ -50,6 +53,8 @@ const (
 	flagMultiSigThreshold = "multisig-threshold"
 	flagNoSort            = "nosort"
 	flagHDPath            = "hd-path"
+	flagBIP39Passphrase   = "bip39-passphrase"
+	flagSLIP39            = "slip39"
 
 	mnemonicEntropySize = 256
 )
@@ -59,7 +64,8 @@ RunAddCmd
 input
   - bip39 mnemonic
   - bip39 passphrase
-  - bip44 path
+  - bip44 path or HD path template
+  - SLIP-39 shares, replacing the bip39 mnemonic
   - local encryption password
 
 output
@@ -179,6 +185,8 @@ func RunAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		hdPath = hd.CreateHDPath(coinType, account, index).String()
 	} else if useLedger {
 		return errors.New("cannot set custom bip32 path with ledger")
+	} else if hdPath, err = cryptohd.ResolvePath(hdPath, index); err != nil {
+		return err
 	}
 
 	// If we're using ledger, only thing we need is the path and the bech32 prefix.
@@ -194,6 +202,18 @@ func RunAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		return printCreate(cmd, k, false, "", outputFormat)
 	}
 
+	promptPassphrase, _ := cmd.Flags().GetBool(flagBIP39Passphrase)
+	promptPassphrase = promptPassphrase || interactive
+
+	if useSLIP39, _ := cmd.Flags().GetBool(flagSLIP39); useSLIP39 {
+		k, err := importSLIP39Key(kb, name, hdPath, algo, inBuf, promptPassphrase)
+		if err != nil {
+			return err
+		}
+
+		return printCreate(cmd, k, false, "", outputFormat)
+	}
+
 	// Get bip39 mnemonic
 	var mnemonic, bip39Passphrase string
 
@@ -232,25 +252,11 @@ func RunAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	}
 
 	// override bip39 passphrase
-	if interactive {
-		bip39Passphrase, err = input.GetString(
-			"Enter your bip39 passphrase. This is combined with the mnemonic to derive the seed. "+
-				"Most users should just hit enter to use the default, \"\"", inBuf)
+	if promptPassphrase {
+		bip39Passphrase, err = readPassphrase("bip39", "mnemonic", inBuf)
 		if err != nil {
 			return err
 		}
-
-		// if they use one, make them re-enter it
-		if len(bip39Passphrase) != 0 {
-			p2, err := input.GetString("Repeat the passphrase:", inBuf)
-			if err != nil {
-				return err
-			}
-
-			if bip39Passphrase != p2 {
-				return errors.New("passphrases don't match")
-			}
-		}
 	}
 
 	k, err := kb.NewAccount(name, mnemonic, bip39Passphrase, hdPath, algo)
@@ -268,6 +274,129 @@ func RunAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	return printCreate(cmd, k, showMnemonic, mnemonic, outputFormat)
 }
 
+// readPassphrase prompts for an optional passphrase, which must be entered
+// twice if not empty.
+func readPassphrase(kind, secret string, inBuf *bufio.Reader) (string, error) {
+	passphrase, err := input.GetString(
+		fmt.Sprintf("Enter your %s passphrase. This is combined with the %s to derive the seed. ", kind, secret)+
+			"Most users should just hit enter to use the default, \"\"", inBuf)
+	if err != nil {
+		return "", err
+	}
+
+	// if they use one, make them re-enter it
+	if len(passphrase) != 0 {
+		p2, err := input.GetString("Repeat the passphrase:", inBuf)
+		if err != nil {
+			return "", err
+		}
+
+		if passphrase != p2 {
+			return "", errors.New("passphrases don't match")
+		}
+	}
+
+	return passphrase, nil
+}
+
+// readSLIP39Shares reads SLIP-39 mnemonic shares, one per line, until an empty
+// line or the end of the input.
+func readSLIP39Shares(inBuf *bufio.Reader) ([]string, error) {
+	var shares []string
+	for {
+		share, err := input.GetString(
+			fmt.Sprintf("Enter SLIP-39 share #%d, or hit enter once all shares are entered.", len(shares)+1), inBuf)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+
+		if share == "" {
+			break
+		}
+
+		if _, err := slip39.ParseShare(share); err != nil {
+			return nil, fmt.Errorf("invalid share #%d: %w", len(shares)+1, err)
+		}
+		shares = append(shares, share)
+
+		if err != nil {
+			break
+		}
+	}
+
+	if len(shares) == 0 {
+		return nil, errors.New("no SLIP-39 shares provided")
+	}
+	return shares, nil
+}
+
+// readSLIP39Seed recovers the master secret of SLIP-39 shares read from the
+// input, to be used as the BIP-32 seed.
+func readSLIP39Seed(inBuf *bufio.Reader, promptPassphrase bool) ([]byte, error) {
+	shares, err := readSLIP39Shares(inBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	var passphrase string
+	if promptPassphrase {
+		passphrase, err = readPassphrase("SLIP-39", "shares", inBuf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return slip39.Combine(shares, []byte(passphrase))
+}
+
+// deriveFromSeed derives the private key of the signing algorithm for the
+// BIP-32 seed and HD path.
+func deriveFromSeed(algo keyring.SignatureAlgo, seed []byte, hdPath string) ([]byte, error) {
+	deriver, ok := algo.(cryptohd.SeedDeriver)
+	if !ok {
+		return nil, fmt.Errorf("signing algorithm %s does not support seed derivation", algo.Name())
+	}
+
+	return deriver.DeriveFromSeed()(seed, hdPath)
+}
+
+// importSLIP39Key stores the key derived from the SLIP-39 shares read from the
+// input under the given name.
+func importSLIP39Key(
+	kb keyring.Keyring,
+	name, hdPath string,
+	algo keyring.SignatureAlgo,
+	inBuf *bufio.Reader,
+	promptPassphrase bool,
+) (*keyring.Record, error) {
+	seed, err := readSLIP39Seed(inBuf, promptPassphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, err := deriveFromSeed(algo, seed, hdPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := kb.ImportPrivKeyHex(name, hex.EncodeToString(privKey), string(algo.Name())); err != nil {
+		return nil, err
+	}
+
+	return kb.Key(name)
+}
+
+// AddDerivationFlags registers the HD derivation flags extending the keys add
+// command.
+func AddDerivationFlags(cmd *cobra.Command) {
+	if f := cmd.Flag(flagHDPath); f != nil {
+		f.Usage = "Manual HD path derivation or path template, either bip44, ledger-live, " +
+			"ledger-legacy or a path containing {index} (overrides --coin-type, --account)"
+	}
+	cmd.Flags().Bool(flagBIP39Passphrase, false, "Prompt for a passphrase combined with the mnemonic or SLIP-39 shares")
+	cmd.Flags().Bool(flagSLIP39, false, "Recover the key from SLIP-39 (Shamir) mnemonic shares")
+}
+
 func printCreate(cmd *cobra.Command, k *keyring.Record, showMnemonic bool, mnemonic, outputFormat string) error {
 	switch outputFormat {
 	case OutputFormatText:
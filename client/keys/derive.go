@@ -0,0 +1,167 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keys
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+
+	bip39 "github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cryptohd "github.com/hetu-project/hetu/v1/crypto/hd"
+)
+
+const (
+	flagStart = "start"
+	flagCount = "count"
+)
+
+// DerivedAddress is an address derived from a mnemonic or SLIP-39 shares.
+type DerivedAddress struct {
+	Index   uint32 `json:"index"`
+	Path    string `json:"path"`
+	Address string `json:"address"`
+	Bech32  string `json:"bech32"`
+}
+
+// DeriveAddressesCommand derives a batch of addresses from a mnemonic or
+// SLIP-39 shares, without storing any key.
+func DeriveAddressesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "derive-addresses",
+		Short: "Derive a batch of addresses from a mnemonic or SLIP-39 shares",
+		Long: `Derive the addresses of consecutive account indexes from a bip39 mnemonic, or
+from SLIP-39 shares with --slip39, without storing any key in the keyring.
+
+The HD path is either one of the templates bip44 (m/44'/60'/0'/0/{index}),
+ledger-live (m/44'/60'/{index}'/0/0) and ledger-legacy (m/44'/60'/0'/{index}),
+or a custom path containing the {index} placeholder.
+`,
+		Args: cobra.NoArgs,
+		RunE: runDeriveAddressesCmd,
+	}
+
+	cmd.Flags().String(flagHDPath, "bip44", "HD path template, either bip44, ledger-live, ledger-legacy or a path containing {index}")
+	cmd.Flags().Uint32(flagStart, 0, "First account index to derive")
+	cmd.Flags().Uint32(flagCount, 10, "Number of addresses to derive")
+	cmd.Flags().Bool(flagBIP39Passphrase, false, "Prompt for a passphrase combined with the mnemonic or SLIP-39 shares")
+	cmd.Flags().Bool(flagSLIP39, false, "Derive from SLIP-39 (Shamir) mnemonic shares")
+	cmd.Flags().String(flags.FlagKeyAlgorithm, string(cryptohd.EthSecp256k1Type), "Key signing algorithm to derive the addresses for")
+
+	return cmd
+}
+
+func runDeriveAddressesCmd(cmd *cobra.Command, _ []string) error {
+	clientCtx, err := client.ReadPersistentCommandFlags(client.GetClientContextFromCmd(cmd), cmd.Flags())
+	if err != nil {
+		return err
+	}
+	inBuf := bufio.NewReader(clientCtx.Input)
+
+	template, _ := cmd.Flags().GetString(flagHDPath)
+	start, _ := cmd.Flags().GetUint32(flagStart)
+	count, _ := cmd.Flags().GetUint32(flagCount)
+	promptPassphrase, _ := cmd.Flags().GetBool(flagBIP39Passphrase)
+	useSLIP39, _ := cmd.Flags().GetBool(flagSLIP39)
+	algoStr, _ := cmd.Flags().GetString(flags.FlagKeyAlgorithm)
+
+	algo, err := keyring.NewSigningAlgoFromString(algoStr, cryptohd.SupportedAlgorithms)
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return errors.New("count must be positive")
+	}
+
+	var seed []byte
+	if useSLIP39 {
+		seed, err = readSLIP39Seed(inBuf, promptPassphrase)
+	} else {
+		seed, err = readBIP39Seed(inBuf, promptPassphrase)
+	}
+	if err != nil {
+		return err
+	}
+
+	addresses := make([]DerivedAddress, 0, count)
+	for index := start; index-start < count; index++ {
+		path, err := cryptohd.ResolvePath(template, index)
+		if err != nil {
+			return err
+		}
+
+		privKey, err := deriveFromSeed(algo, seed, path)
+		if err != nil {
+			return err
+		}
+
+		addr := algo.Generate()(privKey).PubKey().Address()
+		addresses = append(addresses, DerivedAddress{
+			Index:   index,
+			Path:    path,
+			Address: common.BytesToAddress(addr).Hex(),
+			Bech32:  sdk.AccAddress(addr).String(),
+		})
+	}
+
+	var out []byte
+	switch clientCtx.OutputFormat {
+	case OutputFormatJSON:
+		out, err = json.Marshal(addresses)
+	default:
+		out, err = yaml.Marshal(addresses)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.Println(string(out))
+	return nil
+}
+
+// readBIP39Seed reads a bip39 mnemonic and returns its BIP-32 seed.
+func readBIP39Seed(inBuf *bufio.Reader, promptPassphrase bool) ([]byte, error) {
+	mnemonic, err := input.GetString("Enter your bip39 mnemonic", inBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, errors.New("invalid mnemonic")
+	}
+
+	var passphrase string
+	if promptPassphrase {
+		passphrase, err = readPassphrase("bip39", "mnemonic", inBuf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+}
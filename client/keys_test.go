@@ -0,0 +1,245 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	clientkeys "github.com/hetu-project/hetu/v1/client/keys"
+	"github.com/hetu-project/hetu/v1/crypto/hd"
+	"github.com/hetu-project/hetu/v1/encoding"
+)
+
+const (
+	testMnemonic = "picnic rent average infant boat squirrel federal assault mercy purity very motor fossil wheel verify upset box fresh horse vivid copy predict square regret"
+	// single SLIP-39 share of master secret bb54aac4b89dc868ba37d9cc21b2cece with passphrase TREZOR
+	testSLIP39Share  = "duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard"
+	testSLIP39Secret = "bb54aac4b89dc868ba37d9cc21b2cece"
+)
+
+// executeKeysCmd runs the keys command with the given arguments and input,
+// returning its output.
+func executeKeysCmd(t *testing.T, home, input string, args ...string) (string, error) {
+	t.Helper()
+
+	clientCtx := client.Context{}.
+		WithCodec(encoding.MakeConfig().Codec).
+		WithInput(strings.NewReader(input))
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	out := new(bytes.Buffer)
+	cmd := KeyCommands(home)
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	cmd.SetArgs(append(args, "--"+flags.FlagHome, home))
+
+	err := cmd.ExecuteContext(ctx)
+	return out.String(), err
+}
+
+// keyAddress returns the address of the key stored in the test keyring.
+func keyAddress(t *testing.T, home, name string) sdk.AccAddress {
+	t.Helper()
+
+	kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, home, nil, encoding.MakeConfig().Codec, hd.EthSecp256k1Option())
+	require.NoError(t, err)
+	record, err := kr.Key(name)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+	return addr
+}
+
+// seedAddress returns the address of the key derived from the BIP-32 seed.
+func seedAddress(t *testing.T, seed []byte, path string) sdk.AccAddress {
+	t.Helper()
+
+	privKey, err := hd.EthSecp256k1.DeriveFromSeed()(seed, path)
+	require.NoError(t, err)
+	return sdk.AccAddress(hd.EthSecp256k1.Generate()(privKey).PubKey().Address())
+}
+
+// mnemonicAddress returns the address of the key derived from the bip39 mnemonic.
+func mnemonicAddress(t *testing.T, passphrase, path string) sdk.AccAddress {
+	t.Helper()
+
+	privKey, err := hd.EthSecp256k1.Derive()(testMnemonic, passphrase, path)
+	require.NoError(t, err)
+	return sdk.AccAddress(hd.EthSecp256k1.Generate()(privKey).PubKey().Address())
+}
+
+func TestAddKeySLIP39(t *testing.T) {
+	secret, err := hex.DecodeString(testSLIP39Secret)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		input   string
+		args    []string
+		expAddr sdk.AccAddress
+		expErr  string
+	}{
+		{
+			"pass - share with passphrase",
+			testSLIP39Share + "\n\nTREZOR\nTREZOR\n",
+			[]string{"--bip39-passphrase"},
+			seedAddress(t, secret, "m/44'/60'/0'/0/0"),
+			"",
+		},
+		{
+			"pass - HD path template",
+			testSLIP39Share + "\n\nTREZOR\nTREZOR\n",
+			[]string{"--bip39-passphrase", "--hd-path", "ledger-live", "--index", "2"},
+			seedAddress(t, secret, "m/44'/60'/2'/0/0"),
+			"",
+		},
+		{
+			"fail - passphrases don't match",
+			testSLIP39Share + "\n\nTREZOR\nTREZOR2\n",
+			[]string{"--bip39-passphrase"},
+			nil,
+			"passphrases don't match",
+		},
+		{
+			"fail - invalid share",
+			strings.Replace(testSLIP39Share, "keyboard", "kidney", 1) + "\n",
+			nil,
+			nil,
+			"invalid share #1",
+		},
+		{
+			"fail - no share",
+			"\n",
+			nil,
+			nil,
+			"no SLIP-39 shares provided",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			home := t.TempDir()
+			args := append([]string{"add", "slip39", "--slip39", "--" + flags.FlagKeyringBackend, keyring.BackendTest}, tc.args...)
+
+			_, err := executeKeysCmd(t, home, tc.input, args...)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expAddr, keyAddress(t, home, "slip39"))
+		})
+	}
+}
+
+func TestAddKeyBIP39Passphrase(t *testing.T) {
+	home := t.TempDir()
+
+	_, err := executeKeysCmd(
+		t, home, testMnemonic+"\npassphrase\npassphrase\n",
+		"add", "bip39", "--recover", "--bip39-passphrase", "--"+flags.FlagKeyringBackend, keyring.BackendTest,
+	)
+	require.NoError(t, err)
+
+	addr := keyAddress(t, home, "bip39")
+	require.Equal(t, mnemonicAddress(t, "passphrase", "m/44'/60'/0'/0/0"), addr)
+	require.NotEqual(t, mnemonicAddress(t, "", "m/44'/60'/0'/0/0"), addr)
+}
+
+func TestDeriveAddresses(t *testing.T) {
+	secret, err := hex.DecodeString(testSLIP39Secret)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		input    string
+		args     []string
+		expPaths []string
+		expAddrs []sdk.AccAddress
+		expErr   string
+	}{
+		{
+			"pass - bip39 mnemonic",
+			testMnemonic + "\n",
+			[]string{"--count", "2"},
+			[]string{"m/44'/60'/0'/0/0", "m/44'/60'/0'/0/1"},
+			[]sdk.AccAddress{
+				mnemonicAddress(t, "", "m/44'/60'/0'/0/0"),
+				mnemonicAddress(t, "", "m/44'/60'/0'/0/1"),
+			},
+			"",
+		},
+		{
+			"pass - bip39 mnemonic with passphrase and ledger-legacy template",
+			testMnemonic + "\npassphrase\npassphrase\n",
+			[]string{"--bip39-passphrase", "--hd-path", "ledger-legacy", "--start", "3", "--count", "1"},
+			[]string{"m/44'/60'/0'/3"},
+			[]sdk.AccAddress{mnemonicAddress(t, "passphrase", "m/44'/60'/0'/3")},
+			"",
+		},
+		{
+			"pass - SLIP-39 share with passphrase",
+			testSLIP39Share + "\n\nTREZOR\nTREZOR\n",
+			[]string{"--slip39", "--bip39-passphrase", "--count", "1"},
+			[]string{"m/44'/60'/0'/0/0"},
+			[]sdk.AccAddress{seedAddress(t, secret, "m/44'/60'/0'/0/0")},
+			"",
+		},
+		{
+			"fail - invalid mnemonic",
+			"foo bar\n",
+			nil,
+			nil,
+			nil,
+			"invalid mnemonic",
+		},
+		{
+			"fail - zero count",
+			testMnemonic + "\n",
+			[]string{"--count", "0"},
+			nil,
+			nil,
+			"count must be positive",
+		},
+		{
+			"fail - invalid template",
+			testMnemonic + "\n",
+			[]string{"--hd-path", "m/44'/60'/foo/{index}"},
+			nil,
+			nil,
+			"invalid component",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args := append([]string{"derive-addresses", "--output", "json"}, tc.args...)
+
+			out, err := executeKeysCmd(t, t.TempDir(), tc.input, args...)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// the output follows the prompts
+			var addresses []clientkeys.DerivedAddress
+			require.NoError(t, json.Unmarshal([]byte(out[strings.Index(out, "["):]), &addresses), out)
+			require.Len(t, addresses, len(tc.expAddrs))
+			for i, derived := range addresses {
+				require.Equal(t, tc.expPaths[i], derived.Path, fmt.Sprintf("address %d", i))
+				require.Equal(t, tc.expAddrs[i].String(), derived.Bech32)
+			}
+		})
+	}
+}
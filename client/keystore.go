@@ -0,0 +1,168 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package client
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto"
+
+	"github.com/hetu-project/hetu/v1/crypto/ethsecp256k1"
+	"github.com/hetu-project/hetu/v1/crypto/hd"
+)
+
+const flagLightKDF = "light-kdf"
+
+// ImportKeystoreCommand imports an encrypted keystore file (Web3 Secret Storage,
+// as written by geth and MetaMask) into the local keybase.
+func ImportKeystoreCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-keystore <name> <keyfile>",
+		Short: "Import an Ethereum keystore file into the local keybase",
+		Long: `Import an encrypted Ethereum keystore file (Web3 Secret Storage v3, scrypt or pbkdf2),
+such as the UTC--<date>--<address> files written by geth, as an eth_secp256k1 key.`,
+		Args: cobra.ExactArgs(2),
+		RunE: runImportKeystoreCmd,
+	}
+}
+
+func runImportKeystoreCmd(cmd *cobra.Command, args []string) error {
+	clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+	clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	keyJSON, err := os.ReadFile(args[1])
+	if err != nil {
+		return err
+	}
+
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	password, err := input.GetPassword("Enter the keystore password:", inBuf)
+	if err != nil {
+		return err
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return err
+	}
+
+	privKey := &ethsecp256k1.PrivKey{
+		Key: ethcrypto.FromECDSA(key.PrivateKey),
+	}
+
+	// the keystore password also encrypts the armor, which is only used for the import
+	armor := crypto.EncryptArmorPrivKey(privKey, password, ethsecp256k1.KeyType)
+	if err := clientCtx.Keyring.ImportPrivKey(args[0], armor, password); err != nil {
+		return err
+	}
+
+	cmd.Println(key.Address.Hex())
+	return nil
+}
+
+// ExportKeystoreCommand exports a key with the given name as an encrypted
+// keystore file (Web3 Secret Storage v3).
+func ExportKeystoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-keystore <name>",
+		Short: "Export an Ethereum private key as an encrypted keystore file",
+		Long: `Export an eth_secp256k1 key as an encrypted Ethereum keystore file (Web3 Secret Storage v3,
+scrypt), which can be imported by geth and MetaMask. The keystore is printed unless --output-document is set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runExportKeystoreCmd,
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The keystore file to write, instead of printing it")
+	cmd.Flags().Bool(flagLightKDF, false, "Use the light scrypt parameters, faster but less secure")
+	return cmd
+}
+
+func runExportKeystoreCmd(cmd *cobra.Command, args []string) error {
+	clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+	clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	privKey, err := exportEthPrivKey(
+		cmd, clientCtx, args[0],
+		"Enter key password:",
+		"Export the private key to an encrypted keystore, are you sure?",
+	)
+	if err != nil || privKey == nil {
+		return err
+	}
+
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	password, err := input.GetPassword("Enter a password to encrypt the keystore:", inBuf)
+	if err != nil {
+		return err
+	}
+
+	repeated, err := input.GetPassword("Repeat the password:", inBuf)
+	if err != nil {
+		return err
+	}
+
+	if password != repeated {
+		return errors.New("passwords don't match")
+	}
+
+	scryptN, scryptP := keystore.StandardScryptN, keystore.StandardScryptP
+	if lightKDF, _ := cmd.Flags().GetBool(flagLightKDF); lightKDF {
+		scryptN, scryptP = keystore.LightScryptN, keystore.LightScryptP
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{
+		Id:         id,
+		Address:    ethcrypto.PubkeyToAddress(privKey.PublicKey),
+		PrivateKey: privKey,
+	}, password, scryptN, scryptP)
+	if err != nil {
+		return err
+	}
+
+	outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+	if outputDoc == "" {
+		cmd.Println(string(keyJSON))
+		return nil
+	}
+
+	if err := os.WriteFile(outputDoc, keyJSON, 0o600); err != nil {
+		return fmt.Errorf("failed to write keystore file: %w", err)
+	}
+	return nil
+}
@@ -1,17 +1,29 @@
 package main_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 
 	"github.com/hetu-project/hetu/v1/app"
 	hetud "github.com/hetu-project/hetu/v1/cmd/hetud"
+	"github.com/hetu-project/hetu/v1/contracts"
 	"github.com/hetu-project/hetu/v1/utils"
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
 )
 
 func TestInitCmd(t *testing.T) {
@@ -39,3 +51,134 @@ func TestAddKeyLedgerCmd(t *testing.T) {
 	err := svrcmd.Execute(rootCmd, "HETUD", app.DefaultNodeHome)
 	require.Error(t, err)
 }
+
+func TestImportEthAllocCmd(t *testing.T) {
+	home := t.TempDir()
+
+	rootCmd, _ := hetud.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"init",
+		"hetu-test",
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, utils.TestnetChainID+"-1"),
+	})
+	require.NoError(t, svrcmd.Execute(rootCmd, "hetud", home))
+
+	allocFile := filepath.Join(home, "geth-genesis.json")
+	require.NoError(t, os.WriteFile(allocFile, []byte(`{
+		"config": {"chainId": 1},
+		"alloc": {
+			"0x1000000000000000000000000000000000000001": {"balance": "0x64", "nonce": "0x2"},
+			"0x2000000000000000000000000000000000000002": {
+				"balance": "0",
+				"code": "0x6000",
+				"storage": {"0x01": "0x02"}
+			}
+		}
+	}`), 0o600))
+
+	rootCmd, _ = hetud.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"genesis",
+		"import-eth-alloc",
+		allocFile,
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+	})
+	require.NoError(t, svrcmd.Execute(rootCmd, "hetud", home))
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+
+	var evmGenState evmtypes.GenesisState
+	require.NoError(t, json.Unmarshal(appState[evmtypes.ModuleName], &evmGenState))
+	require.Len(t, evmGenState.Accounts, 1)
+	require.Equal(t, "0x2000000000000000000000000000000000000002", evmGenState.Accounts[0].Address)
+	require.Equal(t, "6000", evmGenState.Accounts[0].Code)
+	require.Len(t, evmGenState.Accounts[0].Storage, 1)
+
+	for module, expState := range map[string]string{
+		banktypes.ModuleName: `"amount":"100"`,
+		authtypes.ModuleName: `"sequence":"2"`,
+	} {
+		var state bytes.Buffer
+		require.NoError(t, json.Compact(&state, appState[module]))
+		require.Contains(t, state.String(), expState)
+	}
+
+	// the accounts can't be imported twice
+	rootCmd, _ = hetud.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"genesis",
+		"import-eth-alloc",
+		allocFile,
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+	})
+	require.Error(t, svrcmd.Execute(rootCmd, "hetud", home))
+}
+
+func TestAddGenesisEntryPointCmd(t *testing.T) {
+	home := t.TempDir()
+
+	rootCmd, _ := hetud.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"init",
+		"hetu-test",
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, utils.TestnetChainID+"-1"),
+	})
+	require.NoError(t, svrcmd.Execute(rootCmd, "hetud", home))
+
+	// the constructor creates a child contract with the 0x2a runtime bytecode, sets the
+	// slot 1 to 7 and returns the child address as runtime bytecode, as an immutable
+	childInitCode := "602a60005360016000f3"
+	initCode := "69" + childInitCode + "600052" + // MSTORE(0, childInitCode)
+		"600a60166000f0" + // CREATE(0, 22, 10)
+		"6007600155" + // SSTORE(1, 7)
+		"600052" + // MSTORE(0, child)
+		"60206000f3" // RETURN(0, 32)
+	artifactFile := filepath.Join(home, "EntryPoint.json")
+	require.NoError(t, os.WriteFile(artifactFile, []byte(fmt.Sprintf(`{"bytecode": {"object": "0x%s"}}`, initCode)), 0o600))
+
+	rootCmd, _ = hetud.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"add-genesis-entrypoint",
+		artifactFile,
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+	})
+	require.NoError(t, svrcmd.Execute(rootCmd, "hetud", home))
+
+	appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+
+	var evmGenState evmtypes.GenesisState
+	require.NoError(t, json.Unmarshal(appState[evmtypes.ModuleName], &evmGenState))
+	require.Len(t, evmGenState.Accounts, 2)
+
+	accounts := make(map[common.Address]evmtypes.GenesisAccount)
+	for _, account := range evmGenState.Accounts {
+		accounts[common.HexToAddress(account.Address)] = account
+	}
+
+	child := crypto.CreateAddress(contracts.EntryPointAddress, 1)
+	entryPoint, found := accounts[contracts.EntryPointAddress]
+	require.True(t, found)
+	require.Equal(t, common.Bytes2Hex(common.LeftPadBytes(child.Bytes(), 32)), entryPoint.Code)
+	require.Equal(t, evmtypes.Storage{
+		evmtypes.NewState(common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(7))),
+	}, entryPoint.Storage)
+	require.Equal(t, "2a", accounts[child].Code)
+
+	// the EntryPoint nonce counts the created contract
+	var state bytes.Buffer
+	require.NoError(t, json.Compact(&state, appState[authtypes.ModuleName]))
+	require.Contains(t, state.String(), `"sequence":"2"`)
+
+	// the contracts can't be added twice
+	rootCmd, _ = hetud.NewRootCmd()
+	rootCmd.SetArgs([]string{
+		"add-genesis-entrypoint",
+		artifactFile,
+		fmt.Sprintf("--%s=%s", flags.FlagHome, home),
+	})
+	require.Error(t, svrcmd.Execute(rootCmd, "hetud", home))
+}
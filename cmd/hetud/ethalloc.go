@@ -0,0 +1,292 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/hetu-project/hetu/v1/types"
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+const flagEthAlloc = "eth-alloc"
+
+// NewGenesisCmd returns the genesis file subcommands.
+func NewGenesisCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "genesis",
+		Short: "Genesis file subcommands",
+	}
+
+	cmd.AddCommand(ImportEthAllocCmd(defaultNodeHome))
+	return cmd
+}
+
+// ImportEthAllocCmd returns the import-eth-alloc cobra Command.
+func ImportEthAllocCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-eth-alloc GETH_GENESIS_FILE",
+		Short: "Import the accounts of a geth genesis alloc into genesis.json",
+		Long: `Import the accounts of the alloc section of a geth genesis.json, or of a file holding the
+alloc only, into genesis.json. Each account becomes an EthAccount with its nonce, its balance
+is set in the EVM denomination and added to the supply, and its code and storage are set in
+the EVM genesis accounts.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			alloc, err := readEthAlloc(args[0])
+			if err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			var evmGenState evmtypes.GenesisState
+			if err := clientCtx.Codec.UnmarshalJSON(appState[evmtypes.ModuleName], &evmGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal evm genesis state: %w", err)
+			}
+
+			authGenState := authtypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+			if err != nil {
+				return fmt.Errorf("failed to get accounts from any: %w", err)
+			}
+
+			bankGenState := banktypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+
+			genAccs, balances, evmGenAccounts := convertEthAlloc(alloc, evmGenState.Params.EvmDenom)
+			for _, genAcc := range genAccs {
+				if accs.Contains(genAcc.GetAddress()) {
+					return fmt.Errorf("cannot import the account at existing address %s", common.BytesToAddress(genAcc.GetAddress()))
+				}
+				accs = append(accs, genAcc)
+			}
+
+			for _, balance := range balances {
+				bankGenState.Balances = append(bankGenState.Balances, balance)
+				// an empty supply is computed from the balances at genesis
+				if !bankGenState.Supply.Empty() {
+					bankGenState.Supply = bankGenState.Supply.Add(balance.Coins...)
+				}
+			}
+			bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+
+			evmGenState.Accounts = append(evmGenState.Accounts, evmGenAccounts...)
+			if err := evmGenState.Validate(); err != nil {
+				return fmt.Errorf("failed to validate evm genesis state: %w", err)
+			}
+
+			accs = authtypes.SanitizeGenesisAccounts(accs)
+			packedAccs, err := authtypes.PackAccounts(accs)
+			if err != nil {
+				return fmt.Errorf("failed to convert accounts into any's: %w", err)
+			}
+			authGenState.Accounts = packedAccs
+
+			authGenStateBz, err := clientCtx.Codec.MarshalJSON(&authGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+			}
+			appState[authtypes.ModuleName] = authGenStateBz
+
+			bankGenStateBz, err := clientCtx.Codec.MarshalJSON(bankGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+			}
+			appState[banktypes.ModuleName] = bankGenStateBz
+
+			evmGenStateBz, err := clientCtx.Codec.MarshalJSON(&evmGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal evm genesis state: %w", err)
+			}
+			appState[evmtypes.ModuleName] = evmGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// readEthAlloc reads the alloc of a geth genesis file, or a file holding the alloc only.
+func readEthAlloc(path string) (core.GenesisAlloc, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var genesis struct {
+		Alloc core.GenesisAlloc `json:"alloc"`
+	}
+	if err := json.Unmarshal(bz, &genesis); err == nil && genesis.Alloc != nil {
+		return genesis.Alloc, nil
+	}
+
+	var alloc core.GenesisAlloc
+	if err := json.Unmarshal(bz, &alloc); err != nil {
+		return nil, fmt.Errorf("invalid geth genesis alloc in %s: %w", path, err)
+	}
+	return alloc, nil
+}
+
+// convertEthAlloc converts the accounts of a geth genesis alloc, sorted by address, into the
+// auth accounts, the balances in the EVM denomination and the EVM genesis accounts.
+func convertEthAlloc(
+	alloc core.GenesisAlloc, evmDenom string,
+) ([]authtypes.GenesisAccount, []banktypes.Balance, []evmtypes.GenesisAccount) {
+	addresses := make([]common.Address, 0, len(alloc))
+	for address := range alloc {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	var (
+		genAccs        []authtypes.GenesisAccount
+		balances       []banktypes.Balance
+		evmGenAccounts []evmtypes.GenesisAccount
+	)
+	for _, address := range addresses {
+		account := alloc[address]
+		addr := sdk.AccAddress(address.Bytes())
+
+		genAccs = append(genAccs, &types.EthAccount{
+			BaseAccount: authtypes.NewBaseAccount(addr, nil, 0, account.Nonce),
+			CodeHash:    crypto.Keccak256Hash(account.Code).Hex(),
+		})
+
+		if account.Balance != nil && account.Balance.Sign() > 0 {
+			balances = append(balances, banktypes.Balance{
+				Address: addr.String(),
+				Coins:   sdk.NewCoins(sdk.NewCoin(evmDenom, sdkmath.NewIntFromBigInt(account.Balance))),
+			})
+		}
+
+		if len(account.Code) == 0 && len(account.Storage) == 0 {
+			continue
+		}
+
+		storage := make(evmtypes.Storage, 0, len(account.Storage))
+		for key, value := range account.Storage {
+			storage = append(storage, evmtypes.NewState(key, value))
+		}
+		sort.Slice(storage, func(i, j int) bool {
+			return storage[i].Key < storage[j].Key
+		})
+
+		evmGenAccounts = append(evmGenAccounts, evmtypes.GenesisAccount{
+			Address: address.Hex(),
+			Code:    common.Bytes2Hex(account.Code),
+			Storage: storage,
+		})
+	}
+
+	return genAccs, balances, evmGenAccounts
+}
+
+// addEthAllocExport adds the --eth-alloc flag to the export command, exporting the state as
+// a geth genesis instead.
+func addEthAllocExport(exportCmd *cobra.Command, a appCreator) {
+	runE := exportCmd.RunE
+	exportCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if ethAlloc, _ := cmd.Flags().GetBool(flagEthAlloc); !ethAlloc {
+			return runE(cmd, args)
+		}
+
+		serverCtx := server.GetServerContextFromCmd(cmd)
+		config := serverCtx.Config
+
+		homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+		config.SetRoot(homeDir)
+
+		appGenesis, err := genutiltypes.AppGenesisFromFile(config.GenesisFile())
+		if err != nil {
+			return err
+		}
+		chainID, err := types.ParseChainID(appGenesis.ChainID)
+		if err != nil {
+			return err
+		}
+
+		db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(config.RootDir, "data"))
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+		evmosApp, err := a.loadApp(serverCtx.Logger, db, nil, height, serverCtx.Viper)
+		if err != nil {
+			return fmt.Errorf("error exporting state: %w", err)
+		}
+
+		out, err := json.MarshalIndent(evmosApp.ExportEthGenesis(chainID), "", "  ")
+		if err != nil {
+			return err
+		}
+
+		outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+		if outputDocument == "" {
+			_, err := cmd.OutOrStdout().Write(out)
+			return err
+		}
+		return os.WriteFile(outputDocument, out, 0o600)
+	}
+
+	exportCmd.Flags().Bool(flagEthAlloc, false, "Export the state as a geth genesis, with the accounts in its alloc")
+}
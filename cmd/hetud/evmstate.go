@@ -0,0 +1,182 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hetu-project/hetu/v1/app"
+	evmkeeper "github.com/hetu-project/hetu/v1/x/evm/keeper"
+)
+
+const (
+	flagStateHeight = "height"
+	flagStateTop    = "top"
+)
+
+// stateAnalysis is the summary of the analysis of the EVM store.
+type stateAnalysis struct {
+	Height            int64                    `json:"height"`
+	Codes             int                      `json:"codes"`
+	CodeSize          int                      `json:"code_size"`
+	Contracts         int                      `json:"contracts"`
+	Slots             int                      `json:"slots"`
+	StorageSize       int                      `json:"storage_size"`
+	Prunable          evmkeeper.PruneResult    `json:"prunable"`
+	LargestStorages   []evmkeeper.StorageUsage `json:"largest_storages"`
+	UnreferencedCodes []evmkeeper.CodeUsage    `json:"unreferenced_codes"`
+	OrphanedStorages  []evmkeeper.StorageUsage `json:"orphaned_storages"`
+	MissingCodes      []string                 `json:"missing_codes"`
+}
+
+// NewEVMStateCmd returns the offline EVM store commands.
+func NewEVMStateCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evm-state",
+		Short: "Offline EVM store subcommands",
+	}
+
+	cmd.AddCommand(
+		NewEVMStateAnalyzeCmd(appCreator),
+	)
+	return cmd
+}
+
+// NewEVMStateAnalyzeCmd returns the command analyzing the EVM store of the node.
+func NewEVMStateAnalyzeCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "analyze",
+		Short: "Analyze the EVM store at a height",
+		Long: `Analyze the EVM store at a height, the latest one by default: the reference counts of
+the contract codes, the storage left under addresses without account and the largest
+contract storages. The node must be stopped.
+
+The prunable state, the codes referenced by no account and the orphaned storage, can't be
+removed offline, as removing it changes the app hash. It's removed by the network in a
+coordinated upgrade whose handler calls the keeper PruneState function.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			height, err := cmd.Flags().GetInt64(flagStateHeight)
+			if err != nil {
+				return err
+			}
+			top, err := cmd.Flags().GetInt(flagStateTop)
+			if err != nil {
+				return err
+			}
+
+			evmosApp, ctx, closeDB, err := loadEVMState(cmd, appCreator, height)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			report := evmosApp.EvmKeeper.AnalyzeState(ctx)
+
+			bz, err := json.MarshalIndent(summarizeState(ctx.BlockHeight(), report, top), "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagStateHeight, 0, "the height of the analyzed state, the latest one if 0")
+	cmd.Flags().Int(flagStateTop, 20, "the number of largest contract storages listed")
+	return cmd
+}
+
+// loadEVMState opens the application database of the node and returns the
+// application with a context on a copy of its state at the given height, the
+// latest one if 0. The returned function closes the database.
+func loadEVMState(cmd *cobra.Command, appCreator servertypes.AppCreator, height int64) (*app.Evmos, sdk.Context, func(), error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	home := serverCtx.Config.RootDir
+
+	db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(home, "data"))
+	if err != nil {
+		return nil, sdk.Context{}, nil, err
+	}
+
+	evmosApp, ok := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper).(*app.Evmos)
+	if !ok {
+		_ = db.Close()
+		return nil, sdk.Context{}, nil, errors.New("the application doesn't hold an EVM store")
+	}
+	// close the application database and the snapshot store
+	closeDB := func() { _ = evmosApp.Close() }
+
+	if height == 0 {
+		height = evmosApp.LastBlockHeight()
+	}
+	cms, err := evmosApp.CommitMultiStore().CacheMultiStoreWithVersion(height)
+	if err != nil {
+		closeDB()
+		return nil, sdk.Context{}, nil, fmt.Errorf("failed to load the state at height %d: %w", height, err)
+	}
+
+	ctx := sdk.NewContext(cms, cmtproto.Header{Height: height}, false, serverCtx.Logger)
+	return evmosApp, ctx, closeDB, nil
+}
+
+// summarizeState returns the summary of the report, listing the top largest storages.
+func summarizeState(height int64, report evmkeeper.StateReport, top int) stateAnalysis {
+	analysis := stateAnalysis{
+		Height:            height,
+		Codes:             len(report.Codes),
+		Contracts:         len(report.Storages),
+		Prunable:          report.Prunable(),
+		UnreferencedCodes: report.UnreferencedCodes(),
+		OrphanedStorages:  report.OrphanedStorages(),
+	}
+
+	for _, code := range report.Codes {
+		analysis.CodeSize += code.Size
+	}
+	for _, storage := range report.Storages {
+		analysis.Slots += storage.Slots
+		analysis.StorageSize += storage.Size
+	}
+	for _, codeHash := range report.MissingCodes {
+		analysis.MissingCodes = append(analysis.MissingCodes, codeHash.Hex())
+	}
+
+	largest := append([]evmkeeper.StorageUsage(nil), report.Storages...)
+	sort.SliceStable(largest, func(i, j int) bool {
+		return largest[i].Size > largest[j].Size
+	})
+	if len(largest) > top {
+		largest = largest[:top]
+	}
+	analysis.LargestStorages = largest
+
+	return analysis
+}
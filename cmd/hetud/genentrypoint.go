@@ -0,0 +1,286 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/hetu-project/hetu/v1/contracts"
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+// AddGenesisEntryPointCmd returns add-genesis-entrypoint cobra Command.
+func AddGenesisEntryPointCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-entrypoint BYTECODE_FILE",
+		Short: "Deploy the ERC-4337 EntryPoint contract in genesis.json",
+		Long: `Deploy the ERC-4337 EntryPoint contract at its canonical address through the EVM
+genesis accounts. The file holds either the hex encoded creation bytecode of the contract or
+its compilation artifact, with the creation bytecode in the bytecode field.
+
+The constructor is run at the canonical address, so the contracts it creates, such as the
+SenderCreator of the EntryPoint v0.6, and the immutables referencing them are deployed as
+on any other chain.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			initCode, err := readCreationBytecode(args[0])
+			if err != nil {
+				return err
+			}
+
+			alloc, err := deployEntryPoint(initCode)
+			if err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			authGenState := authtypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+
+			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+			if err != nil {
+				return fmt.Errorf("failed to get accounts from any: %w", err)
+			}
+
+			var evmGenState evmtypes.GenesisState
+			if err := clientCtx.Codec.UnmarshalJSON(appState[evmtypes.ModuleName], &evmGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal evm genesis state: %w", err)
+			}
+
+			// the contracts hold no balance
+			newAccs, _, evmGenAccounts := convertEthAlloc(alloc, evmGenState.Params.EvmDenom)
+			for _, acc := range newAccs {
+				if accs.Contains(acc.GetAddress()) {
+					return fmt.Errorf("cannot add contract at existing address %s", common.BytesToAddress(acc.GetAddress()))
+				}
+				accs = append(accs, acc)
+			}
+			evmGenState.Accounts = append(evmGenState.Accounts, evmGenAccounts...)
+
+			if err := evmGenState.Validate(); err != nil {
+				return fmt.Errorf("failed to validate evm genesis state: %w", err)
+			}
+
+			accs = authtypes.SanitizeGenesisAccounts(accs)
+
+			genAccs, err := authtypes.PackAccounts(accs)
+			if err != nil {
+				return fmt.Errorf("failed to convert accounts into any's: %w", err)
+			}
+			authGenState.Accounts = genAccs
+
+			authGenStateBz, err := clientCtx.Codec.MarshalJSON(&authGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+			}
+
+			appState[authtypes.ModuleName] = authGenStateBz
+
+			evmGenStateBz, err := clientCtx.Codec.MarshalJSON(&evmGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal evm genesis state: %w", err)
+			}
+
+			appState[evmtypes.ModuleName] = evmGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// deployEntryPoint runs the EntryPoint constructor at the canonical address in an empty
+// state and returns the deployed contracts: the EntryPoint and the contracts it creates,
+// with their code, nonce and storage.
+func deployEntryPoint(initCode []byte) (core.GenesisAlloc, error) {
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// contract nonces start at 1 (EIP-161), the constructor being run as the code of the
+	// EntryPoint account
+	address := contracts.EntryPointAddress
+	statedb.SetNonce(address, 1)
+	statedb.SetCode(address, initCode)
+
+	tracer := newDeployTracer()
+	code, _, err := runtime.Call(address, nil, &runtime.Config{
+		State:     statedb,
+		EVMConfig: vm.Config{Debug: true, Tracer: tracer},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to run the EntryPoint constructor: %w", err)
+	}
+	if len(code) == 0 {
+		return nil, errors.New("the EntryPoint constructor returned an empty runtime bytecode")
+	}
+	statedb.SetCode(address, code)
+
+	alloc := make(core.GenesisAlloc)
+	for _, addr := range append([]common.Address{address}, tracer.created...) {
+		// the contracts created by a reverted call don't exist
+		if !statedb.Exist(addr) {
+			continue
+		}
+
+		account := core.GenesisAccount{
+			Code:    statedb.GetCode(addr),
+			Nonce:   statedb.GetNonce(addr),
+			Balance: new(big.Int),
+			Storage: make(map[common.Hash]common.Hash),
+		}
+		for key := range tracer.slots[addr] {
+			if value := statedb.GetState(addr, key); value != (common.Hash{}) {
+				account.Storage[key] = value
+			}
+		}
+		alloc[addr] = account
+	}
+
+	return alloc, nil
+}
+
+// deployTracer records the contracts created and the storage slots written by a
+// contract deployment.
+type deployTracer struct {
+	created []common.Address
+	slots   map[common.Address]map[common.Hash]struct{}
+}
+
+var _ vm.EVMLogger = (*deployTracer)(nil)
+
+func newDeployTracer() *deployTracer {
+	return &deployTracer{slots: make(map[common.Address]map[common.Hash]struct{})}
+}
+
+// CaptureEnter records the contracts created.
+func (t *deployTracer) CaptureEnter(typ vm.OpCode, _, to common.Address, _ []byte, _ uint64, _ *big.Int) {
+	if typ == vm.CREATE || typ == vm.CREATE2 {
+		t.created = append(t.created, to)
+	}
+}
+
+// CaptureState records the storage slots written.
+func (t *deployTracer) CaptureState(_ uint64, op vm.OpCode, _, _ uint64, scope *vm.ScopeContext, _ []byte, _ int, err error) {
+	if op != vm.SSTORE || err != nil {
+		return
+	}
+
+	addr := scope.Contract.Address()
+	if t.slots[addr] == nil {
+		t.slots[addr] = make(map[common.Hash]struct{})
+	}
+	t.slots[addr][scope.Stack.Back(0).Bytes32()] = struct{}{}
+}
+
+func (*deployTracer) CaptureTxStart(uint64) {}
+
+func (*deployTracer) CaptureTxEnd(uint64) {}
+
+func (*deployTracer) CaptureStart(*vm.EVM, common.Address, common.Address, bool, []byte, uint64, *big.Int) {
+}
+
+func (*deployTracer) CaptureEnd([]byte, uint64, time.Duration, error) {}
+
+func (*deployTracer) CaptureExit([]byte, uint64, error) {}
+
+func (*deployTracer) CaptureFault(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error) {}
+
+// readCreationBytecode reads the creation bytecode of a contract from a file holding
+// either the hex encoded bytecode or a compilation artifact.
+func readCreationBytecode(path string) ([]byte, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	hexCode := strings.TrimSpace(string(bz))
+
+	// compilation artifact, with a hardhat or foundry bytecode field
+	var artifact struct {
+		Bytecode json.RawMessage `json:"bytecode"`
+	}
+	if err := json.Unmarshal(bz, &artifact); err == nil {
+		var foundry struct {
+			Object string `json:"object"`
+		}
+		if err := json.Unmarshal(artifact.Bytecode, &hexCode); err != nil {
+			if err := json.Unmarshal(artifact.Bytecode, &foundry); err != nil {
+				return nil, fmt.Errorf("no bytecode in artifact %s", path)
+			}
+			hexCode = foundry.Object
+		}
+	}
+
+	if !strings.HasPrefix(hexCode, "0x") {
+		hexCode = "0x" + hexCode
+	}
+
+	code, err := hexutil.Decode(hexCode)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bytecode in %s: %w", path, err)
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("empty bytecode in %s", path)
+	}
+
+	return code, nil
+}
@@ -164,12 +164,15 @@ func NewRootCmd() (*cobra.Command, sdktestutil.TestEncodingConfig) {
 		),
 		genutilcli.ValidateGenesisCmd(tempApp.BasicModuleManager),
 		AddGenesisAccountCmd(app.DefaultNodeHome),
+		AddGenesisEntryPointCmd(app.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		NewTestnetCmd(tempApp.BasicModuleManager, banktypes.GenesisBalancesIterator{}),
 		debug.Cmd(),
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(a.newApp, app.DefaultNodeHome),
 		snapshot.Cmd(a.newApp),
+		NewEVMStateCmd(a.newApp),
+		NewGenesisCmd(app.DefaultNodeHome),
 	)
 
 	evmosserver.AddCommands(
@@ -178,6 +181,9 @@ func NewRootCmd() (*cobra.Command, sdktestutil.TestEncodingConfig) {
 		a.appExport,
 		addModuleInitFlags,
 	)
+	if exportCmd, _, err := rootCmd.Find([]string{"export"}); err == nil {
+		addEthAllocExport(exportCmd, a)
+	}
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
@@ -362,23 +368,32 @@ func (a appCreator) appExport(
 	forZeroHeight bool, jailAllowedAddrs []string,
 	appOpts servertypes.AppOptions, modulesToExport []string,
 ) (servertypes.ExportedApp, error) {
-	var evmosApp *app.Evmos
+	evmosApp, err := a.loadApp(logger, db, traceStore, height, appOpts)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	return evmosApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
+}
+
+// loadApp creates a new app loaded at the given height, the latest one if -1.
+func (a appCreator) loadApp(
+	logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, appOpts servertypes.AppOptions,
+) (*app.Evmos, error) {
 	homePath, ok := appOpts.Get(flags.FlagHome).(string)
 	if !ok || homePath == "" {
-		return servertypes.ExportedApp{}, errors.New("application home not set")
+		return nil, errors.New("application home not set")
 	}
 
-	if height != -1 {
-		evmosApp = app.NewEvmos(logger, db, traceStore, false, map[int64]bool{}, "", uint(1), a.encCfg, appOpts)
-
-		if err := evmosApp.LoadHeight(height); err != nil {
-			return servertypes.ExportedApp{}, err
-		}
-	} else {
-		evmosApp = app.NewEvmos(logger, db, traceStore, true, map[int64]bool{}, "", uint(1), a.encCfg, appOpts)
+	if height == -1 {
+		return app.NewEvmos(logger, db, traceStore, true, map[int64]bool{}, "", uint(1), a.encCfg, appOpts), nil
 	}
 
-	return evmosApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
+	evmosApp := app.NewEvmos(logger, db, traceStore, false, map[int64]bool{}, "", uint(1), a.encCfg, appOpts)
+	if err := evmosApp.LoadHeight(height); err != nil {
+		return nil, err
+	}
+	return evmosApp, nil
 }
 
 // initTendermintConfig helps to override default Tendermint Config values.
@@ -0,0 +1,45 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package contracts
+
+import (
+	"bytes"
+	_ "embed" // embed contract ABI
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	//go:embed abi/EntryPoint.json
+	entryPointABIJSON []byte
+
+	// EntryPointABI is the ABI of the ERC-4337 EntryPoint v0.6 contract
+	EntryPointABI abi.ABI
+
+	// EntryPointAddress is the canonical address of the ERC-4337 EntryPoint v0.6
+	// contract, deployed at genesis with the add-genesis-entrypoint command
+	EntryPointAddress = common.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+)
+
+func init() {
+	var err error
+	EntryPointABI, err = abi.JSON(bytes.NewReader(entryPointABIJSON))
+	if err != nil {
+		panic(err)
+	}
+}
@@ -17,6 +17,7 @@ package codec
 
 import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 
 	"github.com/hetu-project/hetu/v1/crypto/ethsecp256k1"
@@ -26,4 +27,7 @@ import (
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &ethsecp256k1.PubKey{})
 	registry.RegisterImplementations((*cryptotypes.PrivKey)(nil), &ethsecp256k1.PrivKey{})
+	// the SDK only registers the secp256r1 public key, passkey accounts stored
+	// on the keyring also need the private key
+	registry.RegisterImplementations((*cryptotypes.PrivKey)(nil), &secp256r1.PrivKey{})
 }
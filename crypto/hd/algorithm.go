@@ -39,7 +39,8 @@ var (
 	// SupportedAlgorithms defines the list of signing algorithms used on Evmos:
 	//  - eth_secp256k1 (Ethereum)
 	//  - secp256k1 (Tendermint)
-	SupportedAlgorithms = keyring.SigningAlgoList{EthSecp256k1, hd.Secp256k1}
+	//  - secp256r1 (passkeys)
+	SupportedAlgorithms = keyring.SigningAlgoList{EthSecp256k1, hd.Secp256k1, Secp256r1}
 	// SupportedAlgorithmsLedger defines the list of signing algorithms used on Evmos for the Ledger device:
 	//  - eth_secp256k1 (Ethereum)
 	//  - secp256k1 (Tendermint)
@@ -55,8 +56,18 @@ func EthSecp256k1Option() keyring.Option {
 	}
 }
 
+// SeedDeriveFn derives a private key from a BIP-32 seed and an HD path.
+type SeedDeriveFn func(seed []byte, path string) ([]byte, error)
+
+// SeedDeriver is implemented by the signing algorithms deriving keys from a
+// BIP-32 seed, such as the master secret recovered from SLIP-39 shares.
+type SeedDeriver interface {
+	DeriveFromSeed() SeedDeriveFn
+}
+
 var (
 	_ keyring.SignatureAlgo = EthSecp256k1
+	_ SeedDeriver           = EthSecp256k1
 
 	// EthSecp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 	EthSecp256k1 = ethSecp256k1Algo{}
@@ -72,12 +83,20 @@ func (s ethSecp256k1Algo) Name() hd.PubKeyType {
 // Derive derives and returns the eth_secp256k1 private key for the given mnemonic and HD path.
 func (s ethSecp256k1Algo) Derive() hd.DeriveFn {
 	return func(mnemonic, bip39Passphrase, path string) ([]byte, error) {
-		hdpath, err := accounts.ParseDerivationPath(path)
+		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
 		if err != nil {
 			return nil, err
 		}
 
-		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		return s.DeriveFromSeed()(seed, path)
+	}
+}
+
+// DeriveFromSeed derives and returns the eth_secp256k1 private key for the given
+// seed and HD path, following BIP-32 (SLIP-0010 for secp256k1).
+func (s ethSecp256k1Algo) DeriveFromSeed() SeedDeriveFn {
+	return func(seed []byte, path string) ([]byte, error) {
+		hdpath, err := accounts.ParseDerivationPath(path)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,53 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package hd
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+)
+
+// IndexPlaceholder is the placeholder of the account index in HD path templates.
+const IndexPlaceholder = "{index}"
+
+// PathTemplates are the named HD path templates of the common Ethereum wallets.
+var PathTemplates = map[string]string{
+	// BIP-44 path of MetaMask, Trezor and the Cosmos SDK keyring
+	"bip44": "m/44'/60'/0'/0/" + IndexPlaceholder,
+	// Ledger Live accounts
+	"ledger-live": "m/44'/60'/" + IndexPlaceholder + "'/0/0",
+	// legacy Ledger (MyEtherWallet) accounts
+	"ledger-legacy": "m/44'/60'/0'/" + IndexPlaceholder,
+}
+
+// ResolvePath returns the HD path of the template for the account index. The
+// template is either the name of one of the PathTemplates, or a BIP-32 path
+// optionally containing the IndexPlaceholder, e.g. m/44'/60'/0'/0/{index}.
+func ResolvePath(template string, index uint32) (string, error) {
+	if named, found := PathTemplates[template]; found {
+		template = named
+	}
+
+	path := strings.ReplaceAll(template, IndexPlaceholder, strconv.FormatUint(uint64(index), 10))
+	hdPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return "", err
+	}
+	return hdPath.String(), nil
+}
@@ -0,0 +1,54 @@
+package hd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/go-bip39"
+)
+
+func TestResolvePath(t *testing.T) {
+	testCases := []struct {
+		name     string
+		template string
+		index    uint32
+		expPath  string
+		expErr   bool
+	}{
+		{"bip44", "bip44", 3, "m/44'/60'/0'/0/3", false},
+		{"ledger live", "ledger-live", 3, "m/44'/60'/3'/0/0", false},
+		{"ledger legacy", "ledger-legacy", 3, "m/44'/60'/0'/3", false},
+		{"custom template", "m/44'/60'/1'/{index}'/7", 2, "m/44'/60'/1'/2'/7", false},
+		{"path without placeholder", "m/44'/60'/0'/0/5", 2, "m/44'/60'/0'/0/5", false},
+		{"invalid template", "m/44'/60'/{index}x", 2, "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := ResolvePath(tc.template, tc.index)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expPath, path)
+		})
+	}
+}
+
+func TestDeriveFromSeed(t *testing.T) {
+	seed := bip39.NewSeed(mnemonic, "")
+	path, err := ResolvePath("bip44", 0)
+	require.NoError(t, err)
+
+	for _, algo := range []keyring.SignatureAlgo{EthSecp256k1, Secp256r1} {
+		fromSeed, err := algo.(SeedDeriver).DeriveFromSeed()(seed, path)
+		require.NoError(t, err)
+
+		fromMnemonic, err := algo.Derive()(mnemonic, "", path)
+		require.NoError(t, err)
+		require.Equal(t, fromMnemonic, fromSeed)
+	}
+}
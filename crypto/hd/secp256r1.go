@@ -0,0 +1,175 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package hd
+
+import (
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/ethereum/go-ethereum/accounts"
+	bip39 "github.com/tyler-smith/go-bip39"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const (
+	// Secp256r1Type defines the ECDSA secp256r1 (NIST P-256) used by passkeys
+	Secp256r1Type = hd.PubKeyType("secp256r1")
+
+	// secp256r1KeySize is the size of a secp256r1 private key scalar
+	secp256r1KeySize = 32
+	// slip10Nist256p1Seed is the HMAC key of the SLIP-0010 master key generation for NIST P-256
+	slip10Nist256p1Seed = "Nist256p1 seed"
+)
+
+var (
+	_ keyring.SignatureAlgo = Secp256r1
+	_ SeedDeriver           = Secp256r1
+
+	// Secp256r1 uses the NIST P-256 ECDSA parameters with SLIP-0010 key derivation.
+	Secp256r1 = secp256r1Algo{}
+)
+
+type secp256r1Algo struct{}
+
+// Name returns secp256r1
+func (s secp256r1Algo) Name() hd.PubKeyType {
+	return Secp256r1Type
+}
+
+// Derive derives and returns the secp256r1 private key for the given mnemonic
+// and HD path, following SLIP-0010 for the NIST P-256 curve.
+func (s secp256r1Algo) Derive() hd.DeriveFn {
+	return func(mnemonic, bip39Passphrase, path string) ([]byte, error) {
+		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.DeriveFromSeed()(seed, path)
+	}
+}
+
+// DeriveFromSeed derives and returns the secp256r1 private key for the given
+// seed and HD path, following SLIP-0010 for the NIST P-256 curve.
+func (s secp256r1Algo) DeriveFromSeed() SeedDeriveFn {
+	return func(seed []byte, path string) ([]byte, error) {
+		hdpath, err := accounts.ParseDerivationPath(path)
+		if err != nil {
+			return nil, err
+		}
+
+		key, chainCode := slip10Nist256p1Master(seed)
+		for _, index := range hdpath {
+			key, chainCode = slip10Nist256p1Child(key, chainCode, index)
+		}
+
+		return key.FillBytes(make([]byte, secp256r1KeySize)), nil
+	}
+}
+
+// Generate generates a secp256r1 private key from the given bytes.
+func (s secp256r1Algo) Generate() hd.GenerateFn {
+	return func(bz []byte) cryptotypes.PrivKey {
+		privKey, err := NewSecp256r1PrivKey(bz)
+		if err != nil {
+			panic(err)
+		}
+		return privKey
+	}
+}
+
+// NewSecp256r1PrivKey creates a secp256r1 private key from its 32 bytes scalar.
+func NewSecp256r1PrivKey(bz []byte) (*secp256r1.PrivKey, error) {
+	if len(bz) != secp256r1KeySize {
+		return nil, errors.New("invalid secp256r1 private key length")
+	}
+
+	d := new(big.Int).SetBytes(bz)
+	if d.Sign() == 0 || d.Cmp(elliptic.P256().Params().N) >= 0 {
+		return nil, errors.New("invalid secp256r1 private key scalar")
+	}
+
+	// the SDK key can only be built from its protobuf encoding (field 1, bytes)
+	privKey := &secp256r1.PrivKey{}
+	if err := privKey.Unmarshal(append([]byte{0x0a, secp256r1KeySize}, bz...)); err != nil {
+		return nil, err
+	}
+	return privKey, nil
+}
+
+// slip10Nist256p1Master returns the SLIP-0010 master key and chain code of a seed.
+func slip10Nist256p1Master(seed []byte) (*big.Int, []byte) {
+	n := elliptic.P256().Params().N
+
+	mac := hmac.New(sha512.New, []byte(slip10Nist256p1Seed))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+
+	for {
+		key := new(big.Int).SetBytes(sum[:32])
+		if key.Sign() != 0 && key.Cmp(n) < 0 {
+			return key, sum[32:]
+		}
+
+		mac = hmac.New(sha512.New, []byte(slip10Nist256p1Seed))
+		mac.Write(sum)
+		sum = mac.Sum(nil)
+	}
+}
+
+// slip10Nist256p1Child returns the SLIP-0010 child private key and chain code
+// at the given index.
+func slip10Nist256p1Child(key *big.Int, chainCode []byte, index uint32) (*big.Int, []byte) {
+	curve := elliptic.P256()
+	n := curve.Params().N
+
+	data := make([]byte, 0, 37)
+	if index >= hdkeychain.HardenedKeyStart {
+		data = append(data, 0x00)
+		data = append(data, key.FillBytes(make([]byte, secp256r1KeySize))...)
+	} else {
+		x, y := curve.ScalarBaseMult(key.FillBytes(make([]byte, secp256r1KeySize)))
+		data = append(data, elliptic.MarshalCompressed(curve, x, y)...)
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	for {
+		mac := hmac.New(sha512.New, chainCode)
+		mac.Write(data)
+		sum := mac.Sum(nil)
+
+		il := new(big.Int).SetBytes(sum[:32])
+		child := new(big.Int).Add(il, key)
+		child.Mod(child, n)
+		if il.Cmp(n) < 0 && child.Sign() != 0 {
+			return child, sum[32:]
+		}
+
+		// invalid key: retry with 0x01 || IR || index
+		data = append([]byte{0x01}, sum[32:]...)
+		data = binary.BigEndian.AppendUint32(data, index)
+	}
+}
@@ -0,0 +1,57 @@
+package hd
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+)
+
+// TestSLIP10Nist256p1 checks the derivation against the SLIP-0010 test vector 1
+// for the nist256p1 curve.
+func TestSLIP10Nist256p1(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	key, chainCode := slip10Nist256p1Master(seed)
+	require.Equal(t, "612091aaa12e22dd2abef664f8a01a82cae99ad7441b7ef8110424915c268bc2", hex.EncodeToString(key.Bytes()))
+
+	key, chainCode = slip10Nist256p1Child(key, chainCode, hdkeychain.HardenedKeyStart)
+	require.Equal(t, "6939694369114c67917a182c59ddb8cafc3004e63ca5d3b84403ba8613debc0c", hex.EncodeToString(key.Bytes()))
+	require.Equal(t, "3460cea53e6a6bb5fb391eeef3237ffd8724bf0a40e94943c98b83825342ee11", hex.EncodeToString(chainCode))
+
+	key, chainCode = slip10Nist256p1Child(key, chainCode, 1)
+	require.Equal(t, "284e9d38d07d21e4e281b645089a94f4cf5a5a81369acf151a1c3a57f18b2129", hex.EncodeToString(key.Bytes()))
+
+	key, _ = slip10Nist256p1Child(key, chainCode, hdkeychain.HardenedKeyStart+2)
+	require.Equal(t, "694596e8a54f252c960eb771a3c41e7e32496d03b954aeb90f61635b8e092aa7", hex.EncodeToString(key.Bytes()))
+}
+
+func TestSecp256r1Keyring(t *testing.T) {
+	kr, err := keyring.New("hetu", keyring.BackendTest, t.TempDir(), nil, TestCodec, func(options *keyring.Options) {
+		options.SupportedAlgos = keyring.SigningAlgoList{Secp256r1}
+	})
+	require.NoError(t, err)
+
+	info, mnemonic, err := kr.NewMnemonic("passkey", keyring.English, "m/44'/60'/0'/0/0", keyring.DefaultBIP39Passphrase, Secp256r1)
+	require.NoError(t, err)
+	require.NotEmpty(t, mnemonic)
+
+	pubKey, err := info.GetPubKey()
+	require.NoError(t, err)
+	require.IsType(t, &secp256r1.PubKey{}, pubKey)
+
+	bz, err := Secp256r1.Derive()(mnemonic, keyring.DefaultBIP39Passphrase, "m/44'/60'/0'/0/0")
+	require.NoError(t, err)
+	privKey := Secp256r1.Generate()(bz)
+	require.True(t, privKey.PubKey().Equals(pubKey))
+
+	msg := []byte("message")
+	sig, _, err := kr.Sign("passkey", msg, 1)
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+}
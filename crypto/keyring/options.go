@@ -32,7 +32,8 @@ const AppName = "Ethereum"
 var (
 	// SupportedAlgorithms defines the list of signing algorithms used on Evmos:
 	//  - eth_secp256k1 (Ethereum)
-	SupportedAlgorithms = keyring.SigningAlgoList{hd.EthSecp256k1}
+	//  - secp256r1 (passkeys)
+	SupportedAlgorithms = keyring.SigningAlgoList{hd.EthSecp256k1, hd.Secp256r1}
 	// SupportedAlgorithmsLedger defines the list of signing algorithms used on Evmos for the Ledger device:
 	//  - secp256k1 (in order to comply with Cosmos SDK)
 	// The Ledger derivation function is responsible for all signing and address generation.
@@ -0,0 +1,389 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+// Package slip39 implements the recovery of master secrets from SLIP-39
+// Shamir's secret-sharing mnemonics, as specified in
+// https://github.com/satoshilabs/slips/blob/master/slip-0039.md
+package slip39
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// radixBits is the number of bits encoded by a mnemonic word
+	radixBits = 10
+	// radixWords is the number of words of the wordlist
+	radixWords = 1 << radixBits
+
+	// idExpLengthWords is the number of words of the identifier, extendable
+	// backup flag and iteration exponent
+	idExpLengthWords = 2
+	// checksumLengthWords is the number of words of the RS1024 checksum
+	checksumLengthWords = 3
+	// metadataLengthWords is the number of words of a share besides its value
+	metadataLengthWords = idExpLengthWords + 2 + checksumLengthWords
+	// minStrengthBits is the minimum length of the master secret in bits
+	minStrengthBits = 128
+	// minMnemonicLengthWords is the minimum number of words of a share
+	minMnemonicLengthWords = metadataLengthWords + (minStrengthBits+radixBits-1)/radixBits
+
+	// baseIterationCount is the total number of PBKDF2 iterations of the
+	// Feistel network for an iteration exponent of 0
+	baseIterationCount = 10000
+	// roundCount is the number of rounds of the Feistel network
+	roundCount = 4
+
+	// secretIndex is the x coordinate of the shared secret
+	secretIndex = 255
+	// digestIndex is the x coordinate of the digest of the shared secret
+	digestIndex = 254
+	// digestLengthBytes is the length of the digest of the shared secret
+	digestLengthBytes = 4
+)
+
+// Share is a parsed SLIP-39 share mnemonic.
+type Share struct {
+	Identifier        uint16
+	Extendable        bool
+	IterationExponent uint8
+	GroupIndex        uint8
+	GroupThreshold    uint8
+	GroupCount        uint8
+	MemberIndex       uint8
+	MemberThreshold   uint8
+	Value             []byte
+}
+
+// rawShare is a point of a Shamir polynomial.
+type rawShare struct {
+	x    uint8
+	data []byte
+}
+
+// ParseShare parses and validates the checksum of the SLIP-39 share mnemonic.
+func ParseShare(mnemonic string) (Share, error) {
+	words := strings.Fields(strings.ToLower(mnemonic))
+	if len(words) < minMnemonicLengthWords {
+		return Share{}, fmt.Errorf("invalid mnemonic length, it must be at least %d words", minMnemonicLengthWords)
+	}
+
+	indices := make([]int, len(words))
+	for i, word := range words {
+		index, found := wordIndex[word]
+		if !found {
+			return Share{}, fmt.Errorf("invalid mnemonic word %q", word)
+		}
+		indices[i] = index
+	}
+
+	paddingLen := (radixBits * (len(indices) - metadataLengthWords)) % 16
+	if paddingLen > 8 {
+		return Share{}, errors.New("invalid mnemonic length")
+	}
+
+	idExp := intFromIndices(indices[:idExpLengthWords])
+	share := Share{
+		Identifier:        uint16(idExp >> 5),
+		Extendable:        (idExp>>4)&1 == 1,
+		IterationExponent: uint8(idExp & 0xf),
+	}
+
+	if !verifyChecksum(indices, share.Extendable) {
+		return Share{}, errors.New("invalid mnemonic checksum")
+	}
+
+	params := intFromIndices(indices[idExpLengthWords : idExpLengthWords+2])
+	share.GroupIndex = uint8(params >> 16 & 0xf)
+	share.GroupThreshold = uint8(params>>12&0xf) + 1
+	share.GroupCount = uint8(params>>8&0xf) + 1
+	share.MemberIndex = uint8(params >> 4 & 0xf)
+	share.MemberThreshold = uint8(params&0xf) + 1
+
+	if share.GroupCount < share.GroupThreshold {
+		return Share{}, errors.New("invalid mnemonic, the group threshold cannot be greater than the group count")
+	}
+
+	valueIndices := indices[idExpLengthWords+2 : len(indices)-checksumLengthWords]
+	valueByteCount := (radixBits*len(valueIndices) - paddingLen) / 8
+	if valueByteCount*8 < minStrengthBits || valueByteCount%2 != 0 {
+		return Share{}, fmt.Errorf(
+			"invalid mnemonic share value length %d, it must be an even number of bytes of at least %d bits",
+			valueByteCount, minStrengthBits,
+		)
+	}
+
+	value := new(big.Int)
+	for _, index := range valueIndices {
+		value.Lsh(value, radixBits)
+		value.Or(value, big.NewInt(int64(index)))
+	}
+	if value.BitLen() > valueByteCount*8 {
+		return Share{}, errors.New("invalid mnemonic padding")
+	}
+	share.Value = value.FillBytes(make([]byte, valueByteCount))
+
+	return share, nil
+}
+
+// Combine recovers the master secret from the SLIP-39 share mnemonics,
+// decrypting it with the passphrase. The mnemonics must contain at least the
+// member threshold of shares for the group threshold of groups.
+func Combine(mnemonics []string, passphrase []byte) ([]byte, error) {
+	if len(mnemonics) == 0 {
+		return nil, errors.New("the list of mnemonics is empty")
+	}
+	for _, c := range passphrase {
+		if c < 32 || c > 126 {
+			return nil, errors.New("the passphrase must contain only printable ASCII characters")
+		}
+	}
+
+	shares := make([]Share, len(mnemonics))
+	for i, mnemonic := range mnemonics {
+		share, err := ParseShare(mnemonic)
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = share
+	}
+
+	encryptedSecret, err := recoverEncryptedSecret(shares)
+	if err != nil {
+		return nil, err
+	}
+
+	first := shares[0]
+	return decrypt(encryptedSecret, passphrase, first.IterationExponent, first.Identifier, first.Extendable), nil
+}
+
+// recoverEncryptedSecret recovers the encrypted master secret from the shares.
+func recoverEncryptedSecret(shares []Share) ([]byte, error) {
+	first := shares[0]
+
+	groups := make(map[uint8][]Share)
+	var groupOrder []uint8
+	for _, share := range shares {
+		if share.Identifier != first.Identifier || share.Extendable != first.Extendable ||
+			share.IterationExponent != first.IterationExponent {
+			return nil, errors.New("invalid set of mnemonics, all the shares must belong to the same secret")
+		}
+		if share.GroupThreshold != first.GroupThreshold || share.GroupCount != first.GroupCount {
+			return nil, errors.New("invalid set of mnemonics, all the shares must have the same group parameters")
+		}
+		if len(share.Value) != len(first.Value) {
+			return nil, errors.New("invalid set of mnemonics, all the shares must have the same length")
+		}
+
+		group, found := groups[share.GroupIndex]
+		if !found {
+			groupOrder = append(groupOrder, share.GroupIndex)
+		}
+
+		duplicate := false
+		for _, member := range group {
+			if member.MemberThreshold != share.MemberThreshold {
+				return nil, fmt.Errorf("invalid set of mnemonics, the shares of group %d must have the same member threshold", share.GroupIndex)
+			}
+			if member.MemberIndex == share.MemberIndex {
+				if !bytes.Equal(member.Value, share.Value) {
+					return nil, fmt.Errorf("invalid set of mnemonics, share %d of group %d is duplicated", share.MemberIndex, share.GroupIndex)
+				}
+				duplicate = true
+			}
+		}
+		if !duplicate {
+			groups[share.GroupIndex] = append(group, share)
+		}
+	}
+
+	var groupShares []rawShare
+	for _, groupIndex := range groupOrder {
+		group := groups[groupIndex]
+		threshold := int(group[0].MemberThreshold)
+		if len(group) < threshold || len(groupShares) == int(first.GroupThreshold) {
+			continue
+		}
+
+		memberShares := make([]rawShare, threshold)
+		for i, member := range group[:threshold] {
+			memberShares[i] = rawShare{x: member.MemberIndex, data: member.Value}
+		}
+
+		secret, err := recoverSecret(memberShares)
+		if err != nil {
+			return nil, err
+		}
+		groupShares = append(groupShares, rawShare{x: groupIndex, data: secret})
+	}
+
+	if len(groupShares) < int(first.GroupThreshold) {
+		return nil, fmt.Errorf(
+			"insufficient number of mnemonics, %d of %d groups are complete",
+			len(groupShares), first.GroupThreshold,
+		)
+	}
+
+	return recoverSecret(groupShares)
+}
+
+// recoverSecret recovers the shared secret from threshold shares, checking its
+// digest if the threshold is greater than 1.
+func recoverSecret(shares []rawShare) ([]byte, error) {
+	if len(shares) == 1 {
+		return shares[0].data, nil
+	}
+
+	secret := interpolate(shares, secretIndex)
+	digestShare := interpolate(shares, digestIndex)
+
+	mac := hmac.New(sha256.New, digestShare[digestLengthBytes:])
+	mac.Write(secret)
+	if !hmac.Equal(mac.Sum(nil)[:digestLengthBytes], digestShare[:digestLengthBytes]) {
+		return nil, errors.New("invalid digest of the shared secret")
+	}
+
+	return secret, nil
+}
+
+// interpolate evaluates at x the polynomial over GF(256) defined by the shares,
+// using Lagrange interpolation.
+func interpolate(shares []rawShare, x uint8) []byte {
+	for _, share := range shares {
+		if share.x == x {
+			return share.data
+		}
+	}
+
+	// logarithm of the product of (x_i - x) for all the shares
+	logProd := 0
+	for _, share := range shares {
+		logProd += int(logTable[share.x^x])
+	}
+
+	result := make([]byte, len(shares[0].data))
+	for _, share := range shares {
+		// logarithm of the Lagrange basis polynomial of the share evaluated at x
+		logBasisEval := logProd - int(logTable[share.x^x])
+		for _, other := range shares {
+			logBasisEval -= int(logTable[share.x^other.x])
+		}
+		logBasisEval = ((logBasisEval % 255) + 255) % 255
+
+		for i, value := range share.data {
+			if value != 0 {
+				result[i] ^= expTable[(int(logTable[value])+logBasisEval)%255]
+			}
+		}
+	}
+
+	return result
+}
+
+// decrypt decrypts the encrypted master secret with the passphrase, using the
+// 4 round Feistel network of the specification.
+func decrypt(encryptedSecret, passphrase []byte, iterationExponent uint8, identifier uint16, extendable bool) []byte {
+	half := len(encryptedSecret) / 2
+	l := append([]byte{}, encryptedSecret[:half]...)
+	r := append([]byte{}, encryptedSecret[half:]...)
+
+	var salt []byte
+	if !extendable {
+		salt = append([]byte("shamir"), byte(identifier>>8), byte(identifier))
+	}
+
+	iterations := (baseIterationCount << iterationExponent) / roundCount
+	for i := roundCount - 1; i >= 0; i-- {
+		password := append([]byte{byte(i)}, passphrase...)
+		f := pbkdf2.Key(password, append(append([]byte{}, salt...), r...), iterations, len(r), sha256.New)
+		for j := range f {
+			f[j] ^= l[j]
+		}
+		l, r = r, f
+	}
+
+	return append(r, l...)
+}
+
+// verifyChecksum verifies the RS1024 checksum of the word indices.
+func verifyChecksum(indices []int, extendable bool) bool {
+	customization := "shamir"
+	if extendable {
+		customization = "shamir_extendable"
+	}
+
+	values := make([]int, 0, len(customization)+len(indices))
+	for _, c := range []byte(customization) {
+		values = append(values, int(c))
+	}
+	values = append(values, indices...)
+
+	return rs1024Polymod(values) == 1
+}
+
+// rs1024Polymod computes the RS1024 checksum polynomial modulus of the values.
+func rs1024Polymod(values []int) uint32 {
+	gen := [radixBits]uint32{
+		0xe0e040, 0x1c1c080, 0x3838100, 0x7070200, 0xe0e0009,
+		0x1c0c2412, 0x38086c24, 0x3090fc48, 0x21b1f890, 0x3f3f120,
+	}
+
+	chk := uint32(1)
+	for _, v := range values {
+		b := chk >> 20
+		chk = (chk&0xfffff)<<radixBits ^ uint32(v)
+		for i := 0; i < radixBits; i++ {
+			if (b>>i)&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
+
+// intFromIndices packs the 10-bit word indices into an integer.
+func intFromIndices(indices []int) int {
+	value := 0
+	for _, index := range indices {
+		value = value<<radixBits | index
+	}
+	return value
+}
+
+// expTable and logTable are the exponent and logarithm tables of GF(256)
+// with the Rijndael polynomial x^8 + x^4 + x^3 + x + 1 and generator 3.
+var expTable, logTable = func() (exp [255]byte, log [256]byte) {
+	poly := 1
+	for i := 0; i < 255; i++ {
+		exp[i] = byte(poly)
+		log[poly] = byte(i)
+
+		// multiply poly by the generator 3 and reduce by the Rijndael polynomial
+		poly = (poly << 1) ^ poly
+		if poly&0x100 != 0 {
+			poly ^= 0x11b
+		}
+	}
+	return exp, log
+}()
@@ -0,0 +1,254 @@
+package slip39
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+var (
+	// single share of master secret bb54aac4b89dc868ba37d9cc21b2cece with passphrase TREZOR
+	vectorSingle = "duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard"
+	// 2 of 3 shares of master secret b43ceb7e57a0ea8766221624d01b0864 with passphrase TREZOR
+	vectorShares = []string{
+		"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed",
+		"shadow pistol academic acid actress prayer class unknown daughter sweater depict flip twice unkind craft early superior advocate guest smoking",
+	}
+)
+
+func TestCombineVectors(t *testing.T) {
+	testCases := []struct {
+		name      string
+		mnemonics []string
+		expSecret string
+		expErr    string
+	}{
+		{"single share", []string{vectorSingle}, "bb54aac4b89dc868ba37d9cc21b2cece", ""},
+		{"2 of 3 shares", vectorShares, "b43ceb7e57a0ea8766221624d01b0864", ""},
+		{"2 of 3 shares, duplicated share", append(vectorShares, vectorShares[0]), "b43ceb7e57a0ea8766221624d01b0864", ""},
+		{"upper case", []string{strings.ToUpper(vectorSingle)}, "bb54aac4b89dc868ba37d9cc21b2cece", ""},
+		{"fail - empty", nil, "", "empty"},
+		{"fail - insufficient shares", vectorShares[:1], "", "insufficient number of mnemonics"},
+		{"fail - invalid checksum", []string{strings.Replace(vectorSingle, "keyboard", "kidney", 1)}, "", "checksum"},
+		{"fail - invalid word", []string{strings.Replace(vectorSingle, "duckling", "duck", 1)}, "", "invalid mnemonic word"},
+		{"fail - too short", []string{"duckling enlarge academic academic"}, "", "length"},
+		{"fail - different secrets", []string{vectorSingle, vectorShares[0]}, "", "same secret"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			secret, err := Combine(tc.mnemonics, []byte("TREZOR"))
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expSecret, hex.EncodeToString(secret))
+		})
+	}
+}
+
+func TestCombinePassphrase(t *testing.T) {
+	secret, err := Combine([]string{vectorSingle}, nil)
+	require.NoError(t, err)
+	require.NotEqual(t, "bb54aac4b89dc868ba37d9cc21b2cece", hex.EncodeToString(secret))
+
+	_, err = Combine([]string{vectorSingle}, []byte("TREZOR\n"))
+	require.ErrorContains(t, err, "printable ASCII")
+}
+
+func TestParseShare(t *testing.T) {
+	share, err := ParseShare(vectorShares[0])
+	require.NoError(t, err)
+	require.Equal(t, uint8(0), share.GroupIndex)
+	require.Equal(t, uint8(1), share.GroupThreshold)
+	require.Equal(t, uint8(1), share.GroupCount)
+	require.Equal(t, uint8(2), share.MemberThreshold)
+	require.Len(t, share.Value, 16)
+}
+
+func TestParseShareValueLength(t *testing.T) {
+	testCases := []struct {
+		name   string
+		length int
+		expErr string
+	}{
+		{"128 bits", 16, ""},
+		{"256 bits", 32, ""},
+		{"144 bits", 18, ""},
+		{"fail - under 128 bits", 12, "length"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mnemonic := encodeShare(Share{
+				Identifier:      7945,
+				GroupThreshold:  1,
+				GroupCount:      1,
+				MemberThreshold: 1,
+				Value:           make([]byte, tc.length),
+			})
+
+			share, err := ParseShare(mnemonic)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, share.Value, tc.length)
+		})
+	}
+}
+
+func TestCombineGroups(t *testing.T) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	require.NoError(t, err)
+	passphrase := []byte("custody")
+
+	// 2 of 3 groups, with 1 of 1, 2 of 3 and 3 of 5 member shares
+	groups := split(t, secret, passphrase, 2, []memberParams{{1, 1}, {2, 3}, {3, 5}})
+
+	testCases := []struct {
+		name      string
+		mnemonics []string
+		expPass   bool
+	}{
+		{"groups 0 and 1", []string{groups[0][0], groups[1][2], groups[1][0]}, true},
+		{"groups 1 and 2", []string{groups[2][4], groups[1][1], groups[2][0], groups[1][2], groups[2][3]}, true},
+		{"all groups", append(append(groups[0], groups[1]...), groups[2]...), true},
+		{"fail - incomplete group 1", []string{groups[0][0], groups[1][2]}, false},
+		{"fail - single group", groups[2], false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recovered, err := Combine(tc.mnemonics, passphrase)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, secret, recovered)
+		})
+	}
+}
+
+type memberParams struct {
+	threshold, count uint8
+}
+
+// split splits the master secret into SLIP-39 share mnemonics, following the
+// reference implementation of the specification.
+func split(t *testing.T, masterSecret, passphrase []byte, groupThreshold uint8, groups []memberParams) [][]string {
+	t.Helper()
+
+	const (
+		identifier        = 7945
+		iterationExponent = 0
+	)
+
+	encryptedSecret := encrypt(masterSecret, passphrase, iterationExponent, identifier)
+	groupShares := splitSecret(t, groupThreshold, uint8(len(groups)), encryptedSecret)
+
+	mnemonics := make([][]string, len(groups))
+	for _, groupShare := range groupShares {
+		group := groups[groupShare.x]
+		for _, memberShare := range splitSecret(t, group.threshold, group.count, groupShare.data) {
+			mnemonics[groupShare.x] = append(mnemonics[groupShare.x], encodeShare(Share{
+				Identifier:        identifier,
+				IterationExponent: iterationExponent,
+				GroupIndex:        groupShare.x,
+				GroupThreshold:    groupThreshold,
+				GroupCount:        uint8(len(groups)),
+				MemberIndex:       memberShare.x,
+				MemberThreshold:   group.threshold,
+				Value:             memberShare.data,
+			}))
+		}
+	}
+	return mnemonics
+}
+
+func splitSecret(t *testing.T, threshold, count uint8, secret []byte) []rawShare {
+	if threshold == 1 {
+		shares := make([]rawShare, count)
+		for i := range shares {
+			shares[i] = rawShare{x: uint8(i), data: secret}
+		}
+		return shares
+	}
+
+	randomPart := make([]byte, len(secret)-digestLengthBytes)
+	_, err := rand.Read(randomPart)
+	require.NoError(t, err)
+	mac := hmac.New(sha256.New, randomPart)
+	mac.Write(secret)
+	digest := append(mac.Sum(nil)[:digestLengthBytes], randomPart...)
+
+	var shares []rawShare
+	for i := uint8(0); i < threshold-2; i++ {
+		data := make([]byte, len(secret))
+		_, err := rand.Read(data)
+		require.NoError(t, err)
+		shares = append(shares, rawShare{x: i, data: data})
+	}
+	base := append(append([]rawShare{}, shares...), rawShare{x: digestIndex, data: digest}, rawShare{x: secretIndex, data: secret})
+	for i := threshold - 2; i < count; i++ {
+		shares = append(shares, rawShare{x: i, data: interpolate(base, i)})
+	}
+	return shares
+}
+
+func encrypt(masterSecret, passphrase []byte, iterationExponent uint8, identifier uint16) []byte {
+	half := len(masterSecret) / 2
+	l := append([]byte{}, masterSecret[:half]...)
+	r := append([]byte{}, masterSecret[half:]...)
+	salt := append([]byte("shamir"), byte(identifier>>8), byte(identifier))
+
+	iterations := (baseIterationCount << iterationExponent) / roundCount
+	for i := 0; i < roundCount; i++ {
+		f := pbkdf2.Key(append([]byte{byte(i)}, passphrase...), append(append([]byte{}, salt...), r...), iterations, len(r), sha256.New)
+		for j := range f {
+			f[j] ^= l[j]
+		}
+		l, r = r, f
+	}
+	return append(r, l...)
+}
+
+func encodeShare(share Share) string {
+	idExp := int(share.Identifier)<<5 | int(share.IterationExponent)
+	params := int(share.GroupIndex)<<16 | int(share.GroupThreshold-1)<<12 | int(share.GroupCount-1)<<8 |
+		int(share.MemberIndex)<<4 | int(share.MemberThreshold-1)
+
+	indices := []int{idExp >> radixBits, idExp & (radixWords - 1), params >> radixBits, params & (radixWords - 1)}
+
+	valueWords := (len(share.Value)*8 + radixBits - 1) / radixBits
+	value := new(big.Int).SetBytes(share.Value)
+	for i := valueWords - 1; i >= 0; i-- {
+		word := new(big.Int).Rsh(value, uint(i*radixBits))
+		indices = append(indices, int(word.Int64()&(radixWords-1)))
+	}
+
+	values := make([]int, 0, len(indices)+9)
+	for _, c := range []byte("shamir") {
+		values = append(values, int(c))
+	}
+	values = append(append(values, indices...), 0, 0, 0)
+	checksum := int(rs1024Polymod(values) ^ 1)
+	indices = append(indices, checksum>>20&(radixWords-1), checksum>>10&(radixWords-1), checksum&(radixWords-1))
+
+	words := make([]string, len(indices))
+	for i, index := range indices {
+		words[i] = wordList[index]
+	}
+	return strings.Join(words, " ")
+}
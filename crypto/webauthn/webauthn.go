@@ -0,0 +1,206 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+// Package webauthn verifies WebAuthn assertions produced by passkeys, which
+// sign a challenge wrapped in the authenticator data and the client data of
+// the WebAuthn ceremony instead of the raw message.
+package webauthn
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+)
+
+const (
+	// ClientDataTypeGet is the client data type of WebAuthn assertions
+	ClientDataTypeGet = "webauthn.get"
+
+	// flagUserPresent is the authenticator data flag set when the user was present
+	flagUserPresent = 0x01
+	// rpIDHashLength is the length of the RP ID hash at the start of the authenticator data
+	rpIDHashLength = 32
+	// flagsIndex is the index of the flags within the authenticator data, after the RP ID hash
+	flagsIndex = rpIDHashLength
+	// minAuthenticatorDataLength is the length of the RP ID hash, the flags and the signature counter
+	minAuthenticatorDataLength = 37
+)
+
+// Assertion defines the envelope of a WebAuthn assertion. The passkey signs
+// sha256(authenticatorData || sha256(clientDataJSON)) with its P-256 key, and
+// the client data holds the base64url encoded challenge.
+type Assertion struct {
+	AuthenticatorData []byte `json:"authenticatorData"`
+	ClientDataJSON    []byte `json:"clientDataJSON"`
+	// Signature is the ASN.1 DER encoded ECDSA signature returned by the authenticator
+	Signature []byte `json:"signature"`
+}
+
+// clientData defines the fields of the collected client data that are checked
+// when verifying an assertion.
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// ecdsaSignature defines the ASN.1 structure of an ECDSA signature.
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// p256HalfOrder is half the order of the P-256 curve, the upper bound of the S
+// value of low-S signatures.
+var p256HalfOrder = new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+
+// ParseAssertion decodes the JSON envelope of a WebAuthn assertion.
+func ParseAssertion(bz []byte) (*Assertion, error) {
+	var assertion Assertion
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&assertion); err != nil {
+		return nil, fmt.Errorf("invalid WebAuthn assertion: %w", err)
+	}
+
+	if len(assertion.AuthenticatorData) < minAuthenticatorDataLength {
+		return nil, fmt.Errorf(
+			"invalid WebAuthn authenticator data length; expected at least %d, got %d",
+			minAuthenticatorDataLength, len(assertion.AuthenticatorData),
+		)
+	}
+	if len(assertion.ClientDataJSON) == 0 {
+		return nil, errors.New("empty WebAuthn client data")
+	}
+	if len(assertion.Signature) == 0 {
+		return nil, errors.New("empty WebAuthn signature")
+	}
+
+	return &assertion, nil
+}
+
+// Challenge returns the challenge that a passkey signs to authorize the given
+// message.
+func Challenge(msg []byte) []byte {
+	hash := sha256.Sum256(msg)
+	return hash[:]
+}
+
+// SignedDigest returns the digest signed by the authenticator.
+func (a Assertion) SignedDigest() []byte {
+	clientDataHash := sha256.Sum256(a.ClientDataJSON)
+	signed := make([]byte, 0, len(a.AuthenticatorData)+len(clientDataHash))
+	signed = append(signed, a.AuthenticatorData...)
+	signed = append(signed, clientDataHash[:]...)
+	digest := sha256.Sum256(signed)
+	return digest[:]
+}
+
+// Verify checks that the assertion was produced with the given passkey of the
+// relying party rpID over the challenge of msg, with the user present. The
+// origin of the client data must be served over HTTPS by the relying party
+// domain or one of its subdomains, and the signature must have a low S value
+// so that it cannot be malleated.
+func (a Assertion) Verify(pubKey *secp256r1.PubKey, msg []byte, rpID string) error {
+	if rpID == "" {
+		return errors.New("WebAuthn relying party ID not set")
+	}
+
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	if !bytes.Equal(a.AuthenticatorData[:rpIDHashLength], rpIDHash[:]) {
+		return fmt.Errorf("WebAuthn authenticator data does not match the relying party ID %s", rpID)
+	}
+
+	var data clientData
+	if err := json.Unmarshal(a.ClientDataJSON, &data); err != nil {
+		return fmt.Errorf("invalid WebAuthn client data: %w", err)
+	}
+
+	if data.Type != ClientDataTypeGet {
+		return fmt.Errorf("invalid WebAuthn client data type; expected %s, got %s", ClientDataTypeGet, data.Type)
+	}
+
+	if data.Challenge != base64.RawURLEncoding.EncodeToString(Challenge(msg)) {
+		return errors.New("WebAuthn challenge does not match the signed message")
+	}
+
+	if err := verifyOrigin(data.Origin, rpID); err != nil {
+		return err
+	}
+
+	if a.AuthenticatorData[flagsIndex]&flagUserPresent == 0 {
+		return errors.New("WebAuthn user presence flag not set")
+	}
+
+	var sig ecdsaSignature
+	if rest, err := asn1.Unmarshal(a.Signature, &sig); err != nil || len(rest) != 0 {
+		return errors.New("invalid WebAuthn signature encoding")
+	}
+	if sig.R == nil || sig.S == nil || sig.S.Sign() <= 0 || sig.S.Cmp(p256HalfOrder) > 0 {
+		return errors.New("WebAuthn signature S value is not low")
+	}
+
+	key, err := PublicKey(pubKey)
+	if err != nil {
+		return err
+	}
+
+	if !ecdsa.VerifyASN1(key, a.SignedDigest(), a.Signature) {
+		return errors.New("invalid WebAuthn signature")
+	}
+
+	return nil
+}
+
+// verifyOrigin checks that the origin of the client data is an HTTPS origin of
+// the relying party domain or of one of its subdomains.
+func verifyOrigin(origin, rpID string) error {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return fmt.Errorf("invalid WebAuthn origin %s: %w", origin, err)
+	}
+
+	host := u.Hostname()
+	if u.Scheme != "https" || (host != rpID && !strings.HasSuffix(host, "."+rpID)) {
+		return fmt.Errorf("WebAuthn origin %s does not belong to the relying party ID %s", origin, rpID)
+	}
+
+	return nil
+}
+
+// PublicKey returns the ECDSA public key of a secp256r1 public key.
+func PublicKey(pubKey *secp256r1.PubKey) (*ecdsa.PublicKey, error) {
+	if pubKey == nil {
+		return nil, errors.New("nil secp256r1 public key")
+	}
+
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), pubKey.Bytes())
+	if x == nil {
+		return nil, errors.New("invalid secp256r1 public key")
+	}
+
+	return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+}
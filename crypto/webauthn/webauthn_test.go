@@ -0,0 +1,226 @@
+package webauthn_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/crypto/webauthn"
+)
+
+const rpID = "wallet.example"
+
+// newAssertion emulates an authenticator of the wallet.example relying party
+// signing the challenge of msg.
+func newAssertion(t *testing.T, privKey *secp256r1.PrivKey, clientDataType string, msg []byte, flags byte) *webauthn.Assertion {
+	t.Helper()
+	return newAssertionWithOrigin(t, privKey, clientDataType, msg, flags, rpID, "https://"+rpID)
+}
+
+// newAssertionWithOrigin emulates an authenticator of the relying party rpID
+// signing the challenge of msg collected by the client at origin.
+func newAssertionWithOrigin(
+	t *testing.T,
+	privKey *secp256r1.PrivKey,
+	clientDataType string,
+	msg []byte,
+	flags byte,
+	rpID, origin string,
+) *webauthn.Assertion {
+	t.Helper()
+
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	authData := append(rpIDHash[:], flags, 0, 0, 0, 1)
+	clientData := fmt.Sprintf(
+		`{"type":%q,"challenge":%q,"origin":%q}`,
+		clientDataType, base64.RawURLEncoding.EncodeToString(webauthn.Challenge(msg)), origin,
+	)
+
+	assertion := &webauthn.Assertion{
+		AuthenticatorData: authData,
+		ClientDataJSON:    []byte(clientData),
+	}
+
+	x, y := elliptic.P256().ScalarBaseMult(privKey.Bytes())
+	key := &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y},
+		D:         new(big.Int).SetBytes(privKey.Bytes()),
+	}
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, assertion.SignedDigest())
+	require.NoError(t, err)
+	// authenticators are not required to produce low-S signatures
+	halfOrder := new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+	if s.Cmp(halfOrder) > 0 {
+		s.Sub(elliptic.P256().Params().N, s)
+	}
+	assertion.Signature = encodeSignature(t, r, s)
+
+	return assertion
+}
+
+// encodeSignature returns the ASN.1 DER encoding of the ECDSA signature (r, s).
+func encodeSignature(t *testing.T, r, s *big.Int) []byte {
+	t.Helper()
+
+	sig, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+	require.NoError(t, err)
+	return sig
+}
+
+func TestAssertion(t *testing.T) {
+	privKey, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	otherKey, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+
+	msg := []byte("sign bytes")
+	pubKey := privKey.PubKey().(*secp256r1.PubKey)
+
+	testCases := []struct {
+		name      string
+		assertion func() *webauthn.Assertion
+		expPass   bool
+	}{
+		{
+			"pass",
+			func() *webauthn.Assertion { return newAssertion(t, privKey, webauthn.ClientDataTypeGet, msg, 0x05) },
+			true,
+		},
+		{
+			"fail - wrong client data type",
+			func() *webauthn.Assertion { return newAssertion(t, privKey, "webauthn.create", msg, 0x05) },
+			false,
+		},
+		{
+			"fail - other message",
+			func() *webauthn.Assertion {
+				return newAssertion(t, privKey, webauthn.ClientDataTypeGet, []byte("other"), 0x05)
+			},
+			false,
+		},
+		{
+			"fail - user not present",
+			func() *webauthn.Assertion { return newAssertion(t, privKey, webauthn.ClientDataTypeGet, msg, 0x04) },
+			false,
+		},
+		{
+			"fail - other key",
+			func() *webauthn.Assertion { return newAssertion(t, otherKey, webauthn.ClientDataTypeGet, msg, 0x05) },
+			false,
+		},
+		{
+			"pass - subdomain origin",
+			func() *webauthn.Assertion {
+				return newAssertionWithOrigin(t, privKey, webauthn.ClientDataTypeGet, msg, 0x05, rpID, "https://app.wallet.example")
+			},
+			true,
+		},
+		{
+			"fail - other relying party",
+			func() *webauthn.Assertion {
+				return newAssertionWithOrigin(t, privKey, webauthn.ClientDataTypeGet, msg, 0x05, "evil.example", "https://evil.example")
+			},
+			false,
+		},
+		{
+			"fail - origin of another domain",
+			func() *webauthn.Assertion {
+				return newAssertionWithOrigin(t, privKey, webauthn.ClientDataTypeGet, msg, 0x05, rpID, "https://evilwallet.example")
+			},
+			false,
+		},
+		{
+			"fail - insecure origin",
+			func() *webauthn.Assertion {
+				return newAssertionWithOrigin(t, privKey, webauthn.ClientDataTypeGet, msg, 0x05, rpID, "http://wallet.example")
+			},
+			false,
+		},
+		{
+			"fail - high S signature",
+			func() *webauthn.Assertion {
+				assertion := newAssertion(t, privKey, webauthn.ClientDataTypeGet, msg, 0x05)
+				var sig struct{ R, S *big.Int }
+				_, err := asn1.Unmarshal(assertion.Signature, &sig)
+				require.NoError(t, err)
+				// the malleated signature is still valid for ECDSA
+				highS := new(big.Int).Sub(elliptic.P256().Params().N, sig.S)
+				assertion.Signature = encodeSignature(t, sig.R, highS)
+				return assertion
+			},
+			false,
+		},
+		{
+			"fail - tampered authenticator data",
+			func() *webauthn.Assertion {
+				assertion := newAssertion(t, privKey, webauthn.ClientDataTypeGet, msg, 0x05)
+				assertion.AuthenticatorData[36]++
+				return assertion
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := json.Marshal(tc.assertion())
+			require.NoError(t, err)
+
+			assertion, err := webauthn.ParseAssertion(bz)
+			require.NoError(t, err)
+
+			err = assertion.Verify(pubKey, msg, rpID)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestAssertionNoRelyingParty(t *testing.T) {
+	privKey, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+
+	msg := []byte("sign bytes")
+	assertion := newAssertion(t, privKey, webauthn.ClientDataTypeGet, msg, 0x05)
+	require.Error(t, assertion.Verify(privKey.PubKey().(*secp256r1.PubKey), msg, ""))
+}
+
+func TestParseAssertion(t *testing.T) {
+	testCases := []struct {
+		name    string
+		bz      string
+		expPass bool
+	}{
+		{"raw signature", string(make([]byte, 64)), false},
+		{"unknown field", `{"authenticatorData":"","clientDataJSON":"","signature":"","foo":1}`, false},
+		{"short authenticator data", `{"authenticatorData":"AAAA","clientDataJSON":"e30=","signature":"AA=="}`, false},
+		{
+			"valid",
+			fmt.Sprintf(`{"authenticatorData":%q,"clientDataJSON":"e30=","signature":"AA=="}`, base64.StdEncoding.EncodeToString(make([]byte, 37))),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := webauthn.ParseAssertion([]byte(tc.bz))
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
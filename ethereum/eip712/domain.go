@@ -16,6 +16,8 @@
 package eip712
 
 import (
+	"fmt"
+
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 )
@@ -32,3 +34,15 @@ func createEIP712Domain(chainID uint64) apitypes.TypedDataDomain {
 
 	return domain
 }
+
+// createProtoEIP712Domain creates the typed data domain of the proto encoding.
+// The domain is bound to the Cosmos chain ID and to the encoding version, so a
+// signature cannot be replayed on another chain sharing the EIP-155 chain ID or
+// decoded with another encoding.
+func createProtoEIP712Domain(chainID string, eip155ChainID uint64, version uint32) apitypes.TypedDataDomain {
+	return apitypes.TypedDataDomain{
+		Name:    chainID,
+		Version: fmt.Sprintf("%d", version),
+		ChainId: math.NewHexOrDecimal256(int64(eip155ChainID)), // #nosec G701
+	}
+}
@@ -0,0 +1,455 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package eip712
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	txTypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	hetutypes "github.com/hetu-project/hetu/v1/types"
+)
+
+// ProtoEncodingVersion defines the EIP-712 encoding version that derives the
+// typed struct of each message from its proto descriptor. Unlike the JSON
+// encodings, it supports transactions with messages of different types.
+const ProtoEncodingVersion uint32 = 2
+
+const (
+	feeTypeName = "cosmos.tx.v1beta1.Fee"
+
+	timestampTypeName = "google.protobuf.Timestamp"
+	durationTypeName  = "google.protobuf.Duration"
+)
+
+// ProtoTxData defines the transaction fields covered by the proto EIP-712 encoding.
+type ProtoTxData struct {
+	ChainID       string
+	AccountNumber uint64
+	Sequence      uint64
+	TimeoutHeight uint64
+	Fee           txTypes.Fee
+	Memo          string
+	Msgs          []*codectypes.Any
+}
+
+// NewProtoTxData returns the transaction fields covered by the proto EIP-712
+// encoding for the given signer data. The fee payer is always set, so it is the
+// same whether it is defined on the transaction or defaults to the first signer.
+func NewProtoTxData(tx authsigning.Tx, chainID string, accountNumber, sequence uint64) (ProtoTxData, error) {
+	msgs := tx.GetMsgs()
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return ProtoTxData{}, err
+		}
+		anys[i] = msgAny
+	}
+
+	fee := txTypes.Fee{
+		Amount:   tx.GetFee(),
+		GasLimit: tx.GetGas(),
+		Payer:    sdk.AccAddress(tx.FeePayer()).String(),
+	}
+	if granter := tx.FeeGranter(); len(granter) != 0 {
+		fee.Granter = sdk.AccAddress(granter).String()
+	}
+
+	return ProtoTxData{
+		ChainID:       chainID,
+		AccountNumber: accountNumber,
+		Sequence:      sequence,
+		TimeoutHeight: tx.GetTimeoutHeight(),
+		Fee:           fee,
+		Memo:          tx.GetMemo(),
+		Msgs:          anys,
+	}, nil
+}
+
+// ProtoWrapTxToTypedData wraps the transaction into an EIP-712 TypedData request
+// whose message types are derived from the proto descriptors of the transaction
+// messages. Each message is set on its own "msg{i}" field of the Tx type.
+func ProtoWrapTxToTypedData(data ProtoTxData) (typedData apitypes.TypedData, err error) {
+	defer doRecover(&err)
+
+	if len(data.Msgs) == 0 {
+		return apitypes.TypedData{}, errors.New("unable to build EIP-712 payload: transaction does contain any messages")
+	}
+
+	chainID, err := hetutypes.ParseChainID(data.ChainID)
+	if err != nil {
+		return apitypes.TypedData{}, fmt.Errorf("invalid chain ID passed as argument: %w", err)
+	}
+
+	builder := newProtoTypesBuilder()
+
+	feeBz, err := data.Fee.Marshal()
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+	feeType, fee, err := builder.addMessage(protoreflect.FullName(feeTypeName), feeBz)
+	if err != nil {
+		return apitypes.TypedData{}, errorsmod.Wrap(err, "failed to encode fee")
+	}
+
+	builder.types[txField] = []apitypes.Type{
+		{Name: "account_number", Type: "uint64"},
+		{Name: "chain_id", Type: ethString},
+		{Name: "fee", Type: feeType},
+		{Name: "memo", Type: ethString},
+		{Name: "sequence", Type: "uint64"},
+		{Name: "timeout_height", Type: "uint64"},
+	}
+	message := map[string]interface{}{
+		"account_number": strconv.FormatUint(data.AccountNumber, 10),
+		"chain_id":       data.ChainID,
+		"fee":            fee,
+		"memo":           data.Memo,
+		"sequence":       strconv.FormatUint(data.Sequence, 10),
+		"timeout_height": strconv.FormatUint(data.TimeoutHeight, 10),
+	}
+
+	for i, msg := range data.Msgs {
+		if msg == nil {
+			return apitypes.TypedData{}, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "msg at index %d is empty", i)
+		}
+
+		msgType, msgValue, err := builder.addMessage(protoMessageName(msg.TypeUrl), msg.Value)
+		if err != nil {
+			return apitypes.TypedData{}, errorsmod.Wrapf(err, "failed to encode msg at index %d", i)
+		}
+
+		field := msgFieldForIndex(i)
+		builder.types[txField] = append(builder.types[txField], apitypes.Type{Name: field, Type: msgType})
+		message[field] = msgValue
+	}
+
+	builder.types["EIP712Domain"] = []apitypes.Type{
+		{Name: "name", Type: ethString},
+		{Name: "version", Type: ethString},
+		{Name: "chainId", Type: "uint256"},
+	}
+
+	return apitypes.TypedData{
+		Types:       builder.types,
+		PrimaryType: txField,
+		Domain:      createProtoEIP712Domain(data.ChainID, chainID.Uint64(), ProtoEncodingVersion),
+		Message:     message,
+	}, nil
+}
+
+// protoMessageName returns the message full name of an Any type URL.
+func protoMessageName(typeURL string) protoreflect.FullName {
+	return protoreflect.FullName(typeURL[strings.LastIndex(typeURL, "/")+1:])
+}
+
+// protoTypesBuilder accumulates the EIP-712 types derived from proto descriptors.
+type protoTypesBuilder struct {
+	types apitypes.Types
+	// names maps the EIP-712 type names to the proto messages defining them
+	names map[string]protoreflect.FullName
+	// visiting holds the messages being defined to reject recursive types
+	visiting map[protoreflect.FullName]bool
+}
+
+func newProtoTypesBuilder() *protoTypesBuilder {
+	return &protoTypesBuilder{
+		types:    apitypes.Types{},
+		names:    map[string]protoreflect.FullName{},
+		visiting: map[protoreflect.FullName]bool{},
+	}
+}
+
+// addMessage decodes the proto encoded message, adds the types of its
+// descriptor and returns its EIP-712 type name and value.
+func (b *protoTypesBuilder) addMessage(name protoreflect.FullName, bz []byte) (string, map[string]interface{}, error) {
+	msg, err := unmarshalProtoMessage(name, bz)
+	if err != nil {
+		return "", nil, err
+	}
+
+	typeName, err := b.addMessageTypes(msg.Descriptor())
+	if err != nil {
+		return "", nil, err
+	}
+
+	value, err := protoMessageValue(msg)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return typeName, value, nil
+}
+
+// addMessageTypes adds the EIP-712 type of the message descriptor, along with
+// the types of its message fields, and returns the message type name.
+func (b *protoTypesBuilder) addMessageTypes(md protoreflect.MessageDescriptor) (string, error) {
+	typeName := protoTypeName(md.FullName())
+
+	if b.visiting[md.FullName()] {
+		return "", errorsmod.Wrapf(errortypes.ErrInvalidType, "recursive message type %s is not supported", md.FullName())
+	}
+	if fullName, found := b.names[typeName]; found {
+		if fullName != md.FullName() {
+			return "", errorsmod.Wrapf(errortypes.ErrInvalidType, "messages %s and %s share the type name %s", fullName, md.FullName(), typeName)
+		}
+		return typeName, nil
+	}
+
+	b.visiting[md.FullName()] = true
+	defer delete(b.visiting, md.FullName())
+
+	fields := md.Fields()
+	types := make([]apitypes.Type, 0, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+
+		fieldType, err := b.fieldType(fd)
+		if err != nil {
+			return "", err
+		}
+		types = append(types, apitypes.Type{Name: string(fd.Name()), Type: fieldType})
+	}
+
+	b.names[typeName] = md.FullName()
+	b.types[typeName] = types
+
+	return typeName, nil
+}
+
+// fieldType returns the EIP-712 type of the field descriptor. Map fields are
+// represented as arrays of their key-value entries.
+func (b *protoTypesBuilder) fieldType(fd protoreflect.FieldDescriptor) (string, error) {
+	if fd.IsMap() {
+		entryType, err := b.addMessageTypes(fd.Message())
+		if err != nil {
+			return "", err
+		}
+		return entryType + "[]", nil
+	}
+
+	var fieldType string
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		fieldType = ethBool
+	case protoreflect.StringKind, protoreflect.EnumKind, protoreflect.FloatKind, protoreflect.DoubleKind:
+		fieldType = ethString
+	case protoreflect.BytesKind:
+		fieldType = "bytes"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		fieldType = "int32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		fieldType = ethInt64
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		fieldType = "uint32"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		fieldType = "uint64"
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		switch fd.Message().FullName() {
+		case timestampTypeName, durationTypeName:
+			fieldType = ethString
+		default:
+			var err error
+			if fieldType, err = b.addMessageTypes(fd.Message()); err != nil {
+				return "", err
+			}
+		}
+	default:
+		return "", errorsmod.Wrapf(errortypes.ErrInvalidType, "unsupported kind %s of field %s", fd.Kind(), fd.FullName())
+	}
+
+	if fd.IsList() {
+		fieldType += "[]"
+	}
+	return fieldType, nil
+}
+
+// protoTypeName converts a proto message full name into an EIP-712 type name,
+// e.g. cosmos.bank.v1beta1.MsgSend becomes CosmosBankV1beta1MsgSend.
+func protoTypeName(name protoreflect.FullName) string {
+	buf := new(bytes.Buffer)
+	for _, part := range strings.FieldsFunc(string(name), func(r rune) bool { return r == '.' || r == '_' }) {
+		buf.WriteString(strings.ToUpper(part[:1]))
+		buf.WriteString(part[1:])
+	}
+	return buf.String()
+}
+
+// unmarshalProtoMessage decodes the message bytes using the registered pulsar
+// type of the message, or a dynamic message built from its gogoproto descriptor.
+func unmarshalProtoMessage(name protoreflect.FullName, bz []byte) (protoreflect.Message, error) {
+	msgType, err := protoregistry.GlobalTypes.FindMessageByName(name)
+	if err != nil {
+		desc, err := gogoproto.HybridResolver.FindDescriptorByName(name)
+		if err != nil {
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidType, "unknown message type %s", name)
+		}
+		md, ok := desc.(protoreflect.MessageDescriptor)
+		if !ok {
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidType, "%s is not a message type", name)
+		}
+		msgType = dynamicpb.NewMessageType(md)
+	}
+
+	msg := msgType.New()
+	if err := proto.Unmarshal(bz, msg.Interface()); err != nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidType, "failed to unmarshal %s: %s", name, err)
+	}
+	return msg, nil
+}
+
+// protoMessageValue returns the EIP-712 value of the message. Unset fields are
+// set to their default values, since every field of the type is signed.
+func protoMessageValue(msg protoreflect.Message) (map[string]interface{}, error) {
+	// unknown fields would not be covered by the signature
+	if len(msg.GetUnknown()) != 0 {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "message %s contains unknown fields", msg.Descriptor().FullName())
+	}
+
+	fields := msg.Descriptor().Fields()
+	value := make(map[string]interface{}, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+
+		fieldValue, err := protoFieldValue(fd, msg.Get(fd))
+		if err != nil {
+			return nil, err
+		}
+		value[string(fd.Name())] = fieldValue
+	}
+
+	return value, nil
+}
+
+// protoFieldValue returns the EIP-712 value of a field.
+func protoFieldValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) (interface{}, error) {
+	switch {
+	case fd.IsMap():
+		return protoMapValue(fd, v.Map())
+	case fd.IsList():
+		list := v.List()
+		values := make([]interface{}, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			value, err := protoSingularValue(fd, list.Get(i))
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value
+		}
+		return values, nil
+	default:
+		return protoSingularValue(fd, v)
+	}
+}
+
+// protoMapValue returns the map entries sorted by key as an array of key-value objects.
+func protoMapValue(fd protoreflect.FieldDescriptor, m protoreflect.Map) ([]interface{}, error) {
+	keys := make([]protoreflect.MapKey, 0, m.Len())
+	m.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, key)
+		return true
+	})
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
+	entries := make([]interface{}, len(keys))
+	for i, key := range keys {
+		k, err := protoSingularValue(fd.MapKey(), key.Value())
+		if err != nil {
+			return nil, err
+		}
+		v, err := protoSingularValue(fd.MapValue(), m.Get(key))
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = map[string]interface{}{
+			string(fd.MapKey().Name()):   k,
+			string(fd.MapValue().Name()): v,
+		}
+	}
+
+	return entries, nil
+}
+
+// protoSingularValue returns the EIP-712 value of a single field element.
+// Integers are formatted as decimal strings to keep 64 bits precision in JSON.
+func protoSingularValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) (interface{}, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return v.Bool(), nil
+	case protoreflect.StringKind:
+		return v.String(), nil
+	case protoreflect.BytesKind:
+		return hexutil.Bytes(v.Bytes()), nil
+	case protoreflect.EnumKind:
+		if enumValue := fd.Enum().Values().ByNumber(v.Enum()); enumValue != nil {
+			return string(enumValue.Name()), nil
+		}
+		return strconv.FormatInt(int64(v.Enum()), 10), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case protoreflect.FloatKind:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 32), nil
+	case protoreflect.DoubleKind:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64), nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		msg := v.Message()
+		switch fd.Message().FullName() {
+		case timestampTypeName:
+			seconds, nanos := protoSecondsAndNanos(msg)
+			return time.Unix(seconds, nanos).UTC().Format(time.RFC3339Nano), nil
+		case durationTypeName:
+			seconds, nanos := protoSecondsAndNanos(msg)
+			return (time.Duration(seconds)*time.Second + time.Duration(nanos)).String(), nil
+		default:
+			return protoMessageValue(msg)
+		}
+	default:
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidType, "unsupported kind %s of field %s", fd.Kind(), fd.FullName())
+	}
+}
+
+// protoSecondsAndNanos returns the fields of a Timestamp or Duration message.
+func protoSecondsAndNanos(msg protoreflect.Message) (int64, int64) {
+	fields := msg.Descriptor().Fields()
+	return msg.Get(fields.ByName("seconds")).Int(), msg.Get(fields.ByName("nanos")).Int()
+}
@@ -0,0 +1,151 @@
+package eip712_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/ethereum/eip712"
+	"github.com/hetu-project/hetu/v1/testutil/tx"
+	"github.com/hetu-project/hetu/v1/utils"
+)
+
+const protoChainID = "hetu_560000-1"
+
+func newProtoTxData(t *testing.T, msgs ...sdk.Msg) eip712.ProtoTxData {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		anys[i] = msgAny
+	}
+
+	return eip712.ProtoTxData{
+		ChainID:       protoChainID,
+		AccountNumber: 8,
+		Sequence:      5,
+		Fee: txtypes.Fee{
+			Amount:   sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, math.NewInt(2000))),
+			GasLimit: 200000,
+		},
+		Memo: "memo",
+		Msgs: anys,
+	}
+}
+
+func TestProtoWrapTxToTypedData(t *testing.T) {
+	from := sdk.AccAddress(tx.GenerateAddress().Bytes())
+	to := sdk.AccAddress(tx.GenerateAddress().Bytes())
+	valAddr := sdk.ValAddress(tx.GenerateAddress().Bytes())
+	amount := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, math.NewInt(1)))
+
+	send := banktypes.NewMsgSend(from, to, amount)
+	delegate := stakingtypes.NewMsgDelegate(from.String(), valAddr.String(), amount[0])
+	vote := govtypesv1.NewMsgVote(from, 1, govtypesv1.OptionYes, "")
+
+	data := newProtoTxData(t, send, delegate, vote)
+	typedData, err := eip712.ProtoWrapTxToTypedData(data)
+	require.NoError(t, err)
+
+	// the domain is bound to the chain and the encoding version
+	require.Equal(t, protoChainID, typedData.Domain.Name)
+	require.Equal(t, "2", typedData.Domain.Version)
+	require.Equal(t, int64(560000), (*big.Int)(typedData.Domain.ChainId).Int64())
+
+	// messages of different types are set on their own fields
+	require.Equal(t, "CosmosBankV1beta1MsgSend", typeOfField(typedData, "Tx", "msg0"))
+	require.Equal(t, "CosmosStakingV1beta1MsgDelegate", typeOfField(typedData, "Tx", "msg1"))
+	require.Equal(t, "CosmosGovV1MsgVote", typeOfField(typedData, "Tx", "msg2"))
+	require.Equal(t, "CosmosBaseV1beta1Coin[]", typeOfField(typedData, "CosmosBankV1beta1MsgSend", "amount"))
+	require.Equal(t, "uint64", typeOfField(typedData, "CosmosGovV1MsgVote", "proposal_id"))
+	require.Equal(t, "VOTE_OPTION_YES", typedData.Message["msg2"].(map[string]interface{})["option"])
+
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+
+	// the encoding is deterministic
+	typedData, err = eip712.ProtoWrapTxToTypedData(newProtoTxData(t, send, delegate, vote))
+	require.NoError(t, err)
+	sameHash, _, err := apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+	require.Equal(t, hash, sameHash)
+
+	// every signed field changes the hash
+	data.Sequence++
+	typedData, err = eip712.ProtoWrapTxToTypedData(data)
+	require.NoError(t, err)
+	otherHash, _, err := apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherHash)
+}
+
+func TestProtoWrapTxToTypedDataEmptyFields(t *testing.T) {
+	// the types are derived from the descriptors, so empty repeated and
+	// message fields are still typed
+	typedData, err := eip712.ProtoWrapTxToTypedData(newProtoTxData(t, &banktypes.MsgSend{}))
+	require.NoError(t, err)
+	require.Equal(t, "CosmosBaseV1beta1Coin[]", typeOfField(typedData, "CosmosBankV1beta1MsgSend", "amount"))
+
+	_, _, err = apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+}
+
+func TestProtoWrapTxToTypedDataErrors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(data *eip712.ProtoTxData)
+	}{
+		{
+			"no messages",
+			func(data *eip712.ProtoTxData) {
+				data.Msgs = nil
+			},
+		},
+		{
+			"unknown message type",
+			func(data *eip712.ProtoTxData) {
+				data.Msgs[0].TypeUrl = "/hetu.unknown.v1.MsgUnknown"
+			},
+		},
+		{
+			"unknown message fields",
+			func(data *eip712.ProtoTxData) {
+				data.Msgs[0].Value = append(data.Msgs[0].Value, 0xf8, 0x01, 0x01)
+			},
+		},
+		{
+			"invalid chain ID",
+			func(data *eip712.ProtoTxData) {
+				data.ChainID = "hetu"
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := newProtoTxData(t, &banktypes.MsgSend{})
+			tc.malleate(&data)
+
+			_, err := eip712.ProtoWrapTxToTypedData(data)
+			require.Error(t, err)
+		})
+	}
+}
+
+func typeOfField(typedData apitypes.TypedData, typeName, field string) string {
+	for _, t := range typedData.Types[typeName] {
+		if t.Name == field {
+			return t.Type
+		}
+	}
+	return ""
+}
@@ -103,7 +103,7 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ExecTxRe
 				EthTxIndex: ethTxIndex,
 			}
 			if result.Code != abci.CodeTypeOK {
-				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
+				// exceeds block gas limit or reverted batch scenario, set gas used to gas limit because that's what's charged by ante handler.
 				// some old versions don't emit any events, so workaround here directly.
 				txResult.GasUsed = ethMsg.GetGas()
 				txResult.Failed = true
@@ -218,10 +218,15 @@ func isEthTx(tx sdk.Tx) bool {
 		return false
 	}
 	opts := extTx.GetExtensionOptions()
-	if len(opts) != 1 || opts[0].GetTypeUrl() != "/ethermint.evm.v1.ExtensionOptionsEthereumTx" {
+	if len(opts) != 1 {
+		return false
+	}
+	switch opts[0].GetTypeUrl() {
+	case "/ethermint.evm.v1.ExtensionOptionsEthereumTx", evmtypes.BatchTxExtensionOption:
+		return true
+	default:
 		return false
 	}
-	return true
 }
 
 // saveTxResult index the txResult into the kv db batch
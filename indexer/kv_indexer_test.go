@@ -42,6 +42,7 @@ func TestKVIndexer(t *testing.T) {
 	txHash := tx.AsTransaction().Hash()
 
 	encodingConfig := evmenc.MakeConfig()
+	types.RegisterInterfaces(encodingConfig.InterfaceRegistry)
 	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
 
 	// build cosmos-sdk wrapper tx
@@ -50,6 +51,16 @@ func TestKVIndexer(t *testing.T) {
 	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
 	require.NoError(t, err)
 
+	// build an atomic batch wrapper tx
+	ethTxParams.Nonce = 1
+	tx2 := types.NewTx(&ethTxParams)
+	tx2.From = from.Hex()
+	require.NoError(t, tx2.Sign(ethSigner, signer))
+	batchTx, err := types.BuildBatchTx(clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom, tx, tx2)
+	require.NoError(t, err)
+	batchBz, err := clientCtx.TxConfig.TxEncoder()(batchTx)
+	require.NoError(t, err)
+
 	// build an invalid wrapper tx
 	builder := clientCtx.TxConfig.NewTxBuilder()
 	require.NoError(t, builder.SetMsgs(tx))
@@ -117,6 +128,19 @@ func TestKVIndexer(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"success, reverted batch",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{batchBz}}},
+			[]*abci.ExecTxResult{
+				{
+					Code:      types.ErrBatchReverted.ABCICode(),
+					Codespace: types.ErrBatchReverted.Codespace(),
+					Log:       "ethereum tx batch reverted",
+					Events:    []abci.Event{},
+				},
+			},
+			true,
+		},
 		{
 			"fail, failed eth tx",
 			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}},
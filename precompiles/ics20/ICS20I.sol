@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The ICS20 precompile is deployed at this address.
+address constant ICS20_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000802;
+
+/// @dev The ICS20 precompile instance.
+ICS20I constant ICS20_CONTRACT = ICS20I(ICS20_PRECOMPILE_ADDRESS);
+
+/// @title ICS20 transfer interface
+/// @notice Starts ICS20 fungible token transfers from the caller's bank
+/// balance and queries their progress. ERC20 tokens of registered token
+/// pairs must be converted to their Cosmos coin before being transferred,
+/// and the EVM denomination cannot be transferred through the precompile.
+interface ICS20I {
+    /// @dev Emitted when a transfer packet is sent.
+    event IBCTransfer(
+        address indexed sender,
+        string sourcePort,
+        string sourceChannel,
+        string denom,
+        uint256 amount,
+        string receiver,
+        uint64 sequence
+    );
+
+    /// @dev Sends `amount` of `denom` from the caller to `receiver` on the
+    /// counterparty chain and returns the sequence of the sent packet.
+    function transfer(
+        string memory sourcePort,
+        string memory sourceChannel,
+        string memory denom,
+        uint256 amount,
+        string memory receiver,
+        uint64 revisionNumber,
+        uint64 revisionHeight,
+        uint64 timeoutTimestamp,
+        string memory memo
+    ) external returns (uint64 sequence);
+
+    /// @dev Returns the denomination trace of an IBC voucher from its hash.
+    function denomTrace(
+        string memory hash
+    ) external view returns (string memory path, string memory baseDenom);
+
+    /// @dev Returns true while the packet has been neither acknowledged nor
+    /// timed out. Once it returns false, the transfer either succeeded or
+    /// the tokens were refunded to the sender.
+    function outstandingPacket(
+        string memory sourcePort,
+        string memory sourceChannel,
+        uint64 sequence
+    ) external view returns (bool outstanding);
+}
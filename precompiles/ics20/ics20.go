@@ -0,0 +1,151 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package ics20
+
+import (
+	"bytes"
+	_ "embed" // embed the precompile ABI
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/hetu-project/hetu/v1/x/evm/statedb"
+)
+
+const (
+	// PrecompileAddress defines the address of the ICS20 precompiled contract
+	PrecompileAddress = "0x0000000000000000000000000000000000000802"
+
+	// TransferMethod defines the ABI method name of the ICS20 transfer
+	TransferMethod = "transfer"
+	// DenomTraceMethod defines the ABI method name of the denomination trace query
+	DenomTraceMethod = "denomTrace"
+	// OutstandingPacketMethod defines the ABI method name of the outstanding packet query
+	OutstandingPacketMethod = "outstandingPacket"
+
+	// EventTypeIBCTransfer defines the event emitted when a transfer packet is sent
+	EventTypeIBCTransfer = "IBCTransfer"
+
+	// TransferGas defines the gas cost of an ICS20 transfer
+	TransferGas uint64 = 150_000
+	// QueryGas defines the gas cost of the queries
+	QueryGas uint64 = 5_000
+)
+
+var (
+	//go:embed abi.json
+	abiJSON []byte
+
+	// ABI is the ABI of the ICS20 precompiled contract
+	ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ABI, err = abi.JSON(bytes.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+var _ vm.PrecompiledContract = Precompile{}
+
+// Precompile defines the ICS20 precompiled contract, which lets contracts
+// start ICS20 transfers of the bank coins they hold and follow the packets
+// they have sent.
+type Precompile struct {
+	transferKeeper TransferKeeper
+	channelKeeper  ChannelKeeper
+	bankKeeper     BankKeeper
+	evmKeeper      EVMKeeper
+}
+
+// NewPrecompile creates a new ICS20 precompiled contract.
+func NewPrecompile(
+	transferKeeper TransferKeeper,
+	channelKeeper ChannelKeeper,
+	bankKeeper BankKeeper,
+	evmKeeper EVMKeeper,
+) Precompile {
+	return Precompile{
+		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
+		bankKeeper:     bankKeeper,
+		evmKeeper:      evmKeeper,
+	}
+}
+
+// Address returns the address of the ICS20 precompiled contract.
+func (Precompile) Address() common.Address {
+	return common.HexToAddress(PrecompileAddress)
+}
+
+// RequiredGas returns the gas cost of the method called with the given input.
+func (Precompile) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := ABI.MethodById(input[:4])
+	if err != nil {
+		return 0
+	}
+
+	if method.Name == TransferMethod {
+		return TransferGas
+	}
+	return QueryGas
+}
+
+// Run executes the ICS20 precompiled contract.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	stateDB, ok := evm.StateDB.(statedb.ExtStateDB)
+	if !ok {
+		return nil, errors.New("ics20 precompile requires a state database that supports native actions")
+	}
+
+	if len(contract.Input) < 4 {
+		return nil, vm.ErrExecutionReverted
+	}
+
+	method, err := ABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	case TransferMethod:
+		if readonly {
+			return nil, vm.ErrWriteProtection
+		}
+		return p.Transfer(stateDB, contract, method, args)
+	case DenomTraceMethod:
+		return p.DenomTrace(stateDB.NativeContext(), method, args)
+	case OutstandingPacketMethod:
+		return p.OutstandingPacket(stateDB.NativeContext(), method, args)
+	default:
+		return nil, fmt.Errorf("unknown method %s", method.Name)
+	}
+}
@@ -0,0 +1,190 @@
+package ics20_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/precompiles/ics20"
+	"github.com/hetu-project/hetu/v1/x/evm/statedb"
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+const (
+	evmDenom     = "ahetu"
+	voucherDenom = "uatom"
+)
+
+var caller = common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+type mockTransferKeeper struct {
+	msgs   []*transfertypes.MsgTransfer
+	traces map[string]transfertypes.DenomTrace
+}
+
+func (m *mockTransferKeeper) Transfer(_ context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	m.msgs = append(m.msgs, msg)
+	return &transfertypes.MsgTransferResponse{Sequence: uint64(len(m.msgs))}, nil
+}
+
+func (m *mockTransferKeeper) GetDenomTrace(_ sdk.Context, hash tmbytes.HexBytes) (transfertypes.DenomTrace, bool) {
+	trace, found := m.traces[hash.String()]
+	return trace, found
+}
+
+type mockChannelKeeper struct{}
+
+func (mockChannelKeeper) HasPacketCommitment(_ sdk.Context, _, _ string, sequence uint64) bool {
+	return sequence == 1
+}
+
+type mockBankKeeper struct{}
+
+func (mockBankKeeper) GetBalance(_ context.Context, _ sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, sdkmath.NewInt(1000))
+}
+
+type mockEVMKeeper struct{}
+
+func (mockEVMKeeper) GetParams(_ sdk.Context) evmtypes.Params {
+	params := evmtypes.DefaultParams()
+	params.EvmDenom = evmDenom
+	return params
+}
+
+func setup(t *testing.T) (ics20.Precompile, *mockTransferKeeper, *vm.EVM, *statedb.StateDB) {
+	t.Helper()
+
+	trace := transfertypes.ParseDenomTrace("transfer/channel-0/" + voucherDenom)
+	transferKeeper := &mockTransferKeeper{
+		traces: map[string]transfertypes.DenomTrace{trace.Hash().String(): trace},
+	}
+	p := ics20.NewPrecompile(transferKeeper, mockChannelKeeper{}, mockBankKeeper{}, mockEVMKeeper{})
+
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+	db := statedb.New(ctx, nil, statedb.NewEmptyTxConfig(common.Hash{}))
+	evm := vm.NewEVM(vm.BlockContext{BlockNumber: big.NewInt(1)}, vm.TxContext{}, db, params.TestChainConfig, vm.Config{})
+
+	return p, transferKeeper, evm, db
+}
+
+func run(p ics20.Precompile, evm *vm.EVM, readonly bool, method string, args ...interface{}) ([]byte, error) {
+	input, err := ics20.ABI.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	contract := vm.NewPrecompile(vm.AccountRef(caller), vm.AccountRef(p.Address()), big.NewInt(0), p.RequiredGas(input))
+	contract.Input = input
+	return p.Run(evm, contract, readonly)
+}
+
+func TestRequiredGas(t *testing.T) {
+	p := ics20.Precompile{}
+
+	for _, tc := range []struct {
+		method string
+		expGas uint64
+	}{
+		{ics20.TransferMethod, ics20.TransferGas},
+		{ics20.DenomTraceMethod, ics20.QueryGas},
+		{ics20.OutstandingPacketMethod, ics20.QueryGas},
+	} {
+		require.Equal(t, tc.expGas, p.RequiredGas(ics20.ABI.Methods[tc.method].ID), tc.method)
+	}
+
+	require.Zero(t, p.RequiredGas(nil))
+	require.Zero(t, p.RequiredGas([]byte{1, 2, 3, 4}))
+}
+
+func TestTransfer(t *testing.T) {
+	transferArgs := func(denom string, amount int64) []interface{} {
+		return []interface{}{
+			"transfer", "channel-0", denom, big.NewInt(amount), "cosmos1receiver",
+			uint64(1), uint64(100), uint64(0), "",
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		readonly bool
+		args     []interface{}
+		expPass  bool
+	}{
+		{"pass", false, transferArgs(voucherDenom, 100), true},
+		{"fail - static call", true, transferArgs(voucherDenom, 100), false},
+		{"fail - zero amount", false, transferArgs(voucherDenom, 0), false},
+		{"fail - insufficient balance", false, transferArgs(voucherDenom, 1001), false},
+		{"fail - EVM denomination", false, transferArgs(evmDenom, 100), false},
+		{"fail - invalid channel", false, []interface{}{
+			"transfer", "", voucherDenom, big.NewInt(100), "cosmos1receiver",
+			uint64(1), uint64(100), uint64(0), "",
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, transferKeeper, evm, db := setup(t)
+
+			bz, err := run(p, evm, tc.readonly, ics20.TransferMethod, tc.args...)
+			if !tc.expPass {
+				require.Error(t, err)
+				require.Empty(t, transferKeeper.msgs)
+				require.Empty(t, db.Logs())
+				return
+			}
+
+			require.NoError(t, err)
+			out, err := ics20.ABI.Unpack(ics20.TransferMethod, bz)
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), out[0])
+
+			require.Len(t, transferKeeper.msgs, 1)
+			require.Equal(t, sdk.AccAddress(caller.Bytes()).String(), transferKeeper.msgs[0].Sender)
+
+			logs := db.Logs()
+			require.Len(t, logs, 1)
+			require.Equal(t, ics20.ABI.Events[ics20.EventTypeIBCTransfer].ID, logs[0].Topics[0])
+			require.Equal(t, common.BytesToHash(caller.Bytes()), logs[0].Topics[1])
+		})
+	}
+}
+
+func TestQueries(t *testing.T) {
+	p, _, evm, _ := setup(t)
+	trace := transfertypes.ParseDenomTrace("transfer/channel-0/" + voucherDenom)
+
+	bz, err := run(p, evm, true, ics20.DenomTraceMethod, trace.IBCDenom())
+	require.NoError(t, err)
+	out, err := ics20.ABI.Unpack(ics20.DenomTraceMethod, bz)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{trace.Path, trace.BaseDenom}, out)
+
+	_, err = run(p, evm, true, ics20.DenomTraceMethod, transfertypes.ParseDenomTrace("transfer/channel-1/uosmo").Hash().String())
+	require.Error(t, err)
+
+	for _, tc := range []struct {
+		sequence       uint64
+		expOutstanding bool
+	}{
+		{1, true},
+		{2, false},
+	} {
+		bz, err := run(p, evm, true, ics20.OutstandingPacketMethod, "transfer", "channel-0", tc.sequence)
+		require.NoError(t, err)
+		out, err := ics20.ABI.Unpack(ics20.OutstandingPacketMethod, bz)
+		require.NoError(t, err)
+		require.Equal(t, tc.expOutstanding, out[0])
+	}
+}
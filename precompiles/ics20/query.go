@@ -0,0 +1,78 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package ics20
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// DenomTrace returns the denomination trace of an IBC voucher from its hash.
+// The hash may be given with or without the "ibc/" prefix.
+func (p Precompile) DenomTrace(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid number of arguments; expected 1, got %d", len(args))
+	}
+
+	hashStr, ok := args[0].(string)
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid hash type %T", args[0])
+	}
+
+	hash, err := transfertypes.ParseHexHash(strings.TrimPrefix(hashStr, transfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return nil, errorsmod.Wrap(transfertypes.ErrInvalidDenomForTransfer, err.Error())
+	}
+
+	denomTrace, found := p.transferKeeper.GetDenomTrace(ctx, hash)
+	if !found {
+		return nil, errorsmod.Wrapf(transfertypes.ErrTraceNotFound, "denomination trace not found for %s", hashStr)
+	}
+
+	return method.Outputs.Pack(denomTrace.Path, denomTrace.BaseDenom)
+}
+
+// OutstandingPacket returns whether a sent packet is still waiting for its
+// acknowledgement or timeout.
+func (p Precompile) OutstandingPacket(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid number of arguments; expected 3, got %d", len(args))
+	}
+
+	sourcePort, ok := args[0].(string)
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid source port type %T", args[0])
+	}
+
+	sourceChannel, ok := args[1].(string)
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid source channel type %T", args[1])
+	}
+
+	sequence, ok := args[2].(uint64)
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid sequence type %T", args[2])
+	}
+
+	outstanding := p.channelKeeper.HasPacketCommitment(ctx, sourcePort, sourceChannel, sequence)
+	return method.Outputs.Pack(outstanding)
+}
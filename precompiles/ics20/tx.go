@@ -0,0 +1,159 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package ics20
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/hetu-project/hetu/v1/x/evm/statedb"
+)
+
+// TransferInput defines the arguments of the transfer method.
+type TransferInput struct {
+	SourcePort       string
+	SourceChannel    string
+	Denom            string
+	Amount           *big.Int
+	Receiver         string
+	RevisionNumber   uint64
+	RevisionHeight   uint64
+	TimeoutTimestamp uint64
+	Memo             string
+}
+
+// NewMsgTransfer creates the ICS20 transfer message sent by the caller from
+// the arguments of the transfer method.
+func NewMsgTransfer(caller common.Address, method *abi.Method, args []interface{}) (*transfertypes.MsgTransfer, error) {
+	var input TransferInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	if input.Amount == nil || input.Amount.Sign() <= 0 {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid amount %s", input.Amount)
+	}
+
+	msg := transfertypes.NewMsgTransfer(
+		input.SourcePort,
+		input.SourceChannel,
+		sdk.NewCoin(input.Denom, sdkmath.NewIntFromBigInt(input.Amount)),
+		sdk.AccAddress(caller.Bytes()).String(),
+		input.Receiver,
+		clienttypes.NewHeight(input.RevisionNumber, input.RevisionHeight),
+		input.TimeoutTimestamp,
+		input.Memo,
+	)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// Transfer sends an ICS20 transfer packet from the caller's bank balance.
+// The coins must already be held in the bank: ERC20 tokens are not converted
+// and the EVM denomination is rejected, since both are part of the state
+// cached by the EVM during the call.
+func (p Precompile) Transfer(
+	stateDB statedb.ExtStateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if value := contract.Value(); value != nil && value.Sign() > 0 {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "transfer method is not payable")
+	}
+
+	msg, err := NewMsgTransfer(contract.CallerAddress, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := stateDB.NativeContext()
+	if msg.Token.Denom == p.evmKeeper.GetParams(ctx).EvmDenom {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidCoins, "cannot transfer the EVM denomination %s", msg.Token.Denom)
+	}
+
+	sender := sdk.AccAddress(contract.CallerAddress.Bytes())
+	if balance := p.bankKeeper.GetBalance(ctx, sender, msg.Token.Denom); balance.Amount.LT(msg.Token.Amount) {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"%s is smaller than %s; ERC20 tokens must be converted before being transferred",
+			balance, msg.Token,
+		)
+	}
+
+	var sequence uint64
+	if err := stateDB.ExecuteNativeAction(func(ctx sdk.Context) error {
+		res, err := p.transferKeeper.Transfer(ctx, msg)
+		if err != nil {
+			return err
+		}
+		sequence = res.Sequence
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := p.emitIBCTransfer(ctx, stateDB, contract.CallerAddress, msg, sequence); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(sequence)
+}
+
+// emitIBCTransfer adds the IBCTransfer log of a sent packet.
+func (p Precompile) emitIBCTransfer(
+	ctx sdk.Context,
+	stateDB statedb.ExtStateDB,
+	sender common.Address,
+	msg *transfertypes.MsgTransfer,
+	sequence uint64,
+) error {
+	event := ABI.Events[EventTypeIBCTransfer]
+	data, err := event.Inputs.NonIndexed().Pack(
+		msg.SourcePort,
+		msg.SourceChannel,
+		msg.Token.Denom,
+		msg.Token.Amount.BigInt(),
+		msg.Receiver,
+		sequence,
+	)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      []common.Hash{event.ID, common.BytesToHash(sender.Bytes())},
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+	return nil
+}
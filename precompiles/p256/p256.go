@@ -0,0 +1,99 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package p256
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// PrecompileAddress defines the address of the P256VERIFY precompiled contract (RIP-7212)
+	PrecompileAddress = "0x0000000000000000000000000000000000000100"
+
+	// VerifyGas defines the gas cost of a signature verification
+	VerifyGas uint64 = 3_450
+
+	// inputLength is the length of the hash, r, s, x and y words of the input
+	inputLength = 160
+)
+
+var (
+	_ vm.PrecompiledContract = Precompile{}
+
+	// validOutput is returned when the signature is valid
+	validOutput = common.LeftPadBytes([]byte{1}, 32)
+)
+
+// Precompile defines the P256VERIFY precompiled contract, which verifies
+// secp256r1 (NIST P-256) signatures as produced by passkeys and secure enclaves.
+type Precompile struct{}
+
+// NewPrecompile creates a new P256VERIFY precompiled contract.
+func NewPrecompile() Precompile {
+	return Precompile{}
+}
+
+// Address returns the address of the P256VERIFY precompiled contract.
+func (Precompile) Address() common.Address {
+	return common.HexToAddress(PrecompileAddress)
+}
+
+// RequiredGas returns the fixed gas cost of a signature verification.
+func (Precompile) RequiredGas(_ []byte) uint64 {
+	return VerifyGas
+}
+
+// Run verifies the signature (r, s) of the hash against the public key (x, y).
+// As defined by RIP-7212, it returns 1 as a 32 bytes word when the signature is
+// valid and an empty output, without error, otherwise.
+func (Precompile) Run(_ *vm.EVM, contract *vm.Contract, _ bool) ([]byte, error) {
+	if Verify(contract.Input) {
+		return validOutput, nil
+	}
+	return nil, nil
+}
+
+// Verify reports whether the 160 bytes input hash || r || s || x || y holds a
+// valid secp256r1 signature.
+func Verify(input []byte) bool {
+	if len(input) != inputLength {
+		return false
+	}
+
+	hash := input[:32]
+	r := new(big.Int).SetBytes(input[32:64])
+	s := new(big.Int).SetBytes(input[64:96])
+	x := new(big.Int).SetBytes(input[96:128])
+	y := new(big.Int).SetBytes(input[128:160])
+
+	curve := elliptic.P256()
+	n := curve.Params().N
+	if r.Sign() == 0 || r.Cmp(n) >= 0 || s.Sign() == 0 || s.Cmp(n) >= 0 {
+		return false
+	}
+
+	if !curve.IsOnCurve(x, y) {
+		return false
+	}
+
+	return ecdsa.Verify(&ecdsa.PublicKey{Curve: curve, X: x, Y: y}, hash, r, s)
+}
@@ -0,0 +1,87 @@
+package p256_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/precompiles/p256"
+)
+
+func TestRun(t *testing.T) {
+	privKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	hash := sha256.Sum256([]byte("message"))
+	r, s, err := ecdsa.Sign(rand.Reader, privKey, hash[:])
+	require.NoError(t, err)
+
+	encode := func(hash []byte, r, s, x, y *big.Int) []byte {
+		input := common.CopyBytes(hash)
+		for _, word := range []*big.Int{r, s, x, y} {
+			input = append(input, common.LeftPadBytes(word.Bytes(), 32)...)
+		}
+		return input
+	}
+
+	otherHash := sha256.Sum256([]byte("other"))
+	n := elliptic.P256().Params().N
+
+	testCases := []struct {
+		name   string
+		input  []byte
+		expOut []byte
+	}{
+		{
+			"valid signature",
+			encode(hash[:], r, s, privKey.X, privKey.Y),
+			common.LeftPadBytes([]byte{1}, 32),
+		},
+		{
+			"wrong hash",
+			encode(otherHash[:], r, s, privKey.X, privKey.Y),
+			nil,
+		},
+		{
+			"s out of range",
+			encode(hash[:], r, new(big.Int).Add(s, n), privKey.X, privKey.Y),
+			nil,
+		},
+		{
+			"zero r",
+			encode(hash[:], big.NewInt(0), s, privKey.X, privKey.Y),
+			nil,
+		},
+		{
+			"public key not on curve",
+			encode(hash[:], r, s, privKey.X, new(big.Int).Add(privKey.Y, big.NewInt(1))),
+			nil,
+		},
+		{
+			"invalid input length",
+			encode(hash[:], r, s, privKey.X, privKey.Y)[:159],
+			nil,
+		},
+	}
+
+	precompile := p256.NewPrecompile()
+	require.Equal(t, p256.VerifyGas, precompile.RequiredGas(nil))
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			contract := vm.NewContract(vm.AccountRef(common.Address{}), vm.AccountRef(precompile.Address()), big.NewInt(0), p256.VerifyGas)
+			contract.Input = tc.input
+
+			out, err := precompile.Run(nil, contract, true)
+			require.NoError(t, err)
+			require.Equal(t, tc.expOut, out)
+		})
+	}
+}
@@ -0,0 +1,304 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+// Package access implements the access control of the JSON-RPC servers: per-method allow
+// and deny lists, API keys with method scopes and token bucket rate limiting.
+package access
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/hetu-project/hetu/v1/server/config"
+)
+
+const (
+	// ErrCodeMethodNotAllowed is the JSON-RPC error code of the calls to a denied method.
+	ErrCodeMethodNotAllowed = -32004
+	// ErrCodeRateLimited is the JSON-RPC error code of the calls exceeding the rate limit.
+	ErrCodeRateLimited = -32005
+	// ErrCodeUnauthorized is the JSON-RPC error code of the requests with a missing or invalid API key.
+	ErrCodeUnauthorized = -32001
+	// ErrCodeInvalidRequest is the JSON-RPC error code of the requests whose methods can't be parsed.
+	ErrCodeInvalidRequest = -32600
+
+	// internalHeader is the header authenticating the requests forwarded by the WebSocket
+	// server, which are already checked.
+	internalHeader = "X-Hetu-Internal-Token"
+)
+
+var (
+	// ErrAPIKeyRequired is returned when the API key is required but not provided.
+	ErrAPIKeyRequired = &Error{Code: ErrCodeUnauthorized, Message: "API key required", Status: http.StatusUnauthorized}
+	// ErrInvalidAPIKey is returned when the provided API key is unknown.
+	ErrInvalidAPIKey = &Error{Code: ErrCodeUnauthorized, Message: "invalid API key", Status: http.StatusUnauthorized}
+	// ErrRateLimited is returned when the client exceeds its rate limit.
+	ErrRateLimited = &Error{Code: ErrCodeRateLimited, Message: "rate limit exceeded", Status: http.StatusTooManyRequests}
+)
+
+// Error is a JSON-RPC access control error.
+type Error struct {
+	Code    int
+	Message string
+	// Status is the HTTP status code of the response.
+	Status int
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string { return e.Message }
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *Error) ErrorCode() int { return e.Code }
+
+// methodNotAllowedError returns the error of a call to a denied method.
+func methodNotAllowedError(method string) *Error {
+	return &Error{
+		Code:    ErrCodeMethodNotAllowed,
+		Message: fmt.Sprintf("method %s is not allowed", method),
+		Status:  http.StatusOK,
+	}
+}
+
+// invalidRequestError returns the error of a request whose methods can't be parsed.
+func invalidRequestError(err error) *Error {
+	return &Error{
+		Code:    ErrCodeInvalidRequest,
+		Message: fmt.Sprintf("invalid request: %s", err),
+		Status:  http.StatusOK,
+	}
+}
+
+// Client is the identity of a JSON-RPC client.
+type Client struct {
+	// IP is the address of the client.
+	IP  string
+	key *config.JSONRPCAPIKey
+}
+
+// HasAPIKey returns true if the client is authenticated with an API key.
+func (c *Client) HasAPIKey() bool {
+	return c.key != nil
+}
+
+// bucketID returns the identifier of the token bucket of the client.
+func (c *Client) bucketID() string {
+	if c.key != nil {
+		return "key:" + c.key.Key
+	}
+	return "ip:" + c.IP
+}
+
+// Controller enforces the access control configuration of the JSON-RPC servers. A disabled
+// controller allows every request.
+type Controller struct {
+	cfg           config.JSONRPCAccessConfig
+	keys          map[string]*config.JSONRPCAPIKey
+	limiter       *limiter
+	internalToken string
+}
+
+// NewController returns a controller enforcing the given access control configuration.
+func NewController(cfg config.JSONRPCAccessConfig) (*Controller, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*config.JSONRPCAPIKey, len(cfg.APIKeys))
+	for i := range cfg.APIKeys {
+		keys[cfg.APIKeys[i].Key] = &cfg.APIKeys[i]
+	}
+
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+
+	return &Controller{
+		cfg:           cfg,
+		keys:          keys,
+		limiter:       newLimiter(),
+		internalToken: hex.EncodeToString(token),
+	}, nil
+}
+
+// Enabled returns true if the access control is enabled.
+func (c *Controller) Enabled() bool {
+	return c != nil && c.cfg.Enable
+}
+
+// Identify returns the client sending the request, identified by its API key and address.
+func (c *Controller) Identify(r *http.Request) (*Client, error) {
+	client := &Client{IP: c.clientIP(r)}
+	if !c.Enabled() {
+		return client, nil
+	}
+
+	key := requestAPIKey(r)
+	if key == "" {
+		if c.cfg.RequireAPIKey {
+			return nil, ErrAPIKeyRequired
+		}
+		return client, nil
+	}
+
+	apiKey, ok := c.keys[key]
+	if !ok {
+		return nil, ErrInvalidAPIKey
+	}
+
+	client.key = apiKey
+	return client, nil
+}
+
+// Authorize returns an error if the client isn't allowed to call one of the methods, or if
+// the cost of the calls exceeds its rate limit. The calls are charged even if the methods
+// can't be parsed.
+func (c *Controller) Authorize(client *Client, methods []string) error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	cost := float64(0)
+	for _, method := range methods {
+		if !c.isAllowed(client, method) {
+			return methodNotAllowedError(method)
+		}
+		cost += c.methodCost(method)
+	}
+
+	if cost == 0 {
+		cost = 1
+	}
+
+	rate, burst := c.cfg.RateLimit, c.cfg.RateBurst
+	if client.key != nil {
+		if client.key.RateLimit != 0 {
+			rate = client.key.RateLimit
+		}
+		if client.key.RateBurst != 0 {
+			burst = client.key.RateBurst
+		}
+	}
+
+	if !c.limiter.allow(client.bucketID(), rate, burst, cost) {
+		return ErrRateLimited
+	}
+	return nil
+}
+
+// isAllowed returns true if the client can call the method. The method scopes of the API
+// keys replace the allow and deny lists.
+func (c *Controller) isAllowed(client *Client, method string) bool {
+	if client.key != nil && len(client.key.Methods) > 0 {
+		return matchAny(client.key.Methods, method)
+	}
+
+	if matchAny(c.cfg.DeniedMethods, method) {
+		return false
+	}
+
+	return len(c.cfg.AllowedMethods) == 0 || matchAny(c.cfg.AllowedMethods, method)
+}
+
+// methodCost returns the cost of the most specific pattern matching the method, 1 if none.
+func (c *Controller) methodCost(method string) float64 {
+	cost, specificity := float64(1), -1
+	for _, methodCost := range c.cfg.MethodCosts {
+		s := patternSpecificity(methodCost.Method)
+		if s > specificity && matchMethod(methodCost.Method, method) {
+			cost, specificity = methodCost.Cost, s
+		}
+	}
+	return cost
+}
+
+// isInternal returns true if the request is forwarded by the WebSocket server.
+func (c *Controller) isInternal(r *http.Request) bool {
+	token := r.Header.Get(internalHeader)
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(c.internalToken)) == 1
+}
+
+// SetInternal marks a request forwarded by the WebSocket server as already checked.
+func (c *Controller) SetInternal(r *http.Request) {
+	if c.Enabled() {
+		r.Header.Set(internalHeader, c.internalToken)
+	}
+}
+
+// clientIP returns the address of the client, from the X-Forwarded-For header if trusted.
+func (c *Controller) clientIP(r *http.Request) string {
+	if c != nil && c.cfg.TrustForwardedFor {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			ip, _, _ := strings.Cut(forwarded, ",")
+			return strings.TrimSpace(ip)
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// requestAPIKey returns the bearer token or the X-API-Key header of the request.
+func requestAPIKey(r *http.Request) string {
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		scheme, token, ok := strings.Cut(auth, " ")
+		if ok && strings.EqualFold(scheme, "Bearer") {
+			return strings.TrimSpace(token)
+		}
+	}
+	return strings.TrimSpace(r.Header.Get("X-API-Key"))
+}
+
+// matchAny returns true if one of the patterns matches the method.
+func matchAny(patterns []string, method string) bool {
+	for _, pattern := range patterns {
+		if matchMethod(pattern, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchMethod returns true if the pattern, a method name, a namespace wildcard or "*",
+// matches the method.
+func matchMethod(pattern, method string) bool {
+	if pattern == "*" || pattern == method {
+		return true
+	}
+
+	namespace, ok := strings.CutSuffix(pattern, "_*")
+	return ok && strings.HasPrefix(method, namespace+"_")
+}
+
+// patternSpecificity ranks the exact patterns over the namespace wildcards over "*".
+func patternSpecificity(pattern string) int {
+	switch {
+	case pattern == "*":
+		return 0
+	case strings.HasSuffix(pattern, "_*"):
+		return 1
+	default:
+		return 2
+	}
+}
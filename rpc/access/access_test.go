@@ -0,0 +1,279 @@
+package access
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/server/config"
+)
+
+func newTestConfig() config.JSONRPCAccessConfig {
+	cfg := *config.DefaultJSONRPCAccessConfig()
+	cfg.Enable = true
+	cfg.APIKeys = []config.JSONRPCAPIKey{
+		{Key: "scoped", Methods: []string{"debug_*", "eth_blockNumber"}},
+		{Key: "unscoped", RateLimit: 1, RateBurst: 1},
+	}
+	return cfg
+}
+
+func newRequest(t *testing.T, body string, headers map[string]string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.RemoteAddr = "10.0.0.1:1234"
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	return req
+}
+
+func TestMatchMethod(t *testing.T) {
+	testCases := []struct {
+		pattern  string
+		method   string
+		expMatch bool
+	}{
+		{"*", "eth_call", true},
+		{"eth_call", "eth_call", true},
+		{"eth_call", "eth_callBundle", false},
+		{"eth_*", "eth_call", true},
+		{"eth_*", "ethx_call", false},
+		{"debug_*", "eth_call", false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expMatch, matchMethod(tc.pattern, tc.method), "%s %s", tc.pattern, tc.method)
+	}
+}
+
+func TestIdentify(t *testing.T) {
+	cfg := newTestConfig()
+	ctrl, err := NewController(cfg)
+	require.NoError(t, err)
+
+	client, err := ctrl.Identify(newRequest(t, "", nil))
+	require.NoError(t, err)
+	require.False(t, client.HasAPIKey())
+	require.Equal(t, "10.0.0.1", client.IP)
+
+	client, err = ctrl.Identify(newRequest(t, "", map[string]string{"Authorization": "Bearer scoped"}))
+	require.NoError(t, err)
+	require.True(t, client.HasAPIKey())
+
+	client, err = ctrl.Identify(newRequest(t, "", map[string]string{"X-API-Key": "unscoped"}))
+	require.NoError(t, err)
+	require.True(t, client.HasAPIKey())
+
+	_, err = ctrl.Identify(newRequest(t, "", map[string]string{"X-API-Key": "unknown"}))
+	require.ErrorIs(t, err, ErrInvalidAPIKey)
+
+	// the forwarded address is only used if trusted
+	headers := map[string]string{"X-Forwarded-For": "1.2.3.4, 10.0.0.1"}
+	client, err = ctrl.Identify(newRequest(t, "", headers))
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.1", client.IP)
+
+	cfg.TrustForwardedFor = true
+	cfg.RequireAPIKey = true
+	ctrl, err = NewController(cfg)
+	require.NoError(t, err)
+
+	_, err = ctrl.Identify(newRequest(t, "", headers))
+	require.ErrorIs(t, err, ErrAPIKeyRequired)
+
+	headers["X-API-Key"] = "scoped"
+	client, err = ctrl.Identify(newRequest(t, "", headers))
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3.4", client.IP)
+}
+
+func TestAuthorizeMethods(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.AllowedMethods = []string{"eth_*", "net_version"}
+	cfg.DeniedMethods = []string{"eth_sendRawTransaction", "debug_*"}
+	cfg.RateLimit = 0
+	ctrl, err := NewController(cfg)
+	require.NoError(t, err)
+
+	anonymous := &Client{IP: "10.0.0.1"}
+	scoped := &Client{IP: "10.0.0.1", key: &ctrl.cfg.APIKeys[0]}
+	unscoped := &Client{IP: "10.0.0.1", key: &ctrl.cfg.APIKeys[1]}
+
+	testCases := []struct {
+		name    string
+		client  *Client
+		methods []string
+		expPass bool
+	}{
+		{"allowed namespace", anonymous, []string{"eth_call"}, true},
+		{"allowed method", anonymous, []string{"net_version"}, true},
+		{"not allowed", anonymous, []string{"web3_clientVersion"}, false},
+		{"denied method", anonymous, []string{"eth_sendRawTransaction"}, false},
+		{"batch with denied method", anonymous, []string{"eth_call", "debug_traceTransaction"}, false},
+		{"key scope overrides deny list", scoped, []string{"debug_traceTransaction"}, true},
+		{"outside key scope", scoped, []string{"eth_call"}, false},
+		{"key without scope uses the lists", unscoped, []string{"debug_traceTransaction"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ctrl.Authorize(tc.client, tc.methods)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, ErrCodeMethodNotAllowed, err.(*Error).Code)
+			}
+		})
+	}
+}
+
+func TestAuthorizeRateLimit(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.DeniedMethods = nil
+	cfg.RateLimit = 10
+	cfg.RateBurst = 20
+	ctrl, err := NewController(cfg)
+	require.NoError(t, err)
+
+	now := time.Now()
+	ctrl.limiter.now = func() time.Time { return now }
+
+	client := &Client{IP: "10.0.0.1"}
+	other := &Client{IP: "10.0.0.2"}
+
+	// eth_getLogs costs 10 tokens
+	require.NoError(t, ctrl.Authorize(client, []string{"eth_getLogs"}))
+	require.NoError(t, ctrl.Authorize(client, []string{"eth_getLogs"}))
+	require.ErrorIs(t, ctrl.Authorize(client, []string{"eth_blockNumber"}), ErrRateLimited)
+
+	// the buckets are per client
+	require.NoError(t, ctrl.Authorize(other, []string{"eth_blockNumber"}))
+
+	// refilled at 10 tokens per second
+	now = now.Add(500 * time.Millisecond)
+	require.NoError(t, ctrl.Authorize(client, []string{"eth_blockNumber", "eth_blockNumber", "eth_blockNumber"}))
+	require.ErrorIs(t, ctrl.Authorize(client, []string{"eth_call"}), ErrRateLimited)
+
+	// a call more expensive than the burst is allowed with a full bucket
+	now = now.Add(10 * time.Second)
+	require.NoError(t, ctrl.Authorize(client, []string{"debug_traceBlockByNumber"}))
+	now = now.Add(2 * time.Second)
+	require.ErrorIs(t, ctrl.Authorize(client, []string{"eth_blockNumber"}), ErrRateLimited)
+
+	// the limits of a key replace the global ones
+	keyed := &Client{IP: "10.0.0.1", key: &ctrl.cfg.APIKeys[1]}
+	require.NoError(t, ctrl.Authorize(keyed, []string{"eth_blockNumber"}))
+	require.ErrorIs(t, ctrl.Authorize(keyed, []string{"eth_blockNumber"}), ErrRateLimited)
+
+	// the idle buckets are pruned
+	now = now.Add(time.Hour)
+	require.NoError(t, ctrl.Authorize(other, []string{"eth_blockNumber"}))
+	require.Len(t, ctrl.limiter.buckets, 1)
+}
+
+func TestMiddleware(t *testing.T) {
+	cfg := newTestConfig()
+	ctrl, err := NewController(cfg)
+	require.NoError(t, err)
+
+	var received string
+	handler := ctrl.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bz, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		received = string(bz)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(req *http.Request) *httptest.ResponseRecorder {
+		received = ""
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// allowed requests are forwarded with their body
+	body := `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`
+	rec := serve(newRequest(t, body, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, body, received)
+
+	// denied batch requests are rejected per request
+	body = `[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":"two","method":"debug_traceTransaction"}]`
+	rec = serve(newRequest(t, body, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, received)
+
+	var res []ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Len(t, res, 2)
+	require.Equal(t, `"two"`, string(res[1].ID))
+	require.Equal(t, ErrCodeMethodNotAllowed, res[1].Error.Code)
+
+	// the trailing data ignored by the JSON-RPC server doesn't hide the method
+	rec = serve(newRequest(t, `{"jsonrpc":"2.0","id":1,"method":"debug_traceBlockByNumber","params":["latest"]} x`, nil))
+	require.Empty(t, received)
+
+	var errRes ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errRes))
+	require.Equal(t, "1", string(errRes.ID))
+	require.Equal(t, ErrCodeMethodNotAllowed, errRes.Error.Code)
+
+	// the requests whose methods can't be parsed are rejected
+	for _, body := range []string{
+		`[{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction"},{"jsonrpc":"2.0","id":2,"method":5}]`,
+		`[{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction"},5]`,
+		`{"jsonrpc":"2.0","id":1,"method":["debug_traceTransaction"]}`,
+		`{"jsonrpc":"2.0","id":1,`,
+	} {
+		rec = serve(newRequest(t, body, map[string]string{"Authorization": "Bearer scoped"}))
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Empty(t, received, body)
+		require.Contains(t, rec.Body.String(), `"code":-32600`, body)
+	}
+
+	// the scope of the key allows the method
+	rec = serve(newRequest(t, body, map[string]string{"Authorization": "Bearer scoped"}))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, body, received)
+
+	// invalid keys are unauthorized
+	rec = serve(newRequest(t, body, map[string]string{"Authorization": "Bearer invalid"}))
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.Empty(t, received)
+
+	// rate limited requests
+	single := `{"jsonrpc":"2.0","id":7,"method":"eth_blockNumber"}`
+	keyed := map[string]string{"X-API-Key": "unscoped"}
+	require.Equal(t, http.StatusOK, serve(newRequest(t, single, keyed)).Code)
+	rec = serve(newRequest(t, single, keyed))
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errRes))
+	require.Equal(t, "7", string(errRes.ID))
+	require.Equal(t, ErrCodeRateLimited, errRes.Error.Code)
+
+	// the requests forwarded by the WebSocket server aren't checked
+	req := newRequest(t, single, keyed)
+	ctrl.SetInternal(req)
+	require.Equal(t, http.StatusOK, serve(req).Code)
+	require.Equal(t, single, received)
+}
+
+func TestMiddlewareDisabled(t *testing.T) {
+	ctrl, err := NewController(*config.DefaultJSONRPCAccessConfig())
+	require.NoError(t, err)
+	require.False(t, ctrl.Enabled())
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	handler := ctrl.Middleware(next)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newRequest(t, `{"method":"debug_traceTransaction"}`, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package access
+
+import (
+	"sync"
+	"time"
+)
+
+// pruneInterval is the interval between the removals of the idle buckets.
+const pruneInterval = time.Minute
+
+// bucket is a token bucket refilled at a constant rate up to its burst.
+type bucket struct {
+	tokens float64
+	rate   float64
+	burst  float64
+	last   time.Time
+}
+
+// refill adds the tokens accumulated since the last update of the bucket.
+func (b *bucket) refill(now time.Time) {
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+}
+
+// limiter rate limits the clients with a token bucket each.
+type limiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastPrune time.Time
+	now       func() time.Time
+}
+
+func newLimiter() *limiter {
+	return &limiter{
+		buckets:   make(map[string]*bucket),
+		lastPrune: time.Now(),
+		now:       time.Now,
+	}
+}
+
+// allow consumes the cost from the bucket of the client, returning false if it doesn't
+// hold enough tokens. A call costing more than the burst is allowed with a full bucket,
+// which goes into debt until refilled. A zero rate disables the limit.
+func (l *limiter) allow(id string, rate float64, burst int, cost float64) bool {
+	if rate <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.prune(now)
+
+	b, ok := l.buckets[id]
+	if !ok {
+		b = &bucket{tokens: float64(burst), last: now}
+		l.buckets[id] = b
+	}
+	// the limits of a key may be updated
+	b.rate, b.burst = rate, float64(burst)
+	b.refill(now)
+
+	required := cost
+	if required > b.burst {
+		required = b.burst
+	}
+	if b.tokens < required {
+		return false
+	}
+
+	b.tokens -= cost
+	return true
+}
+
+// prune removes the buckets refilled to their burst, which are equivalent to new ones.
+func (l *limiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < pruneInterval {
+		return
+	}
+	l.lastPrune = now
+
+	for id, b := range l.buckets {
+		b.refill(now)
+		if b.tokens >= b.burst {
+			delete(l.buckets, id)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package access
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// maxRequestContentLength is the body limit of the go-ethereum HTTP server.
+const maxRequestContentLength = 5 * 1024 * 1024
+
+// Request is the part of a JSON-RPC request checked by the controller.
+type Request struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+}
+
+// ErrorResponse is the response of a JSON-RPC request rejected by the controller.
+type ErrorResponse struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   ErrorMessage    `json:"error"`
+}
+
+// ErrorMessage is the error of a rejected JSON-RPC request.
+type ErrorMessage struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// ParseRequests returns the requests of a single or batch JSON-RPC message. The message
+// is decoded as by the go-ethereum codec: only its first JSON value is read and the
+// requests of a batch are decoded one by one. The requests decoded before an error are
+// returned with it.
+func ParseRequests(body []byte) ([]Request, bool, error) {
+	var raw json.RawMessage
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(&raw); err != nil {
+		return nil, false, err
+	}
+
+	if raw[0] != '[' {
+		var req Request
+		err := json.Unmarshal(raw, &req)
+		return []Request{req}, false, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if _, err := dec.Token(); err != nil { // the opening bracket
+		return nil, true, err
+	}
+
+	var reqs []Request
+	for dec.More() {
+		var req Request
+		err := dec.Decode(&req)
+		reqs = append(reqs, req)
+		if err != nil {
+			return reqs, true, err
+		}
+	}
+	return reqs, true, nil
+}
+
+// Methods returns the methods of the requests.
+func Methods(reqs []Request) []string {
+	methods := make([]string, len(reqs))
+	for i, req := range reqs {
+		methods[i] = req.Method
+	}
+	return methods
+}
+
+// NewErrorResponse returns the response rejecting the requests with the error, an array
+// for a batch.
+func NewErrorResponse(reqs []Request, batch bool, err error) interface{} {
+	code, msg := ErrCodeUnauthorized, err.Error()
+	var accessErr *Error
+	if errors.As(err, &accessErr) {
+		code = accessErr.Code
+	}
+
+	newResponse := func(id json.RawMessage) ErrorResponse {
+		if len(id) == 0 {
+			id = json.RawMessage("null")
+		}
+		return ErrorResponse{
+			Jsonrpc: "2.0",
+			ID:      id,
+			Error:   ErrorMessage{Code: code, Message: msg},
+		}
+	}
+
+	if !batch {
+		var id json.RawMessage
+		if len(reqs) > 0 {
+			id = reqs[0].ID
+		}
+		return newResponse(id)
+	}
+
+	res := make([]ErrorResponse, len(reqs))
+	for i, req := range reqs {
+		res[i] = newResponse(req.ID)
+	}
+	return res
+}
+
+// AuthorizeMessage checks the methods of a JSON-RPC message against the access control,
+// returning its requests for the error response. A message whose methods can't be parsed
+// is charged as a single call and rejected.
+func (c *Controller) AuthorizeMessage(client *Client, body []byte) ([]Request, bool, error) {
+	reqs, batch, err := ParseRequests(body)
+	if err != nil {
+		if authErr := c.Authorize(client, nil); authErr != nil {
+			return reqs, batch, authErr
+		}
+		return reqs, batch, invalidRequestError(err)
+	}
+	return reqs, batch, c.Authorize(client, Methods(reqs))
+}
+
+// Middleware returns a handler enforcing the access control before the JSON-RPC server.
+func (c *Controller) Middleware(next http.Handler) http.Handler {
+	if !c.Enabled() {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if c.isInternal(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		client, err := c.Identify(r)
+		if err != nil {
+			writeError(w, nil, false, err)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestContentLength))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		if reqs, batch, err := c.AuthorizeMessage(client, body); err != nil {
+			writeError(w, reqs, batch, err)
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// writeError writes the JSON-RPC error response with the HTTP status of the error.
+func writeError(w http.ResponseWriter, reqs []Request, batch bool, err error) {
+	status := http.StatusOK
+	var accessErr *Error
+	if errors.As(err, &accessErr) {
+		status = accessErr.Status
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(NewErrorResponse(reqs, batch, err)) // #nosec G703
+}
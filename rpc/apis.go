@@ -16,7 +16,9 @@
 package rpc
 
 import (
+	"context"
 	"fmt"
+	"path/filepath"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -24,15 +26,21 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/hetu-project/hetu/v1/rpc/backend"
+	"github.com/hetu-project/hetu/v1/rpc/namespaces/ethereum/admin"
+	"github.com/hetu-project/hetu/v1/rpc/namespaces/ethereum/bundler"
 	"github.com/hetu-project/hetu/v1/rpc/namespaces/ethereum/debug"
 	"github.com/hetu-project/hetu/v1/rpc/namespaces/ethereum/eth"
 	"github.com/hetu-project/hetu/v1/rpc/namespaces/ethereum/eth/filters"
+	"github.com/hetu-project/hetu/v1/rpc/namespaces/ethereum/evm"
+	"github.com/hetu-project/hetu/v1/rpc/namespaces/ethereum/hetu"
 	"github.com/hetu-project/hetu/v1/rpc/namespaces/ethereum/miner"
 	"github.com/hetu-project/hetu/v1/rpc/namespaces/ethereum/net"
 	"github.com/hetu-project/hetu/v1/rpc/namespaces/ethereum/personal"
 	"github.com/hetu-project/hetu/v1/rpc/namespaces/ethereum/txpool"
 	"github.com/hetu-project/hetu/v1/rpc/namespaces/ethereum/web3"
+	"github.com/hetu-project/hetu/v1/server/config"
 	"github.com/hetu-project/hetu/v1/types"
+	"github.com/hetu-project/hetu/v1/x/evm/fork"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 )
@@ -53,16 +61,30 @@ const (
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
 
+	// Node administration, serving the effective configuration
+	AdminNamespace = "admin"
+
+	// ERC-4337 bundler, serving eth_*UserOperation* methods
+	BundlerNamespace = "bundler"
+
+	// Development controls of a chain forked from a remote network
+	HetuNamespace = "hetu"
+
+	// Block controls of a dev chain
+	EvmNamespace = "evm"
+
 	apiVersion = "1.0"
 )
 
-// APICreator creates the JSON-RPC API implementations.
+// APICreator creates the JSON-RPC API implementations. The EVM backend is shared by all
+// the namespaces.
 type APICreator = func(
 	ctx *server.Context,
 	clientCtx client.Context,
 	tendermintWebsocketClient *rpcclient.WSClient,
 	allowUnprotectedTxs bool,
 	indexer types.EVMTxIndexer,
+	evmBackend *backend.Backend,
 ) []rpc.API
 
 // apiCreators defines the JSON-RPC API namespaces.
@@ -73,10 +95,10 @@ func init() {
 		EthNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			tmWSClient *rpcclient.WSClient,
-			allowUnprotectedTxs bool,
-			indexer types.EVMTxIndexer,
+			_ bool,
+			_ types.EVMTxIndexer,
+			evmBackend *backend.Backend,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: EthNamespace,
@@ -92,7 +114,7 @@ func init() {
 				},
 			}
 		},
-		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, bool, types.EVMTxIndexer) []rpc.API {
+		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, bool, types.EVMTxIndexer, *backend.Backend) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: Web3Namespace,
@@ -102,7 +124,7 @@ func init() {
 				},
 			}
 		},
-		NetNamespace: func(_ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+		NetNamespace: func(_ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer, _ *backend.Backend) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: NetNamespace,
@@ -115,10 +137,10 @@ func init() {
 		PersonalNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			_ *rpcclient.WSClient,
-			allowUnprotectedTxs bool,
-			indexer types.EVMTxIndexer,
+			_ bool,
+			_ types.EVMTxIndexer,
+			evmBackend *backend.Backend,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: PersonalNamespace,
@@ -128,7 +150,7 @@ func init() {
 				},
 			}
 		},
-		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer, _ *backend.Backend) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
@@ -141,10 +163,10 @@ func init() {
 		DebugNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			_ *rpcclient.WSClient,
-			allowUnprotectedTxs bool,
-			indexer types.EVMTxIndexer,
+			_ bool,
+			_ types.EVMTxIndexer,
+			evmBackend *backend.Backend,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: DebugNamespace,
@@ -157,10 +179,10 @@ func init() {
 		MinerNamespace: func(ctx *server.Context,
 			clientCtx client.Context,
 			_ *rpcclient.WSClient,
-			allowUnprotectedTxs bool,
-			indexer types.EVMTxIndexer,
+			_ bool,
+			_ types.EVMTxIndexer,
+			evmBackend *backend.Backend,
 		) []rpc.API {
-			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: MinerNamespace,
@@ -170,10 +192,54 @@ func init() {
 				},
 			}
 		},
+		AdminNamespace: func(ctx *server.Context,
+			_ client.Context,
+			_ *rpcclient.WSClient,
+			_ bool,
+			_ types.EVMTxIndexer,
+			evmBackend *backend.Backend,
+		) []rpc.API {
+			return []rpc.API{
+				{
+					Namespace: AdminNamespace,
+					Version:   apiVersion,
+					Service:   admin.NewAPI(ctx.Logger, evmBackend),
+					Public:    false,
+				},
+			}
+		},
+		BundlerNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			_ bool,
+			_ types.EVMTxIndexer,
+			evmBackend *backend.Backend,
+		) []rpc.API {
+			appConf, err := config.GetConfig(ctx.Viper)
+			if err != nil {
+				panic(err)
+			}
+
+			b, err := bundler.NewBundler(ctx.Logger, evmBackend, clientCtx.Keyring, appConf.JSONRPC.BundlerKey)
+			if err != nil {
+				ctx.Logger.Error("failed to create bundler, bundler namespace is disabled", "error", err.Error())
+				return nil
+			}
+
+			return []rpc.API{
+				{
+					Namespace: EthNamespace,
+					Version:   apiVersion,
+					Service:   bundler.NewPublicAPI(ctx.Logger, b),
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
-// GetRPCAPIs returns the list of all APIs
+// GetRPCAPIs returns the list of all APIs, sharing a single EVM backend whose caches are
+// invalidated on the new CometBFT blocks, and whose caps are reloaded on the app.toml changes.
 func GetRPCAPIs(ctx *server.Context,
 	clientCtx client.Context,
 	tmWSClient *rpcclient.WSClient,
@@ -183,9 +249,17 @@ func GetRPCAPIs(ctx *server.Context,
 ) []rpc.API {
 	var apis []rpc.API
 
+	evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+	if err := evmBackend.SubscribeNewHeads(context.Background()); err != nil {
+		ctx.Logger.Error("failed to subscribe to new heads, block caching is disabled", "error", err.Error())
+	}
+	if ctx.Config != nil {
+		watchConfig(ctx, evmBackend)
+	}
+
 	for _, ns := range selectedAPIs {
 		if creator, ok := apiCreators[ns]; ok {
-			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer)...)
+			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer, evmBackend)...)
 		} else {
 			ctx.Logger.Error("invalid namespace value", "namespace", ns)
 		}
@@ -194,6 +268,18 @@ func GetRPCAPIs(ctx *server.Context,
 	return apis
 }
 
+// watchConfig applies the changes of the app.toml file to the EVM backend.
+func watchConfig(ctx *server.Context, evmBackend *backend.Backend) {
+	path := filepath.Join(ctx.Config.RootDir, "config", "app.toml")
+	watcher, err := config.NewWatcher(ctx.Logger.With("module", "config"), path, evmBackend)
+	if err != nil {
+		ctx.Logger.Error("failed to watch the app config, the changes require a restart", "error", err.Error())
+		return
+	}
+
+	go watcher.Run(context.Background(), config.DefaultWatchInterval)
+}
+
 // RegisterAPINamespace registers a new API namespace with the API creator.
 // This function fails if the namespace is already registered.
 func RegisterAPINamespace(ns string, creator APICreator) error {
@@ -203,3 +289,48 @@ func RegisterAPINamespace(ns string, creator APICreator) error {
 	apiCreators[ns] = creator
 	return nil
 }
+
+// RegisterForkAPI registers the hetu namespace serving the development controls
+// of a chain forked from a remote network.
+func RegisterForkAPI(controller *fork.Controller) error {
+	return RegisterAPINamespace(HetuNamespace, func(ctx *server.Context,
+		_ client.Context,
+		_ *rpcclient.WSClient,
+		_ bool,
+		_ types.EVMTxIndexer,
+		evmBackend *backend.Backend,
+	) []rpc.API {
+		// eth_sendTransaction sends the txs of the impersonated accounts unsigned
+		evmBackend.SetImpersonationHook(controller.IsImpersonated)
+
+		return []rpc.API{
+			{
+				Namespace: HetuNamespace,
+				Version:   apiVersion,
+				Service:   hetu.NewAPI(ctx.Logger, evmBackend, controller),
+				Public:    false,
+			},
+		}
+	})
+}
+
+// RegisterDevAPI registers the evm namespace serving the block controls of a
+// dev chain.
+func RegisterDevAPI(engine evm.Engine) error {
+	return RegisterAPINamespace(EvmNamespace, func(ctx *server.Context,
+		_ client.Context,
+		_ *rpcclient.WSClient,
+		_ bool,
+		_ types.EVMTxIndexer,
+		_ *backend.Backend,
+	) []rpc.API {
+		return []rpc.API{
+			{
+				Namespace: EvmNamespace,
+				Version:   apiVersion,
+				Service:   evm.NewAPI(ctx.Logger, engine),
+				Public:    false,
+			},
+		}
+	})
+}
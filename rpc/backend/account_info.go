@@ -47,7 +47,11 @@ func (b *Backend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNu
 
 	res, err := b.queryClient.Code(rpctypes.ContextWithHeight(blockNum.Int64()), req)
 	if err != nil {
-		return nil, err
+		var code hexutil.Bytes
+		if err := b.archiveFallback(blockNum.Int64(), err, &code, "eth_getCode", address, blockArg(blockNum.Int64())); err != nil {
+			return nil, err
+		}
+		return code, nil
 	}
 
 	return res.Code, nil
@@ -60,12 +64,23 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		return nil, err
 	}
 
+	proof, err := b.getProof(address, storageKeys, blockNum)
+	if err != nil {
+		proof = new(rpctypes.AccountResult)
+		if err := b.archiveFallback(blockNum.Int64(), err, proof, "eth_getProof", address, storageKeys, blockArg(blockNum.Int64())); err != nil {
+			return nil, err
+		}
+	}
+	return proof, nil
+}
+
+// getProof returns the account and storage proofs at the block from the node's state.
+func (b *Backend) getProof(address common.Address, storageKeys []string, blockNum rpctypes.BlockNumber) (*rpctypes.AccountResult, error) {
 	height := blockNum.Int64()
 
-	_, err = b.TendermintBlockByNumber(blockNum)
+	_, err := b.TendermintBlockByNumber(blockNum)
 	if err != nil {
-		// the error message imitates geth behavior
-		return nil, errors.New("header not found")
+		return nil, b.headerNotFound(height, err)
 	}
 	ctx := rpctypes.ContextWithHeight(height)
 
@@ -149,7 +164,11 @@ func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash
 
 	res, err := b.queryClient.Storage(rpctypes.ContextWithHeight(blockNum.Int64()), req)
 	if err != nil {
-		return nil, err
+		var value hexutil.Bytes
+		if err := b.archiveFallback(blockNum.Int64(), err, &value, "eth_getStorageAt", address, key, blockArg(blockNum.Int64())); err != nil {
+			return nil, err
+		}
+		return value, nil
 	}
 
 	value := common.HexToHash(res.Value)
@@ -163,11 +182,23 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 		return nil, err
 	}
 
+	balance, err := b.getBalance(address, blockNum)
+	if err != nil {
+		balance = new(hexutil.Big)
+		if err := b.archiveFallback(blockNum.Int64(), err, balance, "eth_getBalance", address, blockArg(blockNum.Int64())); err != nil {
+			return nil, err
+		}
+	}
+	return balance, nil
+}
+
+// getBalance returns the account's balance at the block from the node's state.
+func (b *Backend) getBalance(address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Big, error) {
 	req := &evmtypes.QueryBalanceRequest{
 		Address: address.String(),
 	}
 
-	_, err = b.TendermintBlockByNumber(blockNum)
+	_, err := b.TendermintBlockByNumber(blockNum)
 	if err != nil {
 		return nil, err
 	}
@@ -220,7 +251,10 @@ func (b *Backend) GetTransactionCount(address common.Address, blockNum rpctypes.
 	includePending := blockNum == rpctypes.EthPendingBlockNumber
 	nonce, err := b.getAccountNonce(address, includePending, blockNum.Int64(), b.logger)
 	if err != nil {
-		return nil, err
+		if err := b.archiveFallback(blockNum.Int64(), err, &n, "eth_getTransactionCount", address, blockArg(blockNum.Int64())); err != nil {
+			return nil, err
+		}
+		return &n, nil
 	}
 
 	n = hexutil.Uint64(nonce)
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"cosmossdk.io/log"
@@ -39,6 +40,9 @@ import (
 	rpctypes "github.com/hetu-project/hetu/v1/rpc/types"
 	"github.com/hetu-project/hetu/v1/server/config"
 	evmostypes "github.com/hetu-project/hetu/v1/types"
+	"github.com/hetu-project/hetu/v1/wallets/accounts"
+	"github.com/hetu-project/hetu/v1/wallets/signer"
+	"github.com/hetu-project/hetu/v1/wallets/usbwallet"
 	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
 )
 
@@ -57,7 +61,10 @@ type EVMBackend interface {
 	SetEtherbase(etherbase common.Address) bool
 	SetGasPrice(gasPrice hexutil.Big) bool
 	ImportRawKey(privkey, password string) (common.Address, error)
+	ImportKeystore(keyJSON []byte, password string) (common.Address, error)
 	ListAccounts() ([]common.Address, error)
+	UnlockAccount(address common.Address, password string, duration time.Duration) (bool, error)
+	LockAccount(address common.Address) bool
 	NewMnemonic(uid string, language keyring.Language, hdPath, bip39Passphrase string, algo keyring.SignatureAlgo) (*keyring.Record, error)
 	UnprotectedAllowed() bool
 	RPCGasCap() uint64            // global gas cap for eth_call over rpc: DoS protection
@@ -69,6 +76,8 @@ type EVMBackend interface {
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
 	SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error)
+	SignCosmosTx(address common.Address, signDoc hexutil.Bytes) (hexutil.Bytes, error)
+	Wallets() []accounts.Wallet
 
 	// Blocks Info
 	BlockNumber() (hexutil.Uint64, error)
@@ -119,6 +128,7 @@ type EVMBackend interface {
 	// Send Transaction
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	SendRawTransactionBatch(data []hexutil.Bytes) ([]common.Hash, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
 	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
 	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
@@ -147,8 +157,15 @@ type Backend struct {
 	logger              log.Logger
 	chainID             *big.Int
 	cfg                 config.Config
+	cfgMtx              sync.RWMutex // guards the reloadable values of cfg
 	allowUnprotectedTxs bool
 	indexer             evmostypes.EVMTxIndexer
+	wallets             accounts.Backend // hardware wallets, nil if disabled
+	signer              signer.Signer    // nil if signing is disabled
+	cache               *backendCache
+	pruningKeepRecent   int64                     // number of recent heights kept by the state pruning, 0 if disabled
+	archive             *rpc.Client               // archive upstream of the pruned state queries, nil if disabled
+	impersonated        func(common.Address) bool // accounts sending unsigned txs, nil unless forked
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -174,6 +191,34 @@ func NewBackend(
 		panic(fmt.Sprintf("invalid rpc client, expected: tmrpcclient.SignClient, got: %T", clientCtx.Client))
 	}
 
+	var wallets accounts.Backend
+	if appConf.JSONRPC.EnableLedger {
+		hub, err := usbwallet.NewLedgerHub()
+		if err != nil {
+			logger.Error("failed to start Ledger hub", "error", err.Error())
+		} else {
+			wallets = hub
+		}
+	}
+
+	txSigner, err := signer.New(appConf.JSONRPC.Signer, clientCtx.Keyring, clientCtx.KeyringDir)
+	if err != nil {
+		logger.Error("failed to create signer, signing is disabled", "error", err.Error())
+	}
+
+	var pruningKeepRecent int64
+	if pruningOpts, err := server.GetPruningOptionsFromFlags(ctx.Viper); err == nil {
+		pruningKeepRecent = int64(pruningOpts.KeepRecent) //#nosec G701 -- the kept heights fit in an int64
+	}
+
+	var archive *rpc.Client
+	if appConf.JSONRPC.ArchiveUpstream != "" {
+		archive, err = rpc.DialContext(context.Background(), appConf.JSONRPC.ArchiveUpstream)
+		if err != nil {
+			logger.Error("failed to connect to the archive upstream, the pruned state queries fail", "error", err.Error())
+		}
+	}
+
 	return &Backend{
 		ctx:                 context.Background(),
 		clientCtx:           clientCtx,
@@ -184,5 +229,17 @@ func NewBackend(
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
 		indexer:             indexer,
+		wallets:             wallets,
+		signer:              txSigner,
+		cache:               newBackendCache(appConf.JSONRPC.BlockCacheSize, appConf.JSONRPC.TxCacheSize),
+		pruningKeepRecent:   pruningKeepRecent,
+		archive:             archive,
 	}
 }
+
+// SetImpersonationHook installs the function deciding whether the txs of an
+// address are sent with the impersonation signature instead of being signed,
+// see evmtypes.ImpersonationSignature. It must be set before serving requests.
+func (b *Backend) SetImpersonationHook(fn func(common.Address) bool) {
+	b.impersonated = fn
+}
@@ -25,6 +25,7 @@ import (
 	rpctypes "github.com/hetu-project/hetu/v1/rpc/types"
 	utiltx "github.com/hetu-project/hetu/v1/testutil/tx"
 	"github.com/hetu-project/hetu/v1/utils"
+	"github.com/hetu-project/hetu/v1/wallets/signer"
 	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
 )
 
@@ -86,6 +87,7 @@ func (suite *BackendTestSuite) SetupTest() {
 	suite.backend.queryClient.QueryClient = mocks.NewEVMQueryClient(suite.T())
 	suite.backend.queryClient.FeeMarket = mocks.NewFeeMarketQueryClient(suite.T())
 	suite.backend.ctx = rpctypes.ContextWithHeight(1)
+	suite.backend.signer = signer.NewKeyringSigner(keyRing, clientDir)
 
 	// Add codec
 	encCfg := encoding.MakeConfig()
@@ -79,7 +79,7 @@ func (b *Backend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (
 		return nil, nil
 	}
 
-	blockRes, err := b.rpcClient.BlockResults(b.ctx, &resBlock.Block.Height)
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
 	if err != nil {
 		b.logger.Debug("failed to fetch block result from Tendermint", "height", blockNum, "error", err.Error())
 		return nil, nil
@@ -107,7 +107,7 @@ func (b *Backend) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]inte
 		return nil, nil
 	}
 
-	blockRes, err := b.rpcClient.BlockResults(b.ctx, &resBlock.Block.Height)
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
 	if err != nil {
 		b.logger.Debug("failed to fetch block result from Tendermint", "block-hash", hash.String(), "error", err.Error())
 		return nil, nil
@@ -125,13 +125,13 @@ func (b *Backend) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]inte
 // GetBlockTransactionCountByHash returns the number of Ethereum transactions in
 // the block identified by hash.
 func (b *Backend) GetBlockTransactionCountByHash(hash common.Hash) *hexutil.Uint {
-	block, err := b.rpcClient.BlockByHash(b.ctx, hash.Bytes())
+	block, err := b.TendermintBlockByHash(hash)
 	if err != nil {
 		b.logger.Debug("block not found", "hash", hash.Hex(), "error", err.Error())
 		return nil
 	}
 
-	if block.Block == nil {
+	if block == nil {
 		b.logger.Debug("block not found", "hash", hash.Hex())
 		return nil
 	}
@@ -159,7 +159,7 @@ func (b *Backend) GetBlockTransactionCountByNumber(blockNum rpctypes.BlockNumber
 // GetBlockTransactionCount returns the number of Ethereum transactions in a
 // given block.
 func (b *Backend) GetBlockTransactionCount(block *tmrpctypes.ResultBlock) *hexutil.Uint {
-	blockRes, err := b.rpcClient.BlockResults(b.ctx, &block.Block.Height)
+	blockRes, err := b.TendermintBlockResultByNumber(&block.Block.Height)
 	if err != nil {
 		return nil
 	}
@@ -181,6 +181,11 @@ func (b *Backend) TendermintBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpc
 		}
 		height = int64(n) //#nosec G701 -- checked for int overflow already
 	}
+
+	if resBlock, ok := b.cache.blocks.get(height); ok {
+		return resBlock, nil
+	}
+
 	resBlock, err := b.rpcClient.Block(b.ctx, &height)
 	if err != nil {
 		b.logger.Debug("tendermint client failed to get block", "height", height, "error", err.Error())
@@ -192,17 +197,40 @@ func (b *Backend) TendermintBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpc
 		return nil, nil
 	}
 
+	b.cacheBlock(resBlock)
 	return resBlock, nil
 }
 
 // TendermintBlockResultByNumber returns a Tendermint-formatted block result
 // by block number
 func (b *Backend) TendermintBlockResultByNumber(height *int64) (*tmrpctypes.ResultBlockResults, error) {
-	return b.rpcClient.BlockResults(b.ctx, height)
+	if height == nil {
+		return b.rpcClient.BlockResults(b.ctx, height)
+	}
+
+	if blockRes, ok := b.cache.blockResults.get(*height); ok {
+		return blockRes, nil
+	}
+
+	blockRes, err := b.rpcClient.BlockResults(b.ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	if blockRes != nil && b.cache.cacheable(blockRes.Height) {
+		b.cache.blockResults.add(blockRes.Height, blockRes.Height, blockRes)
+	}
+	return blockRes, nil
 }
 
 // TendermintBlockByHash returns a Tendermint-formatted block by block number
 func (b *Backend) TendermintBlockByHash(blockHash common.Hash) (*tmrpctypes.ResultBlock, error) {
+	if height, ok := b.cache.blockHashes.get(blockHash); ok {
+		if resBlock, ok := b.cache.blocks.get(height); ok {
+			return resBlock, nil
+		}
+	}
+
 	resBlock, err := b.rpcClient.BlockByHash(b.ctx, blockHash.Bytes())
 	if err != nil {
 		b.logger.Debug("tendermint client failed to get block", "blockHash", blockHash.Hex(), "error", err.Error())
@@ -214,9 +242,21 @@ func (b *Backend) TendermintBlockByHash(blockHash common.Hash) (*tmrpctypes.Resu
 		return nil, nil
 	}
 
+	b.cacheBlock(resBlock)
 	return resBlock, nil
 }
 
+// cacheBlock caches the block by height and hash if committed.
+func (b *Backend) cacheBlock(resBlock *tmrpctypes.ResultBlock) {
+	height := resBlock.Block.Height
+	if !b.cache.cacheable(height) {
+		return
+	}
+
+	b.cache.blocks.add(height, height, resBlock)
+	b.cache.blockHashes.add(common.BytesToHash(resBlock.Block.Hash()), height, height)
+}
+
 // BlockNumberFromTendermint returns the BlockNumber from BlockNumberOrHash
 func (b *Backend) BlockNumberFromTendermint(blockNrOrHash rpctypes.BlockNumberOrHash) (rpctypes.BlockNumber, error) {
 	switch {
@@ -264,6 +304,7 @@ func (b *Backend) EthMsgsFromTendermintBlock(
 	for i, tx := range block.Txs {
 		// Check if tx exists on EVM by cross checking with blockResults:
 		//  - Include unsuccessful tx that exceeds block gas limit
+		//  - Include atomic batches reverted by one of their messages
 		//  - Exclude unsuccessful tx with any other error but ExceedBlockGasLimit
 		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(txResults[i]) {
 			b.logger.Debug("invalid tx result code", "cosmos-hash", hexutil.Encode(tx.Hash()))
@@ -282,7 +323,7 @@ func (b *Backend) EthMsgsFromTendermintBlock(
 				continue
 			}
 
-			ethMsg.Hash = ethMsg.AsTransaction().Hash().Hex()
+			ethMsg.Hash = ethMsg.TxHash().Hex()
 			result = append(result, ethMsg)
 		}
 	}
@@ -301,7 +342,7 @@ func (b *Backend) HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Heade
 		return nil, errors.Errorf("block not found for height %d", blockNum)
 	}
 
-	blockRes, err := b.rpcClient.BlockResults(b.ctx, &resBlock.Block.Height)
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
 	if err != nil {
 		return nil, errors.Errorf("block result not found for height %d", resBlock.Block.Height)
 	}
@@ -334,7 +375,7 @@ func (b *Backend) HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
 
 	height := resHeader.Header.Height
 
-	blockRes, err := b.rpcClient.BlockResults(b.ctx, &resHeader.Header.Height)
+	blockRes, err := b.TendermintBlockResultByNumber(&resHeader.Header.Height)
 	if err != nil {
 		return nil, errors.Errorf("block result not found for height %d", height)
 	}
@@ -377,6 +418,11 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 	blockRes *tmrpctypes.ResultBlockResults,
 	fullTx bool,
 ) (map[string]interface{}, error) {
+	key := rpcBlockKey{height: resBlock.Block.Height, fullTx: fullTx}
+	if res, ok := b.cache.rpcBlocks.get(key); ok {
+		return copyResponse(res), nil
+	}
+
 	ethRPCTxs := []interface{}{}
 	block := resBlock.Block
 
@@ -394,11 +440,10 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 			continue
 		}
 
-		tx := ethMsg.AsTransaction()
 		height := uint64(block.Height) //#nosec G701 -- checked for int overflow already
 		index := uint64(txIndex)       //#nosec G701 -- checked for int overflow already
-		rpcTx, err := rpctypes.NewRPCTransaction(
-			tx,
+		rpcTx, err := rpctypes.NewTransactionFromMsg(
+			ethMsg,
 			common.BytesToHash(block.Hash()),
 			height,
 			index,
@@ -406,7 +451,7 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 			b.chainID,
 		)
 		if err != nil {
-			b.logger.Debug("NewTransactionFromData for receipt failed", "hash", tx.Hash().Hex(), "error", err.Error())
+			b.logger.Debug("NewTransactionFromData for receipt failed", "hash", ethMsg.Hash, "error", err.Error())
 			continue
 		}
 		ethRPCTxs = append(ethRPCTxs, rpcTx)
@@ -464,6 +509,10 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 		gasLimit, new(big.Int).SetUint64(gasUsed),
 		ethRPCTxs, bloom, validatorAddr, baseFee,
 	)
+
+	if b.cache.cacheable(block.Height) {
+		b.cache.rpcBlocks.add(key, block.Height, copyResponse(formattedBlock))
+	}
 	return formattedBlock, nil
 }
 
@@ -479,7 +528,7 @@ func (b *Backend) EthBlockByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Blo
 		return nil, fmt.Errorf("block not found for height %d", blockNum)
 	}
 
-	blockRes, err := b.rpcClient.BlockResults(b.ctx, &resBlock.Block.Height)
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
 	if err != nil {
 		return nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
 	}
@@ -495,6 +544,10 @@ func (b *Backend) EthBlockFromTendermintBlock(
 ) (*ethtypes.Block, error) {
 	block := resBlock.Block
 	height := block.Height
+	if ethBlock, ok := b.cache.ethBlocks.get(height); ok {
+		return ethBlock, nil
+	}
+
 	bloom, err := b.BlockBloom(blockRes)
 	if err != nil {
 		b.logger.Debug("HeaderByNumber BlockBloom failed", "height", height)
@@ -516,5 +569,8 @@ func (b *Backend) EthBlockFromTendermintBlock(
 
 	// TODO: add tx receipts
 	ethBlock := ethtypes.NewBlock(ethHeader, txs, nil, nil, trie.NewStackTrie(nil))
+	if b.cache.cacheable(height) {
+		b.cache.ethBlocks.add(height, height, ethBlock)
+	}
 	return ethBlock, nil
 }
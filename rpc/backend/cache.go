@@ -0,0 +1,231 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	evmostypes "github.com/hetu-project/hetu/v1/types"
+)
+
+// headSubscriber is the CometBFT subscriber of the new heads invalidating the caches.
+const headSubscriber = "evm-backend-heads"
+
+var headQuery = tmtypes.EventQueryNewBlockHeader.String()
+
+// cacheEntry is a cached value with the height of its block.
+type cacheEntry[V any] struct {
+	height int64
+	value  V
+}
+
+// lruCache is a bounded LRU cache recording its hits and misses. A nil cache is disabled.
+type lruCache[K comparable, V any] struct {
+	cache  *lru.Cache[K, cacheEntry[V]]
+	hits   metrics.Counter
+	misses metrics.Counter
+}
+
+// newLRUCache returns a cache of the given size, nil if the size isn't positive.
+func newLRUCache[K comparable, V any](name string, size int) *lruCache[K, V] {
+	if size <= 0 {
+		return nil
+	}
+
+	cache, err := lru.New[K, cacheEntry[V]](size)
+	if err != nil {
+		panic(err)
+	}
+
+	return &lruCache[K, V]{
+		cache:  cache,
+		hits:   metrics.NewRegisteredCounter(fmt.Sprintf("rpc/backend/cache/%s/hit", name), nil),
+		misses: metrics.NewRegisteredCounter(fmt.Sprintf("rpc/backend/cache/%s/miss", name), nil),
+	}
+}
+
+func (c *lruCache[K, V]) get(key K) (value V, ok bool) {
+	if c == nil {
+		return value, false
+	}
+
+	entry, ok := c.cache.Get(key)
+	if !ok {
+		c.misses.Inc(1)
+		return value, false
+	}
+
+	c.hits.Inc(1)
+	return entry.value, true
+}
+
+func (c *lruCache[K, V]) add(key K, height int64, value V) {
+	if c != nil {
+		c.cache.Add(key, cacheEntry[V]{height: height, value: value})
+	}
+}
+
+// removeAbove removes the entries of the blocks above the height.
+func (c *lruCache[K, V]) removeAbove(height int64) {
+	if c == nil {
+		return
+	}
+
+	for _, key := range c.cache.Keys() {
+		if entry, ok := c.cache.Peek(key); ok && entry.height > height {
+			c.cache.Remove(key)
+		}
+	}
+}
+
+// rpcBlockKey is the key of the JSON-RPC blocks, which depend on the fullTx flag.
+type rpcBlockKey struct {
+	height int64
+	fullTx bool
+}
+
+// txIndexKey is the key of the transactions looked up by block and index.
+type txIndexKey struct {
+	height int64
+	index  uint
+}
+
+// backendCache holds the caches of the CometBFT blocks and the data derived from them,
+// shared by the JSON-RPC namespaces. Only the blocks up to the latest head received from
+// CometBFT are cached, so the caching is disabled until the head subscription starts.
+type backendCache struct {
+	head atomic.Int64
+
+	blocks       *lruCache[int64, *tmrpctypes.ResultBlock]
+	blockHashes  *lruCache[common.Hash, int64]
+	blockResults *lruCache[int64, *tmrpctypes.ResultBlockResults]
+	ethBlocks    *lruCache[int64, *ethtypes.Block]
+	rpcBlocks    *lruCache[rpcBlockKey, map[string]interface{}]
+	receipts     *lruCache[common.Hash, map[string]interface{}]
+	txsByHash    *lruCache[common.Hash, *evmostypes.TxResult]
+	txsByIndex   *lruCache[txIndexKey, *evmostypes.TxResult]
+}
+
+// newBackendCache returns the caches holding the given number of blocks and transactions.
+func newBackendCache(blockCacheSize, txCacheSize int) *backendCache {
+	return &backendCache{
+		blocks:       newLRUCache[int64, *tmrpctypes.ResultBlock]("blocks", blockCacheSize),
+		blockHashes:  newLRUCache[common.Hash, int64]("blockhashes", blockCacheSize),
+		blockResults: newLRUCache[int64, *tmrpctypes.ResultBlockResults]("blockresults", blockCacheSize),
+		ethBlocks:    newLRUCache[int64, *ethtypes.Block]("ethblocks", blockCacheSize),
+		rpcBlocks:    newLRUCache[rpcBlockKey, map[string]interface{}]("rpcblocks", 2*blockCacheSize),
+		receipts:     newLRUCache[common.Hash, map[string]interface{}]("receipts", txCacheSize),
+		txsByHash:    newLRUCache[common.Hash, *evmostypes.TxResult]("txs", txCacheSize),
+		txsByIndex:   newLRUCache[txIndexKey, *evmostypes.TxResult]("txindexes", txCacheSize),
+	}
+}
+
+// cacheable returns true if the block at the height was committed before the latest head.
+func (c *backendCache) cacheable(height int64) bool {
+	return height > 0 && height <= c.head.Load()
+}
+
+// setHead updates the latest head. A head that isn't above the previous one can only be
+// received if the chain was rolled back, so the blocks from its height are evicted.
+func (c *backendCache) setHead(height int64) {
+	if c.head.Swap(height) < height {
+		return
+	}
+
+	c.blocks.removeAbove(height - 1)
+	c.blockHashes.removeAbove(height - 1)
+	c.blockResults.removeAbove(height - 1)
+	c.ethBlocks.removeAbove(height - 1)
+	c.rpcBlocks.removeAbove(height - 1)
+	c.receipts.removeAbove(height - 1)
+	c.txsByHash.removeAbove(height - 1)
+	c.txsByIndex.removeAbove(height - 1)
+}
+
+// SubscribeNewHeads enables the caching of the blocks by subscribing to the new block
+// headers from CometBFT.
+func (b *Backend) SubscribeNewHeads(ctx context.Context) error {
+	eventsClient, ok := b.clientCtx.Client.(tmrpcclient.EventsClient)
+	if !ok {
+		return fmt.Errorf("invalid rpc client, expected: tmrpcclient.EventsClient, got: %T", b.clientCtx.Client)
+	}
+
+	eventCh, err := eventsClient.Subscribe(ctx, headSubscriber, headQuery)
+	if err != nil {
+		return err
+	}
+
+	go b.trackHeads(ctx, eventsClient, eventCh)
+	return nil
+}
+
+// trackHeads updates the head of the caches until the context is done, subscribing again
+// when the subscription is canceled by CometBFT.
+func (b *Backend) trackHeads(ctx context.Context, eventsClient tmrpcclient.EventsClient, eventCh <-chan tmrpctypes.ResultEvent) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev, ok := <-eventCh:
+			if !ok {
+				// the caches can't be invalidated until subscribed again
+				b.cache.setHead(0)
+				_ = eventsClient.UnsubscribeAll(ctx, headSubscriber) // #nosec G703
+
+				var err error
+				eventCh, err = eventsClient.Subscribe(ctx, headSubscriber, headQuery)
+				if err != nil {
+					b.logger.Error("failed to subscribe to new heads, block caching is disabled", "error", err.Error())
+					return
+				}
+				continue
+			}
+
+			data, ok := ev.Data.(tmtypes.EventDataNewBlockHeader)
+			if !ok {
+				b.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
+				continue
+			}
+			b.cache.setHead(data.Header.Height)
+		}
+	}
+}
+
+// copyTxResult returns a copy of a cached transaction lookup, which callers may modify.
+func copyTxResult(txResult *evmostypes.TxResult) *evmostypes.TxResult {
+	cpy := *txResult
+	return &cpy
+}
+
+// copyResponse returns a shallow copy of a cached JSON-RPC response, which callers may modify.
+func copyResponse(res map[string]interface{}) map[string]interface{} {
+	cpy := make(map[string]interface{}, len(res))
+	for k, v := range res {
+		cpy[k] = v
+	}
+	return cpy
+}
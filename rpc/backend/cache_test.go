@@ -0,0 +1,88 @@
+package backend
+
+import (
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/hetu-project/hetu/v1/rpc/backend/mocks"
+	ethrpc "github.com/hetu-project/hetu/v1/rpc/types"
+	"github.com/hetu-project/hetu/v1/types"
+)
+
+func (suite *BackendTestSuite) TestBackendCacheHead() {
+	cache := newBackendCache(4, 4)
+	suite.Require().False(cache.cacheable(1))
+
+	cache.setHead(10)
+	suite.Require().True(cache.cacheable(10))
+	suite.Require().False(cache.cacheable(11))
+
+	cache.blockResults.add(5, 5, &tmrpctypes.ResultBlockResults{Height: 5})
+	cache.blockResults.add(9, 9, &tmrpctypes.ResultBlockResults{Height: 9})
+	cache.txsByHash.add(common.Hash{0x01}, 9, &types.TxResult{Height: 9})
+
+	// a higher head keeps the entries
+	cache.setHead(11)
+	_, ok := cache.blockResults.get(9)
+	suite.Require().True(ok)
+
+	// a lower head evicts the entries above it
+	cache.setHead(6)
+	_, ok = cache.blockResults.get(5)
+	suite.Require().True(ok)
+	_, ok = cache.blockResults.get(9)
+	suite.Require().False(ok)
+	_, ok = cache.txsByHash.get(common.Hash{0x01})
+	suite.Require().False(ok)
+
+	// a head received again at the same height replaces the block
+	cache.setHead(5)
+	_, ok = cache.blockResults.get(5)
+	suite.Require().False(ok)
+
+	// disabled caches
+	cache = newBackendCache(0, 0)
+	cache.setHead(10)
+	cache.blocks.add(1, 1, &tmrpctypes.ResultBlock{})
+	_, ok = cache.blocks.get(1)
+	suite.Require().False(ok)
+}
+
+func (suite *BackendTestSuite) TestTendermintBlockCache() {
+	suite.backend.cache = newBackendCache(4, 4)
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+
+	height := int64(1)
+	resBlock := &tmrpctypes.ResultBlock{Block: tmtypes.MakeBlock(height, []tmtypes.Tx{}, nil, nil)}
+	blockRes := &tmrpctypes.ResultBlockResults{Height: height}
+
+	// the blocks are fetched until the head is received, then once
+	client.On("Block", ethrpc.ContextWithHeight(height), mock.AnythingOfType("*int64")).
+		Return(resBlock, nil).Times(2)
+	client.On("BlockResults", ethrpc.ContextWithHeight(height), mock.AnythingOfType("*int64")).
+		Return(blockRes, nil).Times(2)
+
+	block, err := suite.backend.TendermintBlockByNumber(ethrpc.BlockNumber(height))
+	suite.Require().NoError(err)
+	suite.Require().Equal(resBlock, block)
+	_, err = suite.backend.TendermintBlockResultByNumber(&height)
+	suite.Require().NoError(err)
+
+	suite.backend.cache.setHead(height)
+	for i := 0; i < 3; i++ {
+		block, err = suite.backend.TendermintBlockByNumber(ethrpc.BlockNumber(height))
+		suite.Require().NoError(err)
+		suite.Require().Equal(resBlock, block)
+
+		res, err := suite.backend.TendermintBlockResultByNumber(&height)
+		suite.Require().NoError(err)
+		suite.Require().Equal(blockRes, res)
+	}
+
+	// the blocks cached by number are found by hash
+	block, err = suite.backend.TendermintBlockByHash(common.BytesToHash(resBlock.Block.Hash()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(resBlock, block)
+}
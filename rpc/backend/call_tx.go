@@ -117,52 +117,112 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 // SendRawTransaction send a raw Ethereum transaction.
 func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
-	// RLP decode raw transaction bytes
-	tx := &ethtypes.Transaction{}
-	if err := tx.UnmarshalBinary(data); err != nil {
-		b.logger.Error("transaction decoding failed", "error", err.Error())
+	ethereumTx, err := b.decodeRawTransaction(data)
+	if err != nil {
 		return common.Hash{}, err
 	}
 
-	// check the local node config in case unprotected txs are disabled
-	if !b.UnprotectedAllowed() && !tx.Protected() {
-		// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
-		return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+	// Query params to use the EVM denomination
+	res, err := b.queryClient.QueryClient.Params(b.ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		b.logger.Error("failed to query evm params", "error", err.Error())
+		return common.Hash{}, err
 	}
 
-	ethereumTx := &evmtypes.MsgEthereumTx{}
-	if err := ethereumTx.FromEthereumTx(tx); err != nil {
-		b.logger.Error("transaction converting failed", "error", err.Error())
+	cosmosTx, err := ethereumTx.BuildTx(b.clientCtx.TxConfig.NewTxBuilder(), res.Params.EvmDenom)
+	if err != nil {
+		b.logger.Error("failed to build cosmos tx", "error", err.Error())
 		return common.Hash{}, err
 	}
 
-	if err := ethereumTx.ValidateBasic(); err != nil {
-		b.logger.Debug("tx failed basic validation", "error", err.Error())
-		return common.Hash{}, err
+	txHash := ethereumTx.TxHash()
+	return txHash, b.broadcastCosmosTx(cosmosTx)
+}
+
+// SendRawTransactionBatch sends raw Ethereum transactions of the same sender with
+// consecutive nonces in a single cosmos tx, which executes them atomically: either
+// all of them succeed or all of them are reverted.
+func (b *Backend) SendRawTransactionBatch(data []hexutil.Bytes) ([]common.Hash, error) {
+	msgs := make([]*evmtypes.MsgEthereumTx, len(data))
+	hashes := make([]common.Hash, len(data))
+	for i, raw := range data {
+		ethereumTx, err := b.decodeRawTransaction(raw)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %d: %w", i, err)
+		}
+		msgs[i] = ethereumTx
+		hashes[i] = ethereumTx.TxHash()
 	}
 
 	// Query params to use the EVM denomination
 	res, err := b.queryClient.QueryClient.Params(b.ctx, &evmtypes.QueryParamsRequest{})
 	if err != nil {
 		b.logger.Error("failed to query evm params", "error", err.Error())
-		return common.Hash{}, err
+		return nil, err
 	}
 
-	cosmosTx, err := ethereumTx.BuildTx(b.clientCtx.TxConfig.NewTxBuilder(), res.Params.EvmDenom)
+	cosmosTx, err := evmtypes.BuildBatchTx(b.clientCtx.TxConfig.NewTxBuilder(), res.Params.EvmDenom, msgs...)
 	if err != nil {
-		b.logger.Error("failed to build cosmos tx", "error", err.Error())
-		return common.Hash{}, err
+		b.logger.Error("failed to build cosmos batch tx", "error", err.Error())
+		return nil, err
+	}
+
+	return hashes, b.broadcastCosmosTx(cosmosTx)
+}
+
+// decodeRawTransaction decodes and validates a raw Ethereum transaction.
+func (b *Backend) decodeRawTransaction(data hexutil.Bytes) (*evmtypes.MsgEthereumTx, error) {
+	ethereumTx := &evmtypes.MsgEthereumTx{}
+
+	// set code transactions are unknown to go-ethereum, they are always replay-protected
+	if len(data) > 0 && data[0] == evmtypes.SetCodeTxType {
+		if err := ethereumTx.UnmarshalBinary(data); err != nil {
+			b.logger.Error("transaction decoding failed", "error", err.Error())
+			return nil, err
+		}
+	} else if err := b.decodeEthereumTransaction(ethereumTx, data); err != nil {
+		return nil, err
 	}
 
+	if err := ethereumTx.ValidateBasic(); err != nil {
+		b.logger.Debug("tx failed basic validation", "error", err.Error())
+		return nil, err
+	}
+	return ethereumTx, nil
+}
+
+// decodeEthereumTransaction decodes a raw transaction of the types supported by
+// go-ethereum into the given msg.
+func (b *Backend) decodeEthereumTransaction(ethereumTx *evmtypes.MsgEthereumTx, data hexutil.Bytes) error {
+	// RLP decode raw transaction bytes
+	tx := &ethtypes.Transaction{}
+	if err := tx.UnmarshalBinary(data); err != nil {
+		b.logger.Error("transaction decoding failed", "error", err.Error())
+		return err
+	}
+
+	// check the local node config in case unprotected txs are disabled
+	if !b.UnprotectedAllowed() && !tx.Protected() {
+		// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
+		return errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+	}
+
+	if err := ethereumTx.FromEthereumTx(tx); err != nil {
+		b.logger.Error("transaction converting failed", "error", err.Error())
+		return err
+	}
+	return nil
+}
+
+// broadcastCosmosTx encodes the cosmos tx with the default encoder and broadcasts it in sync mode.
+func (b *Backend) broadcastCosmosTx(cosmosTx sdk.Tx) error {
 	// Encode transaction by default Tx encoder
 	txBytes, err := b.clientCtx.TxConfig.TxEncoder()(cosmosTx)
 	if err != nil {
 		b.logger.Error("failed to encode eth tx using default encoder", "error", err.Error())
-		return common.Hash{}, err
+		return err
 	}
 
-	txHash := ethereumTx.AsTransaction().Hash()
-
 	syncCtx := b.clientCtx.WithBroadcastMode(flags.BroadcastSync)
 	rsp, err := syncCtx.BroadcastTx(txBytes)
 	if rsp != nil && rsp.Code != 0 {
@@ -170,10 +230,9 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 	}
 	if err != nil {
 		b.logger.Error("failed to broadcast tx", "error", err.Error())
-		return txHash, err
+		return err
 	}
-
-	return txHash, nil
+	return nil
 }
 
 // SetTxDefaults populates tx message with default values in case they are not
@@ -311,6 +370,17 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 		blockNr = *blockNrOptional
 	}
 
+	gas, err := b.estimateGas(args, blockNr)
+	if err != nil {
+		if err := b.archiveFallback(blockNr.Int64(), err, &gas, "eth_estimateGas", args, blockArg(blockNr.Int64())); err != nil {
+			return 0, err
+		}
+	}
+	return gas, nil
+}
+
+// estimateGas returns the gas used by the transaction at the block, simulated on the node's state.
+func (b *Backend) estimateGas(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (hexutil.Uint64, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
 		return 0, err
@@ -318,8 +388,7 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 
 	header, err := b.TendermintBlockByNumber(blockNr)
 	if err != nil {
-		// the error message imitates geth behavior
-		return 0, errors.New("header not found")
+		return 0, b.headerNotFound(blockNr.Int64(), err)
 	}
 
 	req := evmtypes.EthCallRequest{
@@ -341,8 +410,26 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 
 // DoCall performs a simulated call operation through the evmtypes. It returns the
 // estimated gas used on the operation or an error if fails.
+//
+// The calls at a height pruned by the node are forwarded to the archive upstream
+// if configured, the response only holds the returned data then.
 func (b *Backend) DoCall(
 	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	res, err := b.doCall(args, blockNr)
+	if err != nil {
+		var ret hexutil.Bytes
+		if err := b.archiveFallback(blockNr.Int64(), err, &ret, "eth_call", args, blockArg(blockNr.Int64())); err != nil {
+			return nil, err
+		}
+		return &evmtypes.MsgEthereumTxResponse{Ret: ret}, nil
+	}
+	return res, nil
+}
+
+// doCall performs the simulated call on the node's state.
+func (b *Backend) doCall(
+	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
@@ -350,8 +437,7 @@ func (b *Backend) DoCall(
 	}
 	header, err := b.TendermintBlockByNumber(blockNr)
 	if err != nil {
-		// the error message imitates geth behavior
-		return nil, errors.New("header not found")
+		return nil, b.headerNotFound(blockNr.Int64(), err)
 	}
 
 	req := evmtypes.EthCallRequest{
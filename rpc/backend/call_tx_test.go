@@ -385,6 +385,104 @@ func (suite *BackendTestSuite) TestSendRawTransaction() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSendRawTransactionBatch() {
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParamsWithoutHeader(queryClient, 1)
+	ethSigner := ethtypes.LatestSigner(suite.backend.ChainConfig())
+	msgs := make([]*evmtypes.MsgEthereumTx, 2)
+	rawTxs := make([]hexutil.Bytes, 2)
+	hashes := make([]common.Hash, 2)
+	for i := range msgs {
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  suite.backend.chainID,
+			Nonce:    uint64(i),
+			To:       &common.Address{},
+			Amount:   big.NewInt(0),
+			GasLimit: 100000,
+			GasPrice: big.NewInt(1),
+		})
+		msg.From = suite.from.Hex()
+		suite.Require().NoError(msg.Sign(ethSigner, suite.signer))
+
+		msgs[i] = msg
+		rawTxs[i], _ = rlp.EncodeToBytes(msg.AsTransaction())
+		hashes[i] = msg.AsTransaction().Hash()
+	}
+
+	cosmosTx, err := evmtypes.BuildBatchTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom, msgs...)
+	suite.Require().NoError(err)
+	txBytes, _ := suite.backend.clientCtx.TxConfig.TxEncoder()(cosmosTx)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		rawTxs       []hexutil.Bytes
+		expHashes    []common.Hash
+		expPass      bool
+	}{
+		{
+			"fail - invalid raw transaction",
+			func() {},
+			[]hexutil.Bytes{rawTxs[0], {}},
+			nil,
+			false,
+		},
+		{
+			"fail - single transaction",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				suite.backend.allowUnprotectedTxs = true
+				RegisterParamsWithoutHeader(queryClient, 1)
+			},
+			rawTxs[:1],
+			nil,
+			false,
+		},
+		{
+			"fail - failed to broadcast transaction",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				suite.backend.allowUnprotectedTxs = true
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBroadcastTxError(client, txBytes)
+			},
+			rawTxs,
+			hashes,
+			false,
+		},
+		{
+			"pass - gets the hashes of the batched eth transactions",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				suite.backend.allowUnprotectedTxs = true
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBroadcastTx(client, txBytes)
+			},
+			rawTxs,
+			hashes,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.SendRawTransactionBatch(tc.rawTxs)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expHashes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestDoCall() {
 	_, bz := suite.buildEthereumTx()
 	gasPrice := (*hexutil.Big)(big.NewInt(1))
@@ -16,6 +16,8 @@
 package backend
 
 import (
+	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
@@ -32,6 +34,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -39,11 +42,17 @@ import (
 	rpctypes "github.com/hetu-project/hetu/v1/rpc/types"
 	"github.com/hetu-project/hetu/v1/server/config"
 	"github.com/hetu-project/hetu/v1/types"
+	"github.com/hetu-project/hetu/v1/wallets/signer"
 	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
 )
 
-// Accounts returns the list of accounts available to this node.
+// Accounts returns the list of accounts available to this node. These are
+// the accounts of the signer if one is configured.
 func (b *Backend) Accounts() ([]common.Address, error) {
+	if b.signer != nil {
+		return b.signer.Accounts()
+	}
+
 	addresses := make([]common.Address, 0) // return [] instead of nil if empty
 
 	infos, err := b.clientCtx.Keyring.List()
@@ -198,6 +207,23 @@ func (b *Backend) ImportRawKey(privkey, password string) (common.Address, error)
 		return common.Address{}, err
 	}
 
+	return b.importECDSAKey(priv, password)
+}
+
+// ImportKeystore decrypts a keystore file (Web3 Secret Storage v3, as written by geth)
+// with the given password, and stores its key into the key directory like ImportRawKey.
+func (b *Backend) ImportKeystore(keyJSON []byte, password string) (common.Address, error) {
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return b.importECDSAKey(key.PrivateKey, password)
+}
+
+// importECDSAKey armors and encrypts the ECDSA key with the password and stores it
+// into the key directory, unless it has already been imported.
+func (b *Backend) importECDSAKey(priv *ecdsa.PrivateKey, password string) (common.Address, error) {
 	privKey := &ethsecp256k1.PrivKey{Key: crypto.FromECDSA(priv)}
 
 	addr := sdk.AccAddress(privKey.PubKey().Address().Bytes())
@@ -243,6 +269,34 @@ func (b *Backend) ListAccounts() ([]common.Address, error) {
 	return addrs, nil
 }
 
+// UnlockAccount unlocks the signer account of the address for the duration,
+// or until it is locked if the duration is zero.
+func (b *Backend) UnlockAccount(address common.Address, password string, duration time.Duration) (bool, error) {
+	if b.signer == nil {
+		return false, signer.ErrDisabled
+	}
+
+	unlocker, ok := b.signer.(signer.Unlocker)
+	if !ok {
+		return false, errors.New("the node's signer doesn't support unlocking accounts")
+	}
+
+	if err := unlocker.Unlock(address, password, duration); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// LockAccount locks the signer account of the address. It returns false if the
+// account was not unlocked.
+func (b *Backend) LockAccount(address common.Address) bool {
+	unlocker, ok := b.signer.(signer.Unlocker)
+	if !ok {
+		return false
+	}
+	return unlocker.Lock(address)
+}
+
 // NewAccount will create a new account and returns the address for the new account.
 func (b *Backend) NewMnemonic(uid string,
 	_ keyring.Language,
@@ -292,17 +346,21 @@ func (b *Backend) SetGasPrice(gasPrice hexutil.Big) bool {
 
 // UnprotectedAllowed returns the node configuration value for allowing
 // unprotected transactions (i.e not replay-protected)
-func (b Backend) UnprotectedAllowed() bool {
+func (b *Backend) UnprotectedAllowed() bool {
 	return b.allowUnprotectedTxs
 }
 
 // RPCGasCap is the global gas cap for eth-call variants.
 func (b *Backend) RPCGasCap() uint64 {
+	b.cfgMtx.RLock()
+	defer b.cfgMtx.RUnlock()
 	return b.cfg.JSONRPC.GasCap
 }
 
 // RPCEVMTimeout is the global evm timeout for eth-call variants.
 func (b *Backend) RPCEVMTimeout() time.Duration {
+	b.cfgMtx.RLock()
+	defer b.cfgMtx.RUnlock()
 	return b.cfg.JSONRPC.EVMTimeout
 }
 
@@ -313,6 +371,8 @@ func (b *Backend) RPCTxFeeCap() float64 {
 
 // RPCFilterCap is the limit for total number of filters that can be created
 func (b *Backend) RPCFilterCap() int32 {
+	b.cfgMtx.RLock()
+	defer b.cfgMtx.RUnlock()
 	return b.cfg.JSONRPC.FilterCap
 }
 
@@ -323,14 +383,33 @@ func (b *Backend) RPCFeeHistoryCap() int32 {
 
 // RPCLogsCap defines the max number of results can be returned from single `eth_getLogs` query.
 func (b *Backend) RPCLogsCap() int32 {
+	b.cfgMtx.RLock()
+	defer b.cfgMtx.RUnlock()
 	return b.cfg.JSONRPC.LogsCap
 }
 
 // RPCBlockRangeCap defines the max block range allowed for `eth_getLogs` query.
 func (b *Backend) RPCBlockRangeCap() int32 {
+	b.cfgMtx.RLock()
+	defer b.cfgMtx.RUnlock()
 	return b.cfg.JSONRPC.BlockRangeCap
 }
 
+// Config returns the effective configuration of the server.
+func (b *Backend) Config() config.Config {
+	b.cfgMtx.RLock()
+	defer b.cfgMtx.RUnlock()
+	return b.cfg
+}
+
+// ReloadConfig applies the reloadable JSON-RPC caps of the configuration, the
+// other values are only read at startup.
+func (b *Backend) ReloadConfig(cfg config.Config) {
+	b.cfgMtx.Lock()
+	defer b.cfgMtx.Unlock()
+	b.cfg.JSONRPC.ApplyReloadable(cfg.JSONRPC)
+}
+
 // RPCMinGasPrice returns the minimum gas price for a transaction obtained from
 // the node config. If set value is 0, it will default to 20.
 
@@ -3,16 +3,20 @@ package backend
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	"cosmossdk.io/math"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/google/uuid"
 	"github.com/hetu-project/hetu/v1/crypto/ethsecp256k1"
 	"github.com/hetu-project/hetu/v1/rpc/backend/mocks"
 	"github.com/hetu-project/hetu/v1/types"
+	"github.com/hetu-project/hetu/v1/wallets/signer"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc/metadata"
 )
@@ -357,3 +361,146 @@ func (suite *BackendTestSuite) TestImportRawKey() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestImportKeystore() {
+	priv, _ := ethsecp256k1.GenerateKey()
+	key, err := priv.ToECDSA()
+	suite.Require().NoError(err)
+	addr := common.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{
+		Id:         uuid.New(),
+		Address:    addr,
+		PrivateKey: key,
+	}, "password", keystore.LightScryptN, keystore.LightScryptP)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name     string
+		keyJSON  []byte
+		password string
+		expPass  bool
+	}{
+		{
+			"fail - invalid keystore",
+			[]byte("{}"),
+			"password",
+			false,
+		},
+		{
+			"fail - wrong password",
+			keyJSON,
+			"wrong",
+			false,
+		},
+		{
+			"pass - returning keystore address",
+			keyJSON,
+			"password",
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+
+			output, err := suite.backend.ImportKeystore(tc.keyJSON, tc.password)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(addr, output)
+
+				_, err := suite.backend.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(addr.Bytes()))
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestUnlockAccount() {
+	priv, _ := ethsecp256k1.GenerateKey()
+	privHex := common.Bytes2Hex(priv.Bytes())
+	addr := common.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		addr         common.Address
+		password     string
+		expPass      bool
+	}{
+		{
+			"fail - signing disabled",
+			func() {
+				suite.backend.signer = nil
+			},
+			addr,
+			"test",
+			false,
+		},
+		{
+			"fail - signer doesn't support unlocking",
+			func() {},
+			addr,
+			"test",
+			false,
+		},
+		{
+			"fail - unknown account",
+			func() {
+				suite.backend.signer = signer.NewLockedSigner(suite.backend.signer)
+			},
+			addr,
+			"test",
+			false,
+		},
+		{
+			"fail - wrong password",
+			func() {
+				suite.backend.signer = signer.NewLockedSigner(suite.backend.signer)
+				_, err := suite.backend.ImportRawKey(privHex, "")
+				suite.Require().NoError(err)
+			},
+			addr,
+			"wrong",
+			false,
+		},
+		{
+			"pass - account unlocked with the keyring passphrase",
+			func() {
+				suite.backend.signer = signer.NewLockedSigner(suite.backend.signer)
+				_, err := suite.backend.ImportRawKey(privHex, "")
+				suite.Require().NoError(err)
+			},
+			addr,
+			"test",
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			unlocked, err := suite.backend.UnlockAccount(tc.addr, tc.password, time.Minute)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(unlocked)
+
+				_, err = suite.backend.Sign(tc.addr, []byte("data"))
+				suite.Require().NoError(err)
+
+				suite.Require().True(suite.backend.LockAccount(tc.addr))
+				_, err = suite.backend.Sign(tc.addr, []byte("data"))
+				suite.Require().ErrorIs(err, signer.ErrLocked)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(unlocked)
+				suite.Require().False(suite.backend.LockAccount(tc.addr))
+			}
+		})
+	}
+}
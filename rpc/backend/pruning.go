@@ -0,0 +1,157 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// lowestHeightRegexp matches the error of the CometBFT queries for a block
+// below the earliest block of the store, pruned or skipped by a state sync.
+var lowestHeightRegexp = regexp.MustCompile(`height \d+ is not available, lowest height is (\d+)`)
+
+// prunedVersionErrors are the messages of the store errors for a version
+// removed by the pruning of the application state.
+var prunedVersionErrors = []string{
+	"failed to load state at height",
+	"version does not exist",
+}
+
+// PrunedStateError is returned for the queries of the state at a height pruned
+// by the node. The message imitates the geth error for a missing historical
+// state, so that the clients can retry with an archive node.
+type PrunedStateError struct {
+	// Height is the queried height.
+	Height int64
+	// Earliest is the earliest height with an available state, 0 if unknown.
+	Earliest int64
+}
+
+func (e *PrunedStateError) Error() string {
+	if e.Earliest == 0 {
+		return fmt.Sprintf("missing trie node: state at block %d is not available, the node is pruned", e.Height)
+	}
+	return fmt.Sprintf("missing trie node: state at block %d is not available, the earliest available block is %d", e.Height, e.Earliest)
+}
+
+// earliestStateHeight returns the earliest height whose state is kept by the
+// node: the earliest block of the CometBFT store, or the oldest height kept by
+// the pruning of the application state if greater.
+func (b *Backend) earliestStateHeight() (int64, error) {
+	status, err := b.clientCtx.Client.Status(b.ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	earliest := status.SyncInfo.EarliestBlockHeight
+	if b.pruningKeepRecent > 0 {
+		if kept := status.SyncInfo.LatestBlockHeight - b.pruningKeepRecent; kept > earliest {
+			earliest = kept
+		}
+	}
+	return earliest, nil
+}
+
+// prunedStateError returns the PrunedStateError of a query of the block or the
+// state at the height failed because of the pruning of the node, or nil if the
+// error has another cause.
+func (b *Backend) prunedStateError(height int64, err error) *PrunedStateError {
+	var pruned *PrunedStateError
+	if errors.As(err, &pruned) {
+		return pruned
+	}
+	// the latest and pending states are never pruned
+	if height <= 0 || err == nil {
+		return nil
+	}
+
+	msg := err.Error()
+	if match := lowestHeightRegexp.FindStringSubmatch(msg); match != nil {
+		lowest, _ := strconv.ParseInt(match[1], 10, 64)
+		return &PrunedStateError{Height: height, Earliest: lowest}
+	}
+
+	for _, prunedMsg := range prunedVersionErrors {
+		if !strings.Contains(msg, prunedMsg) {
+			continue
+		}
+
+		pruned = &PrunedStateError{Height: height}
+		earliest, err := b.earliestStateHeight()
+		if err != nil {
+			b.logger.Debug("failed to get the earliest state height", "error", err.Error())
+			return pruned
+		}
+		// the pruning runs every interval, the state can be missing within the
+		// kept heights
+		if earliest <= height {
+			earliest = height + 1
+		}
+		pruned.Earliest = earliest
+		return pruned
+	}
+	return nil
+}
+
+// headerNotFound returns the error of a block query at the height: the
+// PrunedStateError if the block is pruned, or the geth error otherwise.
+func (b *Backend) headerNotFound(height int64, err error) error {
+	if pruned := b.prunedStateError(height, err); pruned != nil {
+		return pruned
+	}
+	// the error message imitates geth behavior
+	return errors.New("header not found")
+}
+
+// archiveFallback serves the request from the archive upstream if the query of
+// the state at the height failed because of the pruning of the node, decoding
+// the response into the result. It returns the PrunedStateError if no archive
+// upstream is configured, and the error as is if the state isn't pruned.
+func (b *Backend) archiveFallback(height int64, err error, result interface{}, method string, args ...interface{}) error {
+	pruned := b.prunedStateError(height, err)
+	if pruned == nil {
+		return err
+	}
+	if b.archive == nil {
+		return pruned
+	}
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+	if timeout := b.cfg.JSONRPC.HTTPTimeout; timeout > 0 {
+		ctx, cancel = context.WithTimeout(b.ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(b.ctx)
+	}
+	defer cancel()
+
+	b.logger.Debug("forwarding request to the archive upstream", "method", method, "height", height)
+	return b.archive.CallContext(ctx, result, method, args...)
+}
+
+// blockArg encodes the height as the block parameter of the archive requests.
+func blockArg(height int64) hexutil.Uint64 {
+	return hexutil.Uint64(height) //#nosec G701 -- the pruned heights are positive
+}
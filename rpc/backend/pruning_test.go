@@ -0,0 +1,214 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hetu-project/hetu/v1/rpc/backend/mocks"
+	rpctypes "github.com/hetu-project/hetu/v1/rpc/types"
+	utiltx "github.com/hetu-project/hetu/v1/testutil/tx"
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+// archiveService serves the eth namespace of an archive node.
+type archiveService struct{}
+
+func (archiveService) GetBalance(common.Address, hexutil.Uint64) *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(42))
+}
+
+func (archiveService) Call(evmtypes.TransactionArgs, hexutil.Uint64) hexutil.Bytes {
+	return hexutil.Bytes{0x01, 0x02}
+}
+
+func registerPrunedBlock(client *mocks.Client, height, lowest int64) {
+	client.On("Block", rpctypes.ContextWithHeight(height), mock.AnythingOfType("*int64")).
+		Return(nil, fmt.Errorf("height %d is not available, lowest height is %d", height, lowest))
+}
+
+func registerPrunedBalance(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
+	queryClient.On("Balance", rpctypes.ContextWithHeight(height), &evmtypes.QueryBalanceRequest{Address: addr.String()}).
+		Return(nil, status.Errorf(codes.InvalidArgument, "failed to load state at height %d; version does not exist (latest height: 100)", height))
+}
+
+func registerStatusHeights(client *mocks.Client, earliest, latest int64) {
+	client.On("Status", rpctypes.ContextWithHeight(1)).
+		Return(&tmrpctypes.ResultStatus{SyncInfo: tmrpctypes.SyncInfo{EarliestBlockHeight: earliest, LatestBlockHeight: latest}}, nil)
+}
+
+func (suite *BackendTestSuite) TestPrunedStateError() {
+	testCases := []struct {
+		name         string
+		height       int64
+		err          error
+		registerMock func()
+		expPruned    *PrunedStateError
+	}{
+		{
+			"not pruned - latest height",
+			0,
+			errors.New("failed to load state at height 0"),
+			func() {},
+			nil,
+		},
+		{
+			"not pruned - other error",
+			5,
+			errors.New("account not found"),
+			func() {},
+			nil,
+		},
+		{
+			"pruned - block below the earliest block",
+			5,
+			errors.New("RPC error -32603 - Internal error: height 5 is not available, lowest height is 20"),
+			func() {},
+			&PrunedStateError{Height: 5, Earliest: 20},
+		},
+		{
+			"pruned - state beyond the kept heights",
+			5,
+			errors.New("failed to load state at height 5; version does not exist (latest height: 100)"),
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				registerStatusHeights(client, 1, 100)
+			},
+			&PrunedStateError{Height: 5, Earliest: 90},
+		},
+		{
+			"pruned - state within the pruning interval",
+			95,
+			errors.New("failed to load state at height 95; version does not exist (latest height: 100)"),
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				registerStatusHeights(client, 1, 100)
+			},
+			&PrunedStateError{Height: 95, Earliest: 96},
+		},
+		{
+			"pruned - status error",
+			5,
+			errors.New("failed to load state at height 5; version does not exist (latest height: 100)"),
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterStatusError(client)
+			},
+			&PrunedStateError{Height: 5},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			suite.backend.pruningKeepRecent = 10
+			tc.registerMock()
+
+			pruned := suite.backend.prunedStateError(tc.height, tc.err)
+			suite.Require().Equal(tc.expPruned, pruned)
+			if pruned != nil {
+				suite.Require().Contains(pruned.Error(), "missing trie node")
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestArchiveFallback() {
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	addr := utiltx.GenerateAddress()
+
+	archiveServer := rpc.NewServer()
+	suite.Require().NoError(archiveServer.RegisterName("eth", archiveService{}))
+	httpServer := httptest.NewServer(archiveServer)
+	defer httpServer.Close()
+
+	testCases := []struct {
+		name         string
+		withArchive  bool
+		registerMock func()
+		expPass      bool
+	}{
+		{
+			"fail - pruned state without archive upstream",
+			false,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, blockNr.Int64(), nil)
+				suite.Require().NoError(err)
+				registerStatusHeights(client, 1, 100)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				registerPrunedBalance(queryClient, addr, blockNr.Int64())
+			},
+			false,
+		},
+		{
+			"pass - pruned state served by the archive upstream",
+			true,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, blockNr.Int64(), nil)
+				suite.Require().NoError(err)
+				registerStatusHeights(client, 1, 100)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				registerPrunedBalance(queryClient, addr, blockNr.Int64())
+			},
+			true,
+		},
+		{
+			"pass - pruned block served by the archive upstream",
+			true,
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				registerPrunedBlock(client, blockNr.Int64(), 20)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			if tc.withArchive {
+				archive, err := rpc.DialHTTP(httpServer.URL)
+				suite.Require().NoError(err)
+				defer archive.Close()
+				suite.backend.archive = archive
+			}
+			tc.registerMock()
+
+			balance, err := suite.backend.GetBalance(addr, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal((*hexutil.Big)(big.NewInt(42)), balance)
+			} else {
+				var pruned *PrunedStateError
+				suite.Require().ErrorAs(err, &pruned)
+				suite.Require().Equal(blockNr.Int64(), pruned.Height)
+			}
+		})
+	}
+
+	suite.Run("Case pass - pruned call served by the archive upstream", func() {
+		suite.SetupTest()
+		archive, err := rpc.DialHTTP(httpServer.URL)
+		suite.Require().NoError(err)
+		defer archive.Close()
+		suite.backend.archive = archive
+
+		client := suite.backend.clientCtx.Client.(*mocks.Client)
+		registerPrunedBlock(client, blockNr.Int64(), 20)
+
+		res, err := suite.backend.DoCall(evmtypes.TransactionArgs{To: &addr}, blockNr)
+		suite.Require().NoError(err)
+		suite.Require().Equal([]byte{0x01, 0x02}, res.Ret)
+	})
+}
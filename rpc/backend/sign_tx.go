@@ -22,25 +22,35 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 
+	"github.com/hetu-project/hetu/v1/ethereum/eip712"
+	"github.com/hetu-project/hetu/v1/wallets/accounts"
+	"github.com/hetu-project/hetu/v1/wallets/signer"
 	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
 )
 
-// SendTransaction sends transaction based on received args using Node's key to sign it
+// SendTransaction sends transaction based on received args using the node's
+// signer to sign it
 func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	_, err := b.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(args.GetFrom().Bytes()))
-	if err != nil {
-		b.logger.Error("failed to find key in keyring", "address", args.GetFrom(), "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
+	// Look up the signer holding the requested account, falling back to the
+	// hardware wallets if the signer doesn't hold it
+	var (
+		wallet  accounts.Wallet
+		account accounts.Account
+	)
+	impersonated := b.impersonated != nil && b.impersonated(args.GetFrom())
+	err := b.checkSignerAccount(args.GetFrom())
+	if err != nil && !impersonated {
+		var walletErr error
+		if wallet, account, walletErr = b.findWallet(args.GetFrom()); walletErr != nil {
+			b.logger.Error("failed to find signer account", "address", args.GetFrom(), "error", err.Error())
+			return common.Hash{}, fmt.Errorf("failed to find account in the node's signer; %s", err.Error())
+		}
 	}
 
 	if args.ChainID != nil && (b.chainID).Cmp((*big.Int)(args.ChainID)) != 0 {
@@ -58,14 +68,22 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 		return common.Hash{}, err
 	}
 
-	signer := ethtypes.MakeSigner(b.ChainConfig(), new(big.Int).SetUint64(uint64(bn)))
+	ethSigner := ethtypes.MakeSigner(b.ChainConfig(), new(big.Int).SetUint64(uint64(bn)))
 
 	// LegacyTx derives chainID from the signature. To make sure the msg.ValidateBasic makes
 	// the corresponding chainID validation, we need to sign the transaction before calling it
 
 	// Sign transaction
 	msg := args.ToTransaction()
-	if err := msg.Sign(signer, b.clientCtx.Keyring); err != nil {
+	switch {
+	case impersonated:
+		err = signTxImpersonated(msg, ethSigner, args.GetFrom())
+	case wallet != nil:
+		err = b.signTxWithWallet(msg, ethSigner, wallet, account)
+	default:
+		err = b.signTxWithSigner(msg, args.GetFrom())
+	}
+	if err != nil {
 		b.logger.Debug("failed to sign tx", "error", err.Error())
 		return common.Hash{}, err
 	}
@@ -125,47 +143,159 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 
 // Sign signs the provided data using the private key of address via Geth's signature standard.
 func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
-	from := sdk.AccAddress(address.Bytes())
-
-	_, err := b.clientCtx.Keyring.KeyByAddress(from)
-	if err != nil {
-		b.logger.Error("failed to find key in keyring", "address", address.String())
-		return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
+	if err := b.checkSignerAccount(address); err != nil {
+		b.logger.Error("failed to find signer account", "address", address.String())
+		return nil, err
 	}
 
-	// Sign the requested hash with the wallet
-	signature, _, err := b.clientCtx.Keyring.SignByAddress(from, data, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+	// Sign the requested data with the signer
+	signature, err := b.signer.SignData(address, data)
 	if err != nil {
-		b.logger.Error("keyring.SignByAddress failed", "address", address.Hex())
+		b.logger.Error("signer.SignData failed", "address", address.Hex(), "error", err.Error())
 		return nil, err
 	}
-
-	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
 	return signature, nil
 }
 
-// SignTypedData signs EIP-712 conformant typed data
+// SignTypedData signs EIP-712 conformant typed data. If the node's signer
+// doesn't hold the account of the address, the data is signed on the hardware
+// wallet holding it.
 func (b *Backend) SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
-	from := sdk.AccAddress(address.Bytes())
+	if err := b.checkSignerAccount(address); err != nil {
+		wallet, account, walletErr := b.findWallet(address)
+		if walletErr != nil {
+			b.logger.Error("failed to find signer account", "address", address.String())
+			return nil, err
+		}
 
-	_, err := b.clientCtx.Keyring.KeyByAddress(from)
-	if err != nil {
-		b.logger.Error("failed to find key in keyring", "address", address.String())
-		return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
+		// The wallet returns the signature with V as 27/28 already
+		signature, err := wallet.SignTypedData(account, typedData)
+		if err != nil {
+			b.logger.Error("wallet.SignTypedData failed", "address", address.Hex(), "error", err.Error())
+			return nil, err
+		}
+		return signature, nil
 	}
 
-	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	// Sign the requested typed data with the signer
+	signature, err := b.signer.SignTypedData(address, typedData)
 	if err != nil {
+		b.logger.Error("signer.SignTypedData failed", "address", address.Hex(), "error", err.Error())
 		return nil, err
 	}
+	return signature, nil
+}
 
-	// Sign the requested hash with the wallet
-	signature, _, err := b.clientCtx.Keyring.SignByAddress(from, sigHash, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+// SignCosmosTx signs the EIP-712 representation of the Amino JSON or Protobuf
+// encoded Cosmos sign doc, to be used as the signature of an EIP-712 Cosmos tx.
+func (b *Backend) SignCosmosTx(address common.Address, signDoc hexutil.Bytes) (hexutil.Bytes, error) {
+	typedData, err := eip712.GetEIP712TypedDataForMsg(signDoc)
 	if err != nil {
-		b.logger.Error("keyring.SignByAddress failed", "address", address.Hex())
 		return nil, err
 	}
 
-	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
-	return signature, nil
+	return b.SignTypedData(address, typedData)
+}
+
+// Wallets returns the hardware wallets the node is currently aware of.
+func (b *Backend) Wallets() []accounts.Wallet {
+	if b.wallets == nil {
+		return nil
+	}
+	return b.wallets.Wallets()
+}
+
+// findWallet returns the hardware wallet holding the pinned account of the
+// address.
+func (b *Backend) findWallet(address common.Address) (accounts.Wallet, accounts.Account, error) {
+	for _, wallet := range b.Wallets() {
+		for _, account := range wallet.Accounts() {
+			if account.Address == address {
+				return wallet, account, nil
+			}
+		}
+	}
+	return nil, accounts.Account{}, keystore.ErrNoMatch
+}
+
+// checkSignerAccount returns an error if signing is disabled or the node's
+// signer doesn't hold the account of the address.
+func (b *Backend) checkSignerAccount(address common.Address) error {
+	if b.signer == nil {
+		return signer.ErrDisabled
+	}
+
+	addresses, err := b.signer.Accounts()
+	if err != nil {
+		return err
+	}
+	for _, addr := range addresses {
+		if addr == address {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s; %s", keystore.ErrNoMatch, address.Hex())
+}
+
+// signTxWithSigner signs the Ethereum tx of the msg with the account of the
+// node's signer, and sets the signed tx on the msg.
+func (b *Backend) signTxWithSigner(msg *evmtypes.MsgEthereumTx, address common.Address) error {
+	tx, err := b.signer.SignTx(address, msg.AsTransaction(), b.chainID)
+	if err != nil {
+		return err
+	}
+
+	// Check the sender as the signer may be external
+	sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(b.chainID), tx)
+	if err != nil {
+		return err
+	}
+	if sender != address {
+		return fmt.Errorf("signer mismatch: expected %s, got %s", address.Hex(), sender.Hex())
+	}
+
+	return msg.FromEthereumTx(tx)
+}
+
+// signTxImpersonated sets the impersonation signature of the sender on the
+// Ethereum tx of the msg. It is only accepted by chains that impersonate the
+// sender, see Backend.SetImpersonationHook.
+func signTxImpersonated(msg *evmtypes.MsgEthereumTx, signer ethtypes.Signer, from common.Address) error {
+	tx, err := msg.AsTransaction().WithSignature(signer, evmtypes.ImpersonationSignature(from))
+	if err != nil {
+		return err
+	}
+
+	return msg.FromEthereumTx(tx)
+}
+
+// signTxWithWallet signs the Ethereum tx of the msg with the account of the
+// hardware wallet, and sets the signed tx on the msg.
+func (b *Backend) signTxWithWallet(
+	msg *evmtypes.MsgEthereumTx,
+	signer ethtypes.Signer,
+	wallet accounts.Wallet,
+	account accounts.Account,
+) error {
+	tx := msg.AsTransaction()
+
+	signature, err := wallet.SignTx(account, tx, b.chainID)
+	if err != nil {
+		return err
+	}
+
+	tx, err = tx.WithSignature(signer, signature)
+	if err != nil {
+		return err
+	}
+
+	sender, err := ethtypes.Sender(signer, tx)
+	if err != nil {
+		return err
+	}
+	if sender != account.Address {
+		return fmt.Errorf("signer mismatch: expected %s, got %s", account.Address.Hex(), sender.Hex())
+	}
+
+	return msg.FromEthereumTx(tx)
 }
@@ -1,23 +1,29 @@
 package backend
 
 import (
+	"crypto/ecdsa"
 	"fmt"
+	"math/big"
 
 	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	gethaccounts "github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	goethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/hetu-project/hetu/v1/crypto/ethsecp256k1"
 	"github.com/hetu-project/hetu/v1/rpc/backend/mocks"
 	utiltx "github.com/hetu-project/hetu/v1/testutil/tx"
+	"github.com/hetu-project/hetu/v1/wallets/accounts"
+	walletmocks "github.com/hetu-project/hetu/v1/wallets/ledger/mocks"
 	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
 )
 
@@ -161,6 +167,18 @@ func (suite *BackendTestSuite) TestSign() {
 			nil,
 			false,
 		},
+		{
+			"fail - signing disabled",
+			func() {
+				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+				err := suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				suite.Require().NoError(err)
+				suite.backend.signer = nil
+			},
+			from,
+			nil,
+			false,
+		},
 		{
 			"pass - sign nil data",
 			func() {
@@ -181,7 +199,7 @@ func (suite *BackendTestSuite) TestSign() {
 
 			responseBz, err := suite.backend.Sign(tc.fromAddr, tc.inputBz)
 			if tc.expPass {
-				signature, _, err := suite.backend.clientCtx.Keyring.SignByAddress((sdk.AccAddress)(from.Bytes()), tc.inputBz, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+				signature, _, err := suite.backend.clientCtx.Keyring.SignByAddress((sdk.AccAddress)(from.Bytes()), gethaccounts.TextHash(tc.inputBz), signingtypes.SignMode_SIGN_MODE_TEXTUAL)
 				signature[goethcrypto.RecoveryIDOffset] += 27
 				suite.Require().NoError(err)
 				suite.Require().Equal((hexutil.Bytes)(signature), responseBz)
@@ -242,6 +260,200 @@ func (suite *BackendTestSuite) TestSignTypedData() {
 	}
 }
 
+// walletBackend is an accounts.Backend with a fixed set of wallets.
+type walletBackend []accounts.Wallet
+
+func (w walletBackend) Wallets() []accounts.Wallet { return w }
+
+// setupLedgerWallet sets a mock Ledger wallet holding the pinned account of
+// the private key on the backend.
+func setupLedgerWallet(suite *BackendTestSuite, priv *ethsecp256k1.PrivKey) (*walletmocks.Wallet, accounts.Account) {
+	key, err := priv.ToECDSA()
+	suite.Require().NoError(err)
+
+	account := accounts.Account{Address: goethcrypto.PubkeyToAddress(key.PublicKey), PublicKey: &key.PublicKey}
+	wallet := walletmocks.NewWallet(suite.T())
+	wallet.On("Accounts").Return([]accounts.Account{account}).Maybe()
+
+	suite.backend.wallets = walletBackend{wallet}
+	return wallet, account
+}
+
+func (suite *BackendTestSuite) TestSendTransactionLedger() {
+	gasPrice := new(hexutil.Big)
+	gas := hexutil.Uint64(21000)
+	toAddr := utiltx.GenerateAddress()
+	priv, _ := ethsecp256k1.GenerateKey()
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	nonce := hexutil.Uint64(1)
+	baseFee := math.NewInt(1)
+	callArgs := evmtypes.TransactionArgs{
+		From:     &from,
+		To:       &toAddr,
+		GasPrice: gasPrice,
+		Gas:      &gas,
+		Nonce:    &nonce,
+	}
+
+	key, err := priv.ToECDSA()
+	suite.Require().NoError(err)
+	otherKey, err := goethcrypto.GenerateKey()
+	suite.Require().NoError(err)
+
+	signWith := func(signingKey *ecdsa.PrivateKey) func(accounts.Account, *ethtypes.Transaction, *big.Int) []byte {
+		return func(_ accounts.Account, tx *ethtypes.Transaction, chainID *big.Int) []byte {
+			hash := ethtypes.LatestSignerForChainID(chainID).Hash(tx)
+			sig, err := goethcrypto.Sign(hash.Bytes(), signingKey)
+			suite.Require().NoError(err)
+			return sig
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func(wallet *walletmocks.Wallet) []byte
+		expPass      bool
+	}{
+		{
+			"fail - Ledger rejected the transaction",
+			func(wallet *walletmocks.Wallet) []byte {
+				registerSendTxQueries(suite, baseFee)
+				wallet.On("SignTx", mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("denied by the user"))
+				return nil
+			},
+			false,
+		},
+		{
+			"fail - signature of another account",
+			func(wallet *walletmocks.Wallet) []byte {
+				registerSendTxQueries(suite, baseFee)
+				wallet.On("SignTx", mock.Anything, mock.Anything, mock.Anything).Return(signWith(otherKey), nil)
+				return nil
+			},
+			false,
+		},
+		{
+			"pass - Return the transaction hash",
+			func(wallet *walletmocks.Wallet) []byte {
+				client := registerSendTxQueries(suite, baseFee)
+				wallet.On("SignTx", mock.Anything, mock.Anything, suite.backend.chainID).Return(signWith(key), nil)
+
+				ethSigner := ethtypes.LatestSigner(suite.backend.ChainConfig())
+				msg := callArgs.ToTransaction()
+				suite.Require().NoError(suite.backend.signTxWithWallet(msg, ethSigner, wallet, accounts.Account{Address: from}))
+				tx, err := msg.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom)
+				suite.Require().NoError(err)
+				txBytes, err := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+				suite.Require().NoError(err)
+				RegisterBroadcastTx(client, txBytes)
+				return msg.AsTransaction().Hash().Bytes()
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			wallet, _ := setupLedgerWallet(suite, priv)
+			expHash := tc.registerMock(wallet)
+
+			responseHash, err := suite.backend.SendTransaction(callArgs)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(common.BytesToHash(expHash), responseHash)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestSignTypedDataLedger() {
+	priv, _ := ethsecp256k1.GenerateKey()
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signature := make([]byte, goethcrypto.SignatureLength)
+	signature[goethcrypto.RecoveryIDOffset] = 27
+
+	testCases := []struct {
+		name         string
+		registerMock func(wallet *walletmocks.Wallet, account accounts.Account)
+		fromAddr     common.Address
+		expPass      bool
+	}{
+		{
+			"fail - account not in keyring nor wallets",
+			func(*walletmocks.Wallet, accounts.Account) {},
+			utiltx.GenerateAddress(),
+			false,
+		},
+		{
+			"fail - Ledger rejected the typed data",
+			func(wallet *walletmocks.Wallet, account accounts.Account) {
+				wallet.On("SignTypedData", account, mock.Anything).Return(nil, fmt.Errorf("denied by the user"))
+			},
+			from,
+			false,
+		},
+		{
+			"pass - signed on the Ledger",
+			func(wallet *walletmocks.Wallet, account accounts.Account) {
+				wallet.On("SignTypedData", account, mock.Anything).Return(signature, nil)
+			},
+			from,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			wallet, account := setupLedgerWallet(suite, priv)
+			tc.registerMock(wallet, account)
+
+			responseBz, err := suite.backend.SignTypedData(tc.fromAddr, apitypes.TypedData{})
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal((hexutil.Bytes)(signature), responseBz)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestWallets() {
+	suite.Require().Empty(suite.backend.Wallets())
+
+	priv, _ := ethsecp256k1.GenerateKey()
+	wallet, account := setupLedgerWallet(suite, priv)
+	suite.Require().Equal([]accounts.Wallet{wallet}, suite.backend.Wallets())
+
+	found, foundAccount, err := suite.backend.findWallet(account.Address)
+	suite.Require().NoError(err)
+	suite.Require().Equal(wallet, found)
+	suite.Require().Equal(account, foundAccount)
+
+	_, _, err = suite.backend.findWallet(utiltx.GenerateAddress())
+	suite.Require().Error(err)
+}
+
+// registerSendTxQueries registers the queries of SendTransaction before the
+// broadcast of the tx.
+func registerSendTxQueries(suite *BackendTestSuite, baseFee math.Int) *mocks.Client {
+	var header metadata.MD
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	RegisterParams(queryClient, &header, 1)
+	_, err := RegisterBlock(client, 1, nil)
+	suite.Require().NoError(err)
+	_, err = RegisterBlockResults(client, 1)
+	suite.Require().NoError(err)
+	RegisterBaseFee(queryClient, baseFee)
+	RegisterParamsWithoutHeader(queryClient, 1)
+	return client
+}
+
 func broadcastTx(suite *BackendTestSuite, priv *ethsecp256k1.PrivKey, baseFee math.Int, callArgsDefault evmtypes.TransactionArgs) (client *mocks.Client, txBytes []byte) {
 	var header metadata.MD
 	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
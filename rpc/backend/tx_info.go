@@ -59,7 +59,7 @@ func (b *Backend) GetTransactionByHash(txHash common.Hash) (*rpctypes.RPCTransac
 		return nil, errors.New("invalid ethereum tx")
 	}
 
-	blockRes, err := b.rpcClient.BlockResults(b.ctx, &block.Block.Height)
+	blockRes, err := b.TendermintBlockResultByNumber(&block.Block.Height)
 	if err != nil {
 		b.logger.Debug("block result not found", "height", block.Block.Height, "error", err.Error())
 		return nil, nil
@@ -155,6 +155,10 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	hexTx := hash.Hex()
 	b.logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
 
+	if receipt, ok := b.cache.receipts.get(hash); ok {
+		return copyResponse(receipt), nil
+	}
+
 	res, err := b.GetTxByEthHash(hash)
 	if err != nil {
 		b.logger.Debug("tx not found", "hash", hexTx, "error", err.Error())
@@ -182,7 +186,7 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	}
 
 	cumulativeGasUsed := uint64(0)
-	blockRes, err := b.rpcClient.BlockResults(b.ctx, &res.Height)
+	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
 	if err != nil {
 		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
 		return nil, nil
@@ -255,7 +259,7 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		// sender and receiver (contract or EOA) addreses
 		"from": from,
 		"to":   txData.GetTo(),
-		"type": hexutil.Uint(ethMsg.AsTransaction().Type()),
+		"type": hexutil.Uint(txData.TxType()),
 	}
 
 	if logs == nil {
@@ -277,6 +281,16 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		}
 	}
 
+	// the txs of an atomic batch share the hash of the first one as batch id
+	if evmtypes.IsBatchTx(tx) {
+		if batchID, ok := evmtypes.BatchID(tx.GetMsgs()); ok {
+			receipt["batchId"] = batchID
+		}
+	}
+
+	if b.cache.cacheable(res.Height) {
+		b.cache.receipts.add(hash, res.Height, copyResponse(receipt))
+	}
 	return receipt, nil
 }
 
@@ -295,7 +309,7 @@ func (b *Backend) GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error)
 		return nil, nil
 	}
 
-	resBlockResult, err := b.rpcClient.BlockResults(b.ctx, &res.Height)
+	resBlockResult, err := b.TendermintBlockResultByNumber(&res.Height)
 	if err != nil {
 		b.logger.Debug("block result not found", "number", res.Height, "error", err.Error())
 		return nil, nil
@@ -346,38 +360,69 @@ func (b *Backend) GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNum
 // TODO: Don't need to convert once hashing is fixed on Tendermint
 // https://github.com/cometbft/cometbft/issues/6539
 func (b *Backend) GetTxByEthHash(hash common.Hash) (*types.TxResult, error) {
+	if txResult, ok := b.cache.txsByHash.get(hash); ok {
+		return copyTxResult(txResult), nil
+	}
+
+	var (
+		txResult *types.TxResult
+		err      error
+	)
 	if b.indexer != nil {
-		return b.indexer.GetByTxHash(hash)
+		txResult, err = b.indexer.GetByTxHash(hash)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// fallback to tendermint tx indexer
+		query := fmt.Sprintf("%s.%s='%s'", evmtypes.TypeMsgEthereumTx, evmtypes.AttributeKeyEthereumTxHash, hash.Hex())
+		txResult, err = b.queryTendermintTxIndexer(query, func(txs *rpctypes.ParsedTxs) *rpctypes.ParsedTx {
+			return txs.GetTxByHash(hash)
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "GetTxByEthHash %s", hash.Hex())
+		}
 	}
 
-	// fallback to tendermint tx indexer
-	query := fmt.Sprintf("%s.%s='%s'", evmtypes.TypeMsgEthereumTx, evmtypes.AttributeKeyEthereumTxHash, hash.Hex())
-	txResult, err := b.queryTendermintTxIndexer(query, func(txs *rpctypes.ParsedTxs) *rpctypes.ParsedTx {
-		return txs.GetTxByHash(hash)
-	})
-	if err != nil {
-		return nil, errorsmod.Wrapf(err, "GetTxByEthHash %s", hash.Hex())
+	if txResult != nil && b.cache.cacheable(txResult.Height) {
+		b.cache.txsByHash.add(hash, txResult.Height, copyTxResult(txResult))
 	}
 	return txResult, nil
 }
 
 // GetTxByTxIndex uses `/tx_query` to find transaction by tx index of valid ethereum txs
 func (b *Backend) GetTxByTxIndex(height int64, index uint) (*types.TxResult, error) {
+	key := txIndexKey{height: height, index: index}
+	if txResult, ok := b.cache.txsByIndex.get(key); ok {
+		return copyTxResult(txResult), nil
+	}
+
+	var (
+		txResult *types.TxResult
+		err      error
+	)
 	int32Index := int32(index) // #nosec G701 -- checked for int overflow already
 	if b.indexer != nil {
-		return b.indexer.GetByBlockAndIndex(height, int32Index)
+		txResult, err = b.indexer.GetByBlockAndIndex(height, int32Index)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// fallback to tendermint tx indexer
+		query := fmt.Sprintf("tx.height=%d AND %s.%s=%d",
+			height, evmtypes.TypeMsgEthereumTx,
+			evmtypes.AttributeKeyTxIndex, index,
+		)
+		txResult, err = b.queryTendermintTxIndexer(query, func(txs *rpctypes.ParsedTxs) *rpctypes.ParsedTx {
+			return txs.GetTxByTxIndex(int(index)) // #nosec G701 -- checked for int overflow already
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "GetTxByTxIndex %d %d", height, index)
+		}
 	}
 
-	// fallback to tendermint tx indexer
-	query := fmt.Sprintf("tx.height=%d AND %s.%s=%d",
-		height, evmtypes.TypeMsgEthereumTx,
-		evmtypes.AttributeKeyTxIndex, index,
-	)
-	txResult, err := b.queryTendermintTxIndexer(query, func(txs *rpctypes.ParsedTxs) *rpctypes.ParsedTx {
-		return txs.GetTxByTxIndex(int(index)) // #nosec G701 -- checked for int overflow already
-	})
-	if err != nil {
-		return nil, errorsmod.Wrapf(err, "GetTxByTxIndex %d %d", height, index)
+	if txResult != nil && b.cache.cacheable(height) {
+		b.cache.txsByIndex.add(key, height, copyTxResult(txResult))
 	}
 	return txResult, nil
 }
@@ -398,7 +443,7 @@ func (b *Backend) queryTendermintTxIndexer(query string, txGetter func(*rpctypes
 
 	var tx sdk.Tx
 	if txResult.TxResult.Code != 0 {
-		// it's only needed when the tx exceeds block gas limit
+		// it's only needed when the tx exceeds block gas limit or is a reverted batch
 		tx, err = b.clientCtx.TxConfig.TxDecoder()(txResult.Tx)
 		if err != nil {
 			return nil, fmt.Errorf("invalid ethereum tx")
@@ -410,7 +455,7 @@ func (b *Backend) queryTendermintTxIndexer(query string, txGetter func(*rpctypes
 
 // GetTransactionByBlockAndIndex is the common code shared by `GetTransactionByBlockNumberAndIndex` and `GetTransactionByBlockHashAndIndex`.
 func (b *Backend) GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*rpctypes.RPCTransaction, error) {
-	blockRes, err := b.rpcClient.BlockResults(b.ctx, &block.Block.Height)
+	blockRes, err := b.TendermintBlockResultByNumber(&block.Block.Height)
 	if err != nil {
 		return nil, nil
 	}
@@ -0,0 +1,122 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/hetu-project/hetu/v1/rpc/access"
+)
+
+// ErrCodeDraining is the JSON-RPC error code of the requests refused while draining.
+const ErrCodeDraining = -32000
+
+// Drain refuses the new requests once started while the in-flight ones finish.
+type Drain struct {
+	mtx      sync.Mutex
+	draining bool
+	inFlight int
+	// idle is closed when the drain is started and no request is in flight
+	idle chan struct{}
+}
+
+// NewDrain returns a drain accepting the requests until started.
+func NewDrain() *Drain {
+	return &Drain{idle: make(chan struct{})}
+}
+
+// Start refuses the new requests.
+func (d *Drain) Start() {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	if d.draining {
+		return
+	}
+	d.draining = true
+	if d.inFlight == 0 {
+		close(d.idle)
+	}
+}
+
+// Draining returns true if the drain is started.
+func (d *Drain) Draining() bool {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	return d.draining
+}
+
+// InFlight returns the number of requests being served.
+func (d *Drain) InFlight() int {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	return d.inFlight
+}
+
+// Wait blocks until the started drain has no request in flight, or the context is done.
+func (d *Drain) Wait(ctx context.Context) error {
+	select {
+	case <-d.idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Middleware returns a handler serving the requests until the drain is started, and
+// refusing them with a 503 status after.
+func (d *Drain) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !d.acquire() {
+			w.Header().Set("Connection", "close")
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_ = json.NewEncoder(w).Encode(access.ErrorResponse{ // #nosec G703
+				Jsonrpc: "2.0",
+				ID:      json.RawMessage("null"),
+				Error:   access.ErrorMessage{Code: ErrCodeDraining, Message: "server is shutting down"},
+			})
+			return
+		}
+		defer d.release()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (d *Drain) acquire() bool {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	if d.draining {
+		return false
+	}
+	d.inFlight++
+	return true
+}
+
+func (d *Drain) release() {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	d.inFlight--
+	if d.draining && d.inFlight == 0 {
+		close(d.idle)
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+// Package health implements the health and readiness endpoints of the JSON-RPC server
+// for the load balancers, and the drain of its requests on shutdown.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/hetu-project/hetu/v1/types"
+)
+
+// checkTimeout bounds the queries of a readiness check.
+const checkTimeout = 5 * time.Second
+
+// StatusClient is the CometBFT client queried for the sync status of the node.
+type StatusClient interface {
+	Status(context.Context) (*coretypes.ResultStatus, error)
+}
+
+// Status is the readiness of the node to serve the JSON-RPC requests.
+type Status struct {
+	Ready bool `json:"ready"`
+	// Reason explains why the node is not ready.
+	Reason       string `json:"reason,omitempty"`
+	Draining     bool   `json:"draining"`
+	CatchingUp   bool   `json:"catching_up"`
+	LatestHeight int64  `json:"latest_height"`
+	// IndexerLag is the number of blocks not indexed yet, omitted if the indexer is disabled.
+	IndexerLag *int64 `json:"indexer_lag,omitempty"`
+	// WebsocketConnected is true if the CometBFT websocket serving the subscriptions is connected.
+	WebsocketConnected bool `json:"websocket_connected"`
+}
+
+// Checker serves the health and readiness endpoints.
+type Checker struct {
+	client        StatusClient
+	indexer       types.EVMTxIndexer
+	maxIndexerLag int64
+	wsConnected   func() bool
+	drain         *Drain
+}
+
+// NewChecker creates a checker of the node readiness. The indexer is nil if disabled.
+func NewChecker(
+	client StatusClient,
+	indexer types.EVMTxIndexer,
+	maxIndexerLag int64,
+	wsConnected func() bool,
+	drain *Drain,
+) *Checker {
+	return &Checker{
+		client:        client,
+		indexer:       indexer,
+		maxIndexerLag: maxIndexerLag,
+		wsConnected:   wsConnected,
+		drain:         drain,
+	}
+}
+
+// Check returns the readiness of the node: it is not draining, not catching up, its
+// indexer lag is under the threshold and the CometBFT websocket is connected.
+func (c *Checker) Check(ctx context.Context) Status {
+	status := Status{
+		Draining:           c.drain.Draining(),
+		WebsocketConnected: c.wsConnected(),
+	}
+
+	if c.client == nil {
+		status.Reason = "no CometBFT node"
+		return status
+	}
+
+	res, err := c.client.Status(ctx)
+	if err != nil {
+		status.Reason = fmt.Sprintf("failed to query the node status: %s", err)
+		return status
+	}
+	status.CatchingUp = res.SyncInfo.CatchingUp
+	status.LatestHeight = res.SyncInfo.LatestBlockHeight
+
+	if c.indexer != nil {
+		indexed, err := c.indexer.LastIndexedBlock()
+		if err != nil {
+			status.Reason = fmt.Sprintf("failed to query the indexer: %s", err)
+			return status
+		}
+		lag := status.LatestHeight - indexed
+		status.IndexerLag = &lag
+	}
+
+	switch {
+	case status.Draining:
+		status.Reason = "draining"
+	case status.CatchingUp:
+		status.Reason = "catching up"
+	case status.IndexerLag != nil && *status.IndexerLag > c.maxIndexerLag:
+		status.Reason = fmt.Sprintf("indexer lag %d is above %d", *status.IndexerLag, c.maxIndexerLag)
+	case !status.WebsocketConnected:
+		status.Reason = "CometBFT websocket disconnected"
+	default:
+		status.Ready = true
+	}
+	return status
+}
+
+// HealthHandler reports that the process is up, including while draining.
+func (c *Checker) HealthHandler(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// ReadyHandler reports the readiness of the node with a 200 status, or a 503 status if
+// it is not ready.
+func (c *Checker) ReadyHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), checkTimeout)
+	defer cancel()
+
+	status := c.Check(ctx)
+	code := http.StatusOK
+	if !status.Ready {
+		code = http.StatusServiceUnavailable
+	}
+	writeJSON(w, code, status)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v) // #nosec G703
+}
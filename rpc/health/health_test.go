@@ -0,0 +1,156 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/types"
+)
+
+type statusClient struct {
+	status *coretypes.ResultStatus
+	err    error
+}
+
+func (c *statusClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return c.status, c.err
+}
+
+type indexer struct {
+	types.EVMTxIndexer
+	last int64
+}
+
+func (i *indexer) LastIndexedBlock() (int64, error) {
+	return i.last, nil
+}
+
+func TestDrain(t *testing.T) {
+	drain := NewDrain()
+	release := make(chan struct{})
+	handler := drain.Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	// a request is in flight when the drain starts
+	inFlight := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		handler.ServeHTTP(inFlight, httptest.NewRequest(http.MethodPost, "/", nil))
+		close(done)
+	}()
+	require.Eventually(t, func() bool { return drain.InFlight() == 1 }, time.Second, time.Millisecond)
+
+	drain.Start()
+	require.True(t, drain.Draining())
+
+	refused := httptest.NewRecorder()
+	handler.ServeHTTP(refused, httptest.NewRequest(http.MethodPost, "/", nil))
+	require.Equal(t, http.StatusServiceUnavailable, refused.Code)
+	require.Contains(t, refused.Body.String(), "server is shutting down")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, drain.Wait(ctx), context.DeadlineExceeded)
+
+	close(release)
+	<-done
+	require.Equal(t, http.StatusOK, inFlight.Code)
+	require.NoError(t, drain.Wait(context.Background()))
+}
+
+func TestCheck(t *testing.T) {
+	testCases := []struct {
+		name      string
+		client    *statusClient
+		indexer   types.EVMTxIndexer
+		connected bool
+		draining  bool
+		expReason string
+	}{
+		{
+			"ready",
+			&statusClient{status: &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 10}}},
+			&indexer{last: 8},
+			true,
+			false,
+			"",
+		},
+		{
+			"node status error",
+			&statusClient{err: errors.New("connection refused")},
+			nil,
+			true,
+			false,
+			"failed to query the node status: connection refused",
+		},
+		{
+			"catching up",
+			&statusClient{status: &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{CatchingUp: true}}},
+			nil,
+			true,
+			false,
+			"catching up",
+		},
+		{
+			"indexer lagging",
+			&statusClient{status: &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 10}}},
+			&indexer{last: 7},
+			true,
+			false,
+			"indexer lag 3 is above 2",
+		},
+		{
+			"websocket disconnected",
+			&statusClient{status: &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 10}}},
+			nil,
+			false,
+			false,
+			"CometBFT websocket disconnected",
+		},
+		{
+			"draining",
+			&statusClient{status: &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 10}}},
+			nil,
+			true,
+			true,
+			"draining",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			drain := NewDrain()
+			if tc.draining {
+				drain.Start()
+			}
+			checker := NewChecker(tc.client, tc.indexer, 2, func() bool { return tc.connected }, drain)
+
+			status := checker.Check(context.Background())
+			require.Equal(t, tc.expReason == "", status.Ready)
+			require.Equal(t, tc.expReason, status.Reason)
+
+			rec := httptest.NewRecorder()
+			checker.ReadyHandler(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+			if status.Ready {
+				require.Equal(t, http.StatusOK, rec.Code)
+			} else {
+				require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+			}
+
+			rec = httptest.NewRecorder()
+			checker.HealthHandler(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.True(t, strings.Contains(rec.Body.String(), `"ok"`))
+		})
+	}
+}
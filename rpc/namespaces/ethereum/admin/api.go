@@ -0,0 +1,87 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package admin
+
+import (
+	"net/url"
+	"time"
+
+	"cosmossdk.io/log"
+
+	"github.com/hetu-project/hetu/v1/server/config"
+)
+
+// redactedKey replaces the JSON-RPC API keys in the returned configuration.
+const redactedKey = "<redacted>"
+
+// Backend defines the methods of the EVM backend used by the admin API.
+type Backend interface {
+	Config() config.Config
+}
+
+// ConfigResult is the effective configuration of the node.
+type ConfigResult struct {
+	// Config holds the values keyed by their app.toml key.
+	Config map[string]interface{} `json:"config"`
+	// Reloadable lists the keys applied when the app.toml file changes.
+	Reloadable []string `json:"reloadable"`
+}
+
+// API offers the administration methods of the node.
+type API struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewAPI creates an instance of the admin API.
+func NewAPI(logger log.Logger, backend Backend) *API {
+	return &API{
+		logger:  logger.With("module", "admin"),
+		backend: backend,
+	}
+}
+
+// Config returns the effective configuration of the node, including the
+// changes of the app.toml file reloaded since the start. The API keys of the
+// access control and the password of the archive upstream are redacted.
+func (api *API) Config() ConfigResult {
+	api.logger.Debug("admin_config")
+
+	values := config.Flatten(api.backend.Config())
+	for key, value := range values {
+		switch v := value.(type) {
+		case time.Duration:
+			values[key] = v.String()
+		case []config.JSONRPCAPIKey:
+			keys := make([]config.JSONRPCAPIKey, len(v))
+			copy(keys, v)
+			for i := range keys {
+				keys[i].Key = redactedKey
+			}
+			values[key] = keys
+		}
+	}
+	if upstream, ok := values["json-rpc.archive-upstream"].(string); ok {
+		if u, err := url.Parse(upstream); err == nil {
+			values["json-rpc.archive-upstream"] = u.Redacted()
+		}
+	}
+
+	return ConfigResult{
+		Config:     values,
+		Reloadable: config.ReloadableKeys,
+	}
+}
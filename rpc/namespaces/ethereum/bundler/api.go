@@ -0,0 +1,80 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package bundler
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PublicAPI is the ERC-4337 bundler API, served in the eth namespace.
+type PublicAPI struct {
+	logger  log.Logger
+	bundler *Bundler
+}
+
+// NewPublicAPI creates an instance of the bundler API.
+func NewPublicAPI(logger log.Logger, bundler *Bundler) *PublicAPI {
+	return &PublicAPI{
+		logger:  logger.With("api", "bundler"),
+		bundler: bundler,
+	}
+}
+
+// SendUserOperation validates the user operation by simulation and submits it
+// in the next bundle. It returns the user operation hash.
+func (api *PublicAPI) SendUserOperation(op UserOperation, entryPoint common.Address) (common.Hash, error) {
+	api.logger.Debug("eth_sendUserOperation", "sender", op.Sender.String())
+
+	if err := api.checkEntryPoint(entryPoint); err != nil {
+		return common.Hash{}, err
+	}
+	return api.bundler.Add(op)
+}
+
+// EstimateUserOperationGas estimates the gas limits of the user operation, whose
+// signature must be a valid dummy signature.
+func (api *PublicAPI) EstimateUserOperationGas(op UserOperation, entryPoint common.Address) (*UserOperationGasEstimate, error) {
+	api.logger.Debug("eth_estimateUserOperationGas", "sender", op.Sender.String())
+
+	if err := api.checkEntryPoint(entryPoint); err != nil {
+		return nil, err
+	}
+	return api.bundler.EstimateGas(op)
+}
+
+// GetUserOperationReceipt returns the receipt of a user operation from its
+// UserOperationEvent, or nil if it isn't included in the latest blocks.
+func (api *PublicAPI) GetUserOperationReceipt(hash common.Hash) (*UserOperationReceipt, error) {
+	api.logger.Debug("eth_getUserOperationReceipt", "hash", hash.Hex())
+	return api.bundler.Receipt(hash)
+}
+
+// SupportedEntryPoints returns the EntryPoint contracts supported by the bundler.
+func (api *PublicAPI) SupportedEntryPoints() []common.Address {
+	api.logger.Debug("eth_supportedEntryPoints")
+	return []common.Address{api.bundler.EntryPoint()}
+}
+
+func (api *PublicAPI) checkEntryPoint(entryPoint common.Address) error {
+	if entryPoint != api.bundler.EntryPoint() {
+		return newRPCError(errCodeInvalidParams, fmt.Sprintf("unsupported EntryPoint %s", entryPoint))
+	}
+	return nil
+}
@@ -0,0 +1,448 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package bundler
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/hetu-project/hetu/v1/contracts"
+	rpctypes "github.com/hetu-project/hetu/v1/rpc/types"
+	"github.com/hetu-project/hetu/v1/wallets/signer"
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+const (
+	// bundleInterval is the interval between the bundles of pending user operations
+	bundleInterval = time.Second
+	// maxBundleSize is the maximum number of user operations of a bundle
+	maxBundleSize = 10
+	// maxSubmitAttempts is the number of failed submissions of a user operation
+	// after which it's dropped
+	maxSubmitAttempts = 3
+	// receiptLookbackBlocks is the number of latest blocks searched for the
+	// UserOperationEvent of a user operation
+	receiptLookbackBlocks = 1024
+	// simulationVerificationGas is the verificationGasLimit of the user
+	// operations simulated for gas estimation
+	simulationVerificationGas = 10_000_000
+)
+
+// userOpArgs are the ABI arguments of a single user operation.
+var userOpArgs = contracts.EntryPointABI.Methods["simulateValidation"].Inputs
+
+// Backend defines the queries and transaction submission used by the bundler.
+// It is implemented by the EVM backend of the JSON-RPC server.
+type Backend interface {
+	ChainID() (*hexutil.Big, error)
+	BlockNumber() (hexutil.Uint64, error)
+	HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Header, error)
+	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
+	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
+	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+}
+
+// Bundler accepts ERC-4337 user operations, validates them by simulation with
+// eth_call, and periodically submits them in handleOps transactions to the
+// EntryPoint, signed with the bundler key. The receipts of the operations are
+// read from the UserOperationEvent logs of the EntryPoint.
+type Bundler struct {
+	logger     log.Logger
+	backend    Backend
+	signer     signer.Signer
+	address    common.Address // bundler key address, beneficiary of the bundles
+	entryPoint common.Address
+
+	mu      sync.Mutex
+	pending []common.Hash
+	ops     map[common.Hash]*pendingOp // operations not submitted yet
+	running bool                       // the bundle loop is running
+}
+
+// pendingOp is a user operation waiting for its submission, with the number of
+// failed submissions of its bundles.
+type pendingOp struct {
+	op       UserOperation
+	attempts int
+}
+
+// NewBundler creates a bundler for the canonical EntryPoint, signing the
+// bundles with the keyring key of the given name.
+func NewBundler(logger log.Logger, backend Backend, kr keyring.Keyring, keyName string) (*Bundler, error) {
+	if keyName == "" {
+		return nil, errors.New("bundler key is not configured")
+	}
+
+	record, err := kr.Key(keyName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bundler key %s: %w", keyName, err)
+	}
+
+	addr, err := record.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	// the bundler key is never unlocked, its password is not checked
+	keyringSigner := signer.NewKeyringSigner(kr, "")
+
+	return &Bundler{
+		logger:     logger.With("module", "bundler"),
+		backend:    backend,
+		signer:     keyringSigner,
+		address:    common.BytesToAddress(addr),
+		entryPoint: contracts.EntryPointAddress,
+		ops:        make(map[common.Hash]*pendingOp),
+	}, nil
+}
+
+// EntryPoint returns the address of the EntryPoint supported by the bundler.
+func (b *Bundler) EntryPoint() common.Address {
+	return b.entryPoint
+}
+
+// Add validates the user operation by simulation and adds it to the pending
+// operations of the next bundle. It returns the user operation hash.
+func (b *Bundler) Add(op UserOperation) (common.Hash, error) {
+	chainID, err := b.backend.ChainID()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	hash, err := op.Hash(b.entryPoint, chainID.ToInt())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	b.mu.Lock()
+	_, found := b.ops[hash]
+	b.mu.Unlock()
+	if found {
+		return common.Hash{}, fmt.Errorf("user operation %s already known", hash)
+	}
+
+	result, err := b.simulateValidation(op)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if result.ReturnInfo.SigFailed {
+		return common.Hash{}, newRPCError(errCodeInvalidSignature, "invalid user operation signature")
+	}
+
+	validUntil := result.ReturnInfo.ValidUntil
+	if validUntil.Sign() > 0 && validUntil.Cmp(big.NewInt(time.Now().Unix())) <= 0 {
+		return common.Hash{}, newRPCError(errCodeExpired, "user operation expired")
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, found := b.ops[hash]; found {
+		return common.Hash{}, fmt.Errorf("user operation %s already known", hash)
+	}
+	b.pending = append(b.pending, hash)
+	b.ops[hash] = &pendingOp{op: op}
+
+	if !b.running {
+		b.running = true
+		go b.loop()
+	}
+
+	return hash, nil
+}
+
+// loop submits a bundle of the pending user operations every bundleInterval,
+// until no operation is pending.
+func (b *Bundler) loop() {
+	ticker := time.NewTicker(bundleInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if _, err := b.Bundle(); err != nil {
+			b.logger.Error("failed to submit bundle", "error", err.Error())
+		}
+
+		b.mu.Lock()
+		idle := len(b.pending) == 0
+		if idle {
+			b.running = false
+		}
+		b.mu.Unlock()
+
+		if idle {
+			return
+		}
+	}
+}
+
+// Bundle submits the pending user operations, up to maxBundleSize, in a
+// handleOps transaction. It returns the hash of the transaction, or an empty
+// hash if no operation is pending. The operations of a bundle failing to be
+// submitted are put back in front of the pending ones, and dropped after
+// maxSubmitAttempts failures.
+func (b *Bundler) Bundle() (common.Hash, error) {
+	b.mu.Lock()
+	size := len(b.pending)
+	if size > maxBundleSize {
+		size = maxBundleSize
+	}
+	hashes := append([]common.Hash(nil), b.pending[:size]...)
+	b.pending = b.pending[size:]
+
+	ops := make([]abiUserOperation, 0, size)
+	for _, hash := range hashes {
+		ops = append(ops, b.ops[hash].op.toABI())
+	}
+	b.mu.Unlock()
+
+	if len(ops) == 0 {
+		return common.Hash{}, nil
+	}
+
+	txHash, err := b.submit(ops)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var retried []common.Hash
+	for _, hash := range hashes {
+		if err == nil {
+			delete(b.ops, hash)
+			continue
+		}
+
+		entry := b.ops[hash]
+		entry.attempts++
+		if entry.attempts < maxSubmitAttempts {
+			retried = append(retried, hash)
+			continue
+		}
+		b.logger.Error("dropped user operation", "hash", hash.Hex(), "attempts", entry.attempts)
+		delete(b.ops, hash)
+	}
+	b.pending = append(retried, b.pending...)
+
+	return txHash, err
+}
+
+// submit signs and broadcasts a handleOps transaction for the user operations.
+func (b *Bundler) submit(ops []abiUserOperation) (common.Hash, error) {
+	data, err := contracts.EntryPointABI.Pack("handleOps", ops, b.address)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	input := hexutil.Bytes(data)
+	args, err := b.backend.SetTxDefaults(evmtypes.TransactionArgs{
+		From:  &b.address,
+		To:    &b.entryPoint,
+		Input: &input,
+	})
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	chainID, err := b.backend.ChainID()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	tx, err := b.signer.SignTx(b.address, args.ToTransaction().AsTransaction(), chainID.ToInt())
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return b.backend.SendRawTransaction(raw)
+}
+
+// Receipt returns the receipt of the user operation, or nil if its
+// UserOperationEvent isn't found in the last receiptLookbackBlocks blocks.
+func (b *Bundler) Receipt(hash common.Hash) (*UserOperationReceipt, error) {
+	txHash, err := b.findUserOperationEvent(hash)
+	if err != nil || txHash == (common.Hash{}) {
+		return nil, err
+	}
+
+	txReceipt, err := b.backend.GetTransactionReceipt(txHash)
+	if err != nil || txReceipt == nil {
+		return nil, err
+	}
+
+	logs, _ := txReceipt["logs"].([]*ethtypes.Log)
+	return newUserOperationReceipt(hash, b.entryPoint, logs, txReceipt)
+}
+
+// findUserOperationEvent returns the hash of the transaction emitting the
+// UserOperationEvent of the user operation, searched from the latest block
+// through the block blooms, or an empty hash if not found.
+func (b *Bundler) findUserOperationEvent(hash common.Hash) (common.Hash, error) {
+	latest, err := b.backend.BlockNumber()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	opEvent := contracts.EntryPointABI.Events["UserOperationEvent"]
+	for height := int64(latest); height > 0 && height > int64(latest)-receiptLookbackBlocks; height-- { //#nosec G115 -- the block number fits in int64
+		header, err := b.backend.HeaderByNumber(rpctypes.BlockNumber(height))
+		if err != nil {
+			return common.Hash{}, err
+		}
+		if !ethtypes.BloomLookup(header.Bloom, b.entryPoint) ||
+			!ethtypes.BloomLookup(header.Bloom, opEvent.ID) ||
+			!ethtypes.BloomLookup(header.Bloom, hash) {
+			continue
+		}
+
+		blockLogs, err := b.backend.GetLogsByHeight(&height)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		for _, txLogs := range blockLogs {
+			for _, log := range txLogs {
+				if log.Address == b.entryPoint && len(log.Topics) > 1 && log.Topics[0] == opEvent.ID && log.Topics[1] == hash {
+					return log.TxHash, nil
+				}
+			}
+		}
+	}
+
+	return common.Hash{}, nil
+}
+
+// EstimateGas returns the gas limits of the user operation, estimated by
+// simulation.
+func (b *Bundler) EstimateGas(op UserOperation) (*UserOperationGasEstimate, error) {
+	preVerificationGas, err := op.CalcPreVerificationGas()
+	if err != nil {
+		return nil, err
+	}
+
+	// simulate with the computed preVerificationGas, and without fees so that
+	// the account doesn't need a deposit
+	op.PreVerificationGas = (*hexutil.Big)(new(big.Int).SetUint64(preVerificationGas))
+	op.MaxFeePerGas = nil
+	op.MaxPriorityFeePerGas = nil
+	if op.VerificationGasLimit == nil || op.VerificationGasLimit.ToInt().Sign() == 0 {
+		op.VerificationGasLimit = (*hexutil.Big)(big.NewInt(simulationVerificationGas))
+	}
+
+	result, err := b.simulateValidation(op)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationGas := new(big.Int).Sub(result.ReturnInfo.PreOpGas, op.PreVerificationGas.ToInt())
+
+	var callGas hexutil.Uint64
+	if len(op.CallData) > 0 {
+		callData := op.CallData
+		callGas, err = b.backend.EstimateGas(evmtypes.TransactionArgs{
+			From:  &b.entryPoint,
+			To:    &op.Sender,
+			Input: &callData,
+		}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate call gas: %w", err)
+		}
+	}
+
+	return &UserOperationGasEstimate{
+		PreVerificationGas:   hexutil.Uint64(preVerificationGas),
+		VerificationGasLimit: (*hexutil.Big)(verificationGas),
+		CallGasLimit:         callGas,
+	}, nil
+}
+
+// returnInfo is the IEntryPoint.ReturnInfo struct of the EntryPoint ABI.
+type returnInfo struct {
+	PreOpGas         *big.Int
+	Prefund          *big.Int
+	SigFailed        bool
+	ValidAfter       *big.Int
+	ValidUntil       *big.Int
+	PaymasterContext []byte
+}
+
+// validationResult is the ValidationResult error of EntryPoint.simulateValidation.
+type validationResult struct {
+	ReturnInfo returnInfo
+}
+
+// simulateValidation simulates the validation of the user operation with
+// EntryPoint.simulateValidation, which always reverts, with ValidationResult
+// on success.
+func (b *Bundler) simulateValidation(op UserOperation) (*validationResult, error) {
+	data, err := contracts.EntryPointABI.Pack("simulateValidation", op.toABI())
+	if err != nil {
+		return nil, newRPCError(errCodeInvalidParams, err.Error())
+	}
+
+	input := hexutil.Bytes(data)
+	_, err = b.backend.DoCall(evmtypes.TransactionArgs{
+		To:    &b.entryPoint,
+		Input: &input,
+	}, rpctypes.EthPendingBlockNumber)
+
+	var revertErr *evmtypes.RevertError
+	if !errors.As(err, &revertErr) {
+		if err == nil {
+			err = errors.New("simulateValidation did not revert")
+		}
+		return nil, fmt.Errorf("failed to simulate user operation: %w", err)
+	}
+
+	reason, _ := revertErr.ErrorData().(string)
+	revertData, err := hexutil.Decode(reason)
+	if err != nil {
+		return nil, err
+	}
+
+	failedOp := contracts.EntryPointABI.Errors["FailedOp"]
+	if values, err := failedOp.Unpack(revertData); err == nil {
+		return nil, newRPCError(errCodeRejected, fmt.Sprintf("%v", values.([]interface{})[1]))
+	}
+
+	validation := contracts.EntryPointABI.Errors["ValidationResult"]
+	values, err := validation.Unpack(revertData)
+	if err != nil {
+		return nil, newRPCError(errCodeRejected, fmt.Sprintf("unexpected simulateValidation revert %s", reason))
+	}
+
+	info, ok := abi.ConvertType(values.([]interface{})[0], new(returnInfo)).(*returnInfo)
+	if !ok {
+		return nil, newRPCError(errCodeRejected, fmt.Sprintf("invalid ValidationResult %s", reason))
+	}
+	return &validationResult{ReturnInfo: *info}, nil
+}
@@ -0,0 +1,353 @@
+package bundler
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/contracts"
+	"github.com/hetu-project/hetu/v1/crypto/hd"
+	"github.com/hetu-project/hetu/v1/encoding"
+	rpctypes "github.com/hetu-project/hetu/v1/rpc/types"
+	"github.com/hetu-project/hetu/v1/types"
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+var chainID = big.NewInt(9000)
+
+// fakeBackend simulates the EntryPoint with a fixed simulateValidation revert,
+// and a chain holding the logs of a transaction per block.
+type fakeBackend struct {
+	revertData []byte
+	sendErr    error
+	sent       []*ethtypes.Transaction
+	blocks     [][]*ethtypes.Log
+}
+
+func (f *fakeBackend) ChainID() (*hexutil.Big, error) {
+	return (*hexutil.Big)(chainID), nil
+}
+
+func (f *fakeBackend) BlockNumber() (hexutil.Uint64, error) {
+	return hexutil.Uint64(len(f.blocks)), nil
+}
+
+func (f *fakeBackend) HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Header, error) {
+	header := &ethtypes.Header{Number: big.NewInt(blockNum.Int64())}
+	for _, log := range f.blocks[blockNum-1] {
+		header.Bloom.Add(log.Address.Bytes())
+		for _, topic := range log.Topics {
+			header.Bloom.Add(topic.Bytes())
+		}
+	}
+	return header, nil
+}
+
+func (f *fakeBackend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
+	return [][]*ethtypes.Log{f.blocks[*height-1]}, nil
+}
+
+func (f *fakeBackend) DoCall(evmtypes.TransactionArgs, rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error) {
+	return nil, evmtypes.NewExecErrorWithReason(f.revertData)
+}
+
+func (f *fakeBackend) EstimateGas(evmtypes.TransactionArgs, *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+	return 50_000, nil
+}
+
+func (f *fakeBackend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error) {
+	gas, nonce := hexutil.Uint64(1_000_000), hexutil.Uint64(0)
+	args.Gas, args.Nonce = &gas, &nonce
+	args.ChainID = (*hexutil.Big)(chainID)
+	args.Value = (*hexutil.Big)(new(big.Int))
+	args.MaxFeePerGas = (*hexutil.Big)(big.NewInt(10))
+	args.MaxPriorityFeePerGas = (*hexutil.Big)(big.NewInt(1))
+	return args, nil
+}
+
+func (f *fakeBackend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
+	if f.sendErr != nil {
+		return common.Hash{}, f.sendErr
+	}
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(data); err != nil {
+		return common.Hash{}, err
+	}
+	f.sent = append(f.sent, tx)
+	return tx.Hash(), nil
+}
+
+func (f *fakeBackend) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
+	for _, logs := range f.blocks {
+		if len(logs) > 0 && logs[0].TxHash == hash {
+			return map[string]interface{}{"transactionHash": hash, "logs": logs}, nil
+		}
+	}
+	return nil, nil
+}
+
+type stakeInfo struct {
+	Stake           *big.Int
+	UnstakeDelaySec *big.Int
+}
+
+func validationRevert(t *testing.T, sigFailed bool, validUntil int64) []byte {
+	validation := contracts.EntryPointABI.Errors["ValidationResult"]
+	stake := stakeInfo{new(big.Int), new(big.Int)}
+	data, err := validation.Inputs.Pack(returnInfo{
+		PreOpGas:         big.NewInt(100_000),
+		Prefund:          new(big.Int),
+		SigFailed:        sigFailed,
+		ValidAfter:       new(big.Int),
+		ValidUntil:       big.NewInt(validUntil),
+		PaymasterContext: []byte{},
+	}, stake, stake, stake)
+	require.NoError(t, err)
+	return append(validation.ID.Bytes()[:4], data...)
+}
+
+func failedOpRevert(t *testing.T, reason string) []byte {
+	failedOp := contracts.EntryPointABI.Errors["FailedOp"]
+	data, err := failedOp.Inputs.Pack(big.NewInt(0), reason)
+	require.NoError(t, err)
+	return append(failedOp.ID.Bytes()[:4], data...)
+}
+
+func setupBundler(t *testing.T, backend *fakeBackend) (*Bundler, common.Address) {
+	kr := keyring.NewInMemory(encoding.MakeConfig().Codec, hd.EthSecp256k1Option())
+	record, _, err := kr.NewMnemonic("bundler", keyring.English, types.BIP44HDPath, keyring.DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+
+	b, err := NewBundler(log.NewNopLogger(), backend, kr, "bundler")
+	require.NoError(t, err)
+	return b, common.BytesToAddress(addr)
+}
+
+func testUserOp() UserOperation {
+	return UserOperation{
+		Sender:               common.HexToAddress("0x1000000000000000000000000000000000000001"),
+		Nonce:                (*hexutil.Big)(big.NewInt(1)),
+		CallData:             hexutil.Bytes{0xb6, 0x1d, 0x27, 0xf6},
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(100_000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(200_000)),
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(50_000)),
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(10)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(1)),
+		Signature:            make(hexutil.Bytes, 65),
+	}
+}
+
+func TestUserOperationHash(t *testing.T) {
+	op := testUserOp()
+	hash, err := op.Hash(contracts.EntryPointAddress, chainID)
+	require.NoError(t, err)
+
+	// the hash covers the operation fields, the EntryPoint and the chain ID
+	other := op
+	other.Nonce = (*hexutil.Big)(big.NewInt(2))
+	otherHash, err := other.Hash(contracts.EntryPointAddress, chainID)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherHash)
+
+	otherHash, err = op.Hash(contracts.EntryPointAddress, big.NewInt(1))
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherHash)
+
+	// the signature is not part of the hash
+	other = op
+	other.Signature = hexutil.Bytes{0x01}
+	otherHash, err = other.Hash(contracts.EntryPointAddress, chainID)
+	require.NoError(t, err)
+	require.Equal(t, hash, otherHash)
+}
+
+func TestAdd(t *testing.T) {
+	testCases := []struct {
+		name       string
+		revertData func(t *testing.T) []byte
+		expErr     string
+	}{
+		{
+			"fail - rejected by the account",
+			func(t *testing.T) []byte { return failedOpRevert(t, "AA23 reverted") },
+			"AA23 reverted",
+		},
+		{
+			"fail - invalid signature",
+			func(t *testing.T) []byte { return validationRevert(t, true, 0) },
+			"invalid user operation signature",
+		},
+		{
+			"fail - expired",
+			func(t *testing.T) []byte { return validationRevert(t, false, 1) },
+			"user operation expired",
+		},
+		{
+			"fail - unexpected revert",
+			func(t *testing.T) []byte { return []byte{0x01, 0x02, 0x03, 0x04} },
+			"unexpected simulateValidation revert",
+		},
+		{
+			"pass",
+			func(t *testing.T) []byte { return validationRevert(t, false, 0) },
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b, _ := setupBundler(t, &fakeBackend{revertData: tc.revertData(t)})
+
+			hash, err := b.Add(testUserOp())
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			expHash, err := testUserOp().Hash(contracts.EntryPointAddress, chainID)
+			require.NoError(t, err)
+			require.Equal(t, expHash, hash)
+
+			_, err = b.Add(testUserOp())
+			require.ErrorContains(t, err, "already known")
+		})
+	}
+}
+
+func TestBundleAndReceipt(t *testing.T) {
+	backend := &fakeBackend{revertData: validationRevert(t, false, 0)}
+	b, bundlerAddr := setupBundler(t, backend)
+
+	op := testUserOp()
+	hash, err := b.Add(op)
+	require.NoError(t, err)
+
+	receipt, err := b.Receipt(hash)
+	require.NoError(t, err)
+	require.Nil(t, receipt, "no receipt before the bundle")
+
+	txHash, err := b.Bundle()
+	require.NoError(t, err)
+	require.Len(t, backend.sent, 1)
+
+	// the bundle is a handleOps transaction to the EntryPoint signed by the bundler key
+	tx := backend.sent[0]
+	require.Equal(t, txHash, tx.Hash())
+	require.Equal(t, contracts.EntryPointAddress, *tx.To())
+	sender, err := ethtypes.LatestSignerForChainID(chainID).Sender(tx)
+	require.NoError(t, err)
+	require.Equal(t, bundlerAddr, sender)
+
+	args, err := contracts.EntryPointABI.Methods["handleOps"].Inputs.Unpack(tx.Data()[4:])
+	require.NoError(t, err)
+	require.Equal(t, bundlerAddr, args[1])
+
+	// nothing left to bundle
+	txHash, err = b.Bundle()
+	require.NoError(t, err)
+	require.Equal(t, common.Hash{}, txHash)
+
+	receipt, err = b.Receipt(hash)
+	require.NoError(t, err)
+	require.Nil(t, receipt, "no receipt before the inclusion")
+
+	opEvent := contracts.EntryPointABI.Events["UserOperationEvent"]
+	eventData, err := opEvent.Inputs.NonIndexed().Pack(big.NewInt(1), true, big.NewInt(1_000), big.NewInt(100))
+	require.NoError(t, err)
+	accountLog := &ethtypes.Log{
+		Address: op.Sender,
+		Topics:  []common.Hash{crypto.Keccak256Hash([]byte("Executed()"))},
+		TxHash:  tx.Hash(),
+	}
+	// the bundle is included in the second block, followed by an empty block
+	backend.blocks = [][]*ethtypes.Log{
+		{{Address: op.Sender, TxHash: common.HexToHash("0x01")}},
+		{
+			{
+				Address: contracts.EntryPointAddress,
+				Topics:  []common.Hash{contracts.EntryPointABI.Events["BeforeExecution"].ID},
+				TxHash:  tx.Hash(),
+			},
+			accountLog,
+			{
+				Address: contracts.EntryPointAddress,
+				Topics:  []common.Hash{opEvent.ID, hash, common.BytesToHash(op.Sender.Bytes()), {}},
+				Data:    eventData,
+				TxHash:  tx.Hash(),
+			},
+		},
+		{},
+	}
+
+	receipt, err = b.Receipt(hash)
+	require.NoError(t, err)
+	require.NotNil(t, receipt)
+	require.True(t, receipt.Success)
+	require.Equal(t, hash, receipt.UserOpHash)
+	require.Equal(t, op.Sender, receipt.Sender)
+	require.Equal(t, big.NewInt(1), receipt.Nonce.ToInt())
+	require.Equal(t, common.Address{}, receipt.Paymaster)
+	require.Equal(t, big.NewInt(1_000), receipt.ActualGasCost.ToInt())
+	require.Equal(t, big.NewInt(100), receipt.ActualGasUsed.ToInt())
+	require.Equal(t, []*ethtypes.Log{accountLog}, receipt.Logs)
+}
+
+func TestBundleSubmitFailure(t *testing.T) {
+	backend := &fakeBackend{revertData: validationRevert(t, false, 0), sendErr: errors.New("mempool is full")}
+	b, _ := setupBundler(t, backend)
+
+	_, err := b.Add(testUserOp())
+	require.NoError(t, err)
+
+	// the operation is kept for the next bundles after a failed submission
+	_, err = b.Bundle()
+	require.ErrorContains(t, err, "mempool is full")
+	_, err = b.Add(testUserOp())
+	require.ErrorContains(t, err, "already known")
+
+	backend.sendErr = nil
+	txHash, err := b.Bundle()
+	require.NoError(t, err)
+	require.Len(t, backend.sent, 1)
+	require.Equal(t, backend.sent[0].Hash(), txHash)
+
+	// the operation is dropped after maxSubmitAttempts failures
+	other := testUserOp()
+	other.Nonce = (*hexutil.Big)(big.NewInt(2))
+	_, err = b.Add(other)
+	require.NoError(t, err)
+
+	backend.sendErr = errors.New("mempool is full")
+	for i := 0; i < maxSubmitAttempts; i++ {
+		_, err = b.Bundle()
+		require.Error(t, err)
+	}
+	txHash, err = b.Bundle()
+	require.NoError(t, err)
+	require.Equal(t, common.Hash{}, txHash)
+}
+
+func TestEstimateGas(t *testing.T) {
+	b, _ := setupBundler(t, &fakeBackend{revertData: validationRevert(t, false, 0)})
+
+	op := testUserOp()
+	preVerificationGas, err := op.CalcPreVerificationGas()
+	require.NoError(t, err)
+
+	estimate, err := b.EstimateGas(op)
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Uint64(preVerificationGas), estimate.PreVerificationGas)
+	require.Equal(t, big.NewInt(100_000-int64(preVerificationGas)), estimate.VerificationGasLimit.ToInt())
+	require.Equal(t, hexutil.Uint64(50_000), estimate.CallGasLimit)
+}
@@ -0,0 +1,148 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package bundler
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/hetu-project/hetu/v1/contracts"
+)
+
+// JSON-RPC error codes of the ERC-4337 bundler API.
+const (
+	errCodeInvalidParams    = -32602
+	errCodeRejected         = -32500 // rejected by the EntryPoint or the account
+	errCodeExpired          = -32503 // expired or not yet valid
+	errCodeInvalidSignature = -32507
+)
+
+// rpcError is a JSON-RPC error with an ERC-4337 error code.
+type rpcError struct {
+	code int
+	msg  string
+}
+
+func newRPCError(code int, msg string) *rpcError {
+	return &rpcError{code: code, msg: msg}
+}
+
+func (e *rpcError) Error() string {
+	return e.msg
+}
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *rpcError) ErrorCode() int {
+	return e.code
+}
+
+// UserOperationGasEstimate is the result of eth_estimateUserOperationGas.
+type UserOperationGasEstimate struct {
+	PreVerificationGas   hexutil.Uint64 `json:"preVerificationGas"`
+	VerificationGasLimit *hexutil.Big   `json:"verificationGasLimit"`
+	CallGasLimit         hexutil.Uint64 `json:"callGasLimit"`
+}
+
+// UserOperationReceipt is the result of eth_getUserOperationReceipt.
+type UserOperationReceipt struct {
+	UserOpHash    common.Hash            `json:"userOpHash"`
+	EntryPoint    common.Address         `json:"entryPoint"`
+	Sender        common.Address         `json:"sender"`
+	Nonce         *hexutil.Big           `json:"nonce"`
+	Paymaster     common.Address         `json:"paymaster"`
+	ActualGasCost *hexutil.Big           `json:"actualGasCost"`
+	ActualGasUsed *hexutil.Big           `json:"actualGasUsed"`
+	Success       bool                   `json:"success"`
+	Reason        hexutil.Bytes          `json:"reason,omitempty"`
+	Logs          []*ethtypes.Log        `json:"logs"`
+	Receipt       map[string]interface{} `json:"receipt"`
+}
+
+// newUserOperationReceipt returns the receipt of the user operation from the
+// logs of its bundle transaction, or nil if the transaction has no
+// UserOperationEvent for the operation. The logs of the operation are the logs
+// emitted between the UserOperationEvent of the previous operation, or the
+// BeforeExecution event, and its own.
+func newUserOperationReceipt(
+	hash common.Hash,
+	entryPoint common.Address,
+	logs []*ethtypes.Log,
+	txReceipt map[string]interface{},
+) (*UserOperationReceipt, error) {
+	opEvent := contracts.EntryPointABI.Events["UserOperationEvent"]
+	revertEvent := contracts.EntryPointABI.Events["UserOperationRevertReason"]
+
+	receipt := &UserOperationReceipt{
+		UserOpHash: hash,
+		EntryPoint: entryPoint,
+		Logs:       []*ethtypes.Log{},
+		Receipt:    txReceipt,
+	}
+
+	beforeExecution := contracts.EntryPointABI.Events["BeforeExecution"]
+
+	start := 0
+	for i, log := range logs {
+		if log.Address != entryPoint || len(log.Topics) == 0 {
+			continue
+		}
+
+		switch log.Topics[0] {
+		case beforeExecution.ID:
+			// the logs of the validation of all operations precede BeforeExecution
+			start = i + 1
+
+		case revertEvent.ID:
+			if len(log.Topics) < 2 || log.Topics[1] != hash {
+				continue
+			}
+			values, err := revertEvent.Inputs.NonIndexed().Unpack(log.Data)
+			if err != nil {
+				return nil, err
+			}
+			receipt.Reason = values[1].([]byte)
+
+		case opEvent.ID:
+			if len(log.Topics) < 2 || log.Topics[1] != hash {
+				start = i + 1
+				continue
+			}
+			if len(log.Topics) < 4 {
+				return nil, fmt.Errorf("invalid UserOperationEvent of user operation %s", hash)
+			}
+			values, err := opEvent.Inputs.NonIndexed().Unpack(log.Data)
+			if err != nil {
+				return nil, err
+			}
+			receipt.Sender = common.BytesToAddress(log.Topics[2].Bytes())
+			receipt.Paymaster = common.BytesToAddress(log.Topics[3].Bytes())
+			receipt.Nonce = (*hexutil.Big)(values[0].(*big.Int))
+			receipt.Success = values[1].(bool)
+			receipt.ActualGasCost = (*hexutil.Big)(values[2].(*big.Int))
+			receipt.ActualGasUsed = (*hexutil.Big)(values[3].(*big.Int))
+
+			receipt.Logs = append(receipt.Logs, logs[start:i]...)
+			return receipt, nil
+		}
+	}
+
+	return nil, nil
+}
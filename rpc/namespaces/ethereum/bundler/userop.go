@@ -0,0 +1,173 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package bundler
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Gas overheads of the calldata of a user operation, paid by its preVerificationGas.
+const (
+	fixedGas        = 21000
+	perUserOpGas    = 18300
+	perUserOpWord   = 4
+	zeroByteGas     = 4
+	nonZeroByteGas  = 16
+	dummySigLength  = 65
+	abiWordByteSize = 32
+)
+
+var (
+	bytes32Type, _ = abi.NewType("bytes32", "", nil)
+	uint256Type, _ = abi.NewType("uint256", "", nil)
+	addressType, _ = abi.NewType("address", "", nil)
+
+	// packedUserOpArgs are the fields of a user operation hashed by the EntryPoint
+	packedUserOpArgs = abi.Arguments{
+		{Type: addressType}, // sender
+		{Type: uint256Type}, // nonce
+		{Type: bytes32Type}, // keccak256(initCode)
+		{Type: bytes32Type}, // keccak256(callData)
+		{Type: uint256Type}, // callGasLimit
+		{Type: uint256Type}, // verificationGasLimit
+		{Type: uint256Type}, // preVerificationGas
+		{Type: uint256Type}, // maxFeePerGas
+		{Type: uint256Type}, // maxPriorityFeePerGas
+		{Type: bytes32Type}, // keccak256(paymasterAndData)
+	}
+
+	// userOpHashArgs are the fields of the user operation hash
+	userOpHashArgs = abi.Arguments{
+		{Type: bytes32Type}, // keccak256(packed user operation)
+		{Type: addressType}, // EntryPoint
+		{Type: uint256Type}, // chain ID
+	}
+)
+
+// UserOperation is an ERC-4337 (EntryPoint v0.6) user operation, as sent to
+// eth_sendUserOperation.
+type UserOperation struct {
+	Sender               common.Address `json:"sender"`
+	Nonce                *hexutil.Big   `json:"nonce"`
+	InitCode             hexutil.Bytes  `json:"initCode"`
+	CallData             hexutil.Bytes  `json:"callData"`
+	CallGasLimit         *hexutil.Big   `json:"callGasLimit"`
+	VerificationGasLimit *hexutil.Big   `json:"verificationGasLimit"`
+	PreVerificationGas   *hexutil.Big   `json:"preVerificationGas"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+	PaymasterAndData     hexutil.Bytes  `json:"paymasterAndData"`
+	Signature            hexutil.Bytes  `json:"signature"`
+}
+
+// abiUserOperation is the UserOperation struct of the EntryPoint ABI.
+type abiUserOperation struct {
+	Sender               common.Address
+	Nonce                *big.Int
+	InitCode             []byte
+	CallData             []byte
+	CallGasLimit         *big.Int
+	VerificationGasLimit *big.Int
+	PreVerificationGas   *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	PaymasterAndData     []byte
+	Signature            []byte
+}
+
+// toABI returns the user operation as the EntryPoint ABI struct, with the
+// missing values set to zero.
+func (op UserOperation) toABI() abiUserOperation {
+	return abiUserOperation{
+		Sender:               op.Sender,
+		Nonce:                toBig(op.Nonce),
+		InitCode:             op.InitCode,
+		CallData:             op.CallData,
+		CallGasLimit:         toBig(op.CallGasLimit),
+		VerificationGasLimit: toBig(op.VerificationGasLimit),
+		PreVerificationGas:   toBig(op.PreVerificationGas),
+		MaxFeePerGas:         toBig(op.MaxFeePerGas),
+		MaxPriorityFeePerGas: toBig(op.MaxPriorityFeePerGas),
+		PaymasterAndData:     op.PaymasterAndData,
+		Signature:            op.Signature,
+	}
+}
+
+// Hash returns the hash of the user operation for the EntryPoint and chain ID,
+// as returned by EntryPoint.getUserOpHash.
+func (op UserOperation) Hash(entryPoint common.Address, chainID *big.Int) (common.Hash, error) {
+	abiOp := op.toABI()
+	packed, err := packedUserOpArgs.Pack(
+		abiOp.Sender,
+		abiOp.Nonce,
+		crypto.Keccak256Hash(abiOp.InitCode),
+		crypto.Keccak256Hash(abiOp.CallData),
+		abiOp.CallGasLimit,
+		abiOp.VerificationGasLimit,
+		abiOp.PreVerificationGas,
+		abiOp.MaxFeePerGas,
+		abiOp.MaxPriorityFeePerGas,
+		crypto.Keccak256Hash(abiOp.PaymasterAndData),
+	)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	encoded, err := userOpHashArgs.Pack(crypto.Keccak256Hash(packed), entryPoint, chainID)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// CalcPreVerificationGas returns the gas paid by the user operation for its share
+// of a bundle's calldata and fixed costs, assuming a bundle of a single operation.
+func (op UserOperation) CalcPreVerificationGas() (uint64, error) {
+	abiOp := op.toABI()
+	if len(abiOp.Signature) == 0 {
+		abiOp.Signature = make([]byte, dummySigLength)
+	}
+
+	packed, err := userOpArgs.Pack(abiOp)
+	if err != nil {
+		return 0, err
+	}
+
+	var callDataCost uint64
+	for _, b := range packed {
+		if b == 0 {
+			callDataCost += zeroByteGas
+		} else {
+			callDataCost += nonZeroByteGas
+		}
+	}
+
+	words := uint64(len(packed)+abiWordByteSize-1) / abiWordByteSize
+	return callDataCost + fixedGas + perUserOpGas + perUserOpWord*words, nil
+}
+
+func toBig(b *hexutil.Big) *big.Int {
+	if b == nil {
+		return new(big.Int)
+	}
+	return b.ToInt()
+}
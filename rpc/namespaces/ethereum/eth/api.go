@@ -68,6 +68,7 @@ type EthereumAPI interface {
 	// Allows developers to both send ETH from one address to another, write data
 	// on-chain, and interact with smart contracts.
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	SendRawTransactionBatch(data []hexutil.Bytes) ([]common.Hash, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
 	// eth_sendPrivateTransaction
 	// eth_cancel	PrivateTransaction
@@ -231,6 +232,13 @@ func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	return e.backend.SendRawTransaction(data)
 }
 
+// SendRawTransactionBatch sends raw Ethereum transactions of the same sender with
+// consecutive nonces, executed atomically in a single cosmos tx.
+func (e *PublicAPI) SendRawTransactionBatch(data []hexutil.Bytes) ([]common.Hash, error) {
+	e.logger.Debug("eth_sendRawTransactionBatch", "count", len(data))
+	return e.backend.SendRawTransactionBatch(data)
+}
+
 // SendTransaction sends an Ethereum transaction.
 func (e *PublicAPI) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
 	e.logger.Debug("eth_sendTransaction", "args", args.String())
@@ -0,0 +1,116 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package evm
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/log"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Engine seals the blocks of a dev chain.
+type Engine interface {
+	Mine(timestamp *time.Time) (int64, error)
+	IncreaseTime(d time.Duration) time.Duration
+	SetNextBlockTimestamp(timestamp time.Time) error
+	Snapshot() uint64
+	Revert(id uint64) (bool, error)
+}
+
+// Quantity is a number passed either as a JSON number or as a hex string, as
+// the development tools do for the evm namespace.
+type Quantity uint64
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (q *Quantity) UnmarshalJSON(input []byte) error {
+	var n uint64
+	if err := json.Unmarshal(input, &n); err == nil {
+		*q = Quantity(n)
+		return nil
+	}
+
+	var h hexutil.Uint64
+	if err := json.Unmarshal(input, &h); err != nil {
+		return fmt.Errorf("invalid quantity %s: %w", input, err)
+	}
+	*q = Quantity(h)
+	return nil
+}
+
+// API is the evm_ prefixed set of APIs controlling the blocks of a dev chain,
+// compatible with the local nodes of the Ethereum development tools.
+type API struct {
+	logger log.Logger
+	engine Engine
+}
+
+// NewAPI creates an instance of the evm API.
+func NewAPI(logger log.Logger, engine Engine) *API {
+	return &API{
+		logger: logger.With("api", "evm"),
+		engine: engine,
+	}
+}
+
+// Mine seals a block, at the given timestamp in seconds if not nil, and
+// returns its number.
+func (api *API) Mine(timestamp *Quantity) (hexutil.Uint64, error) {
+	api.logger.Debug("evm_mine", "timestamp", timestamp)
+
+	var t *time.Time
+	if timestamp != nil {
+		ts := time.Unix(int64(*timestamp), 0)
+		t = &ts
+	}
+
+	height, err := api.engine.Mine(t)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(height), nil
+}
+
+// IncreaseTime moves the time of the next blocks forward by the given seconds
+// and returns the total time offset in seconds.
+func (api *API) IncreaseTime(seconds Quantity) int64 {
+	api.logger.Debug("evm_increaseTime", "seconds", seconds)
+	offset := api.engine.IncreaseTime(time.Duration(seconds) * time.Second)
+	return int64(offset / time.Second)
+}
+
+// SetNextBlockTimestamp sets the timestamp in seconds of the next block.
+func (api *API) SetNextBlockTimestamp(timestamp Quantity) error {
+	api.logger.Debug("evm_setNextBlockTimestamp", "timestamp", timestamp)
+	return api.engine.SetNextBlockTimestamp(time.Unix(int64(timestamp), 0))
+}
+
+// Snapshot records the state of the latest block and returns the id to revert
+// to it.
+func (api *API) Snapshot() hexutil.Uint64 {
+	api.logger.Debug("evm_snapshot")
+	return hexutil.Uint64(api.engine.Snapshot())
+}
+
+// Revert reverts the chain to the snapshot, which can't be reverted to again.
+// It returns false if the snapshot doesn't exist.
+func (api *API) Revert(id Quantity) (bool, error) {
+	api.logger.Debug("evm_revert", "id", id)
+	return api.engine.Revert(uint64(id))
+}
@@ -0,0 +1,127 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package hetu
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/log"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/hetu-project/hetu/v1/rpc/backend"
+	"github.com/hetu-project/hetu/v1/x/evm/fork"
+)
+
+const (
+	// blockTimeout is the maximum time hetu_mine waits for each block.
+	blockTimeout = time.Minute
+	// pollInterval is the interval at which hetu_mine polls the latest block.
+	pollInterval = 100 * time.Millisecond
+)
+
+// API is the hetu_ prefixed set of development APIs of a chain forked from a
+// remote network.
+type API struct {
+	logger     log.Logger
+	backend    backend.EVMBackend
+	controller *fork.Controller
+}
+
+// NewAPI creates an instance of the hetu API.
+func NewAPI(logger log.Logger, backend backend.EVMBackend, controller *fork.Controller) *API {
+	return &API{
+		logger:     logger.With("api", "hetu"),
+		backend:    backend,
+		controller: controller,
+	}
+}
+
+// ImpersonateAccount accepts the transactions sent by the address through
+// eth_sendTransaction without the account's private key.
+func (api *API) ImpersonateAccount(address common.Address) {
+	api.logger.Debug("hetu_impersonateAccount", "address", address)
+	api.controller.ImpersonateAccount(address)
+}
+
+// StopImpersonatingAccount stops impersonating the address.
+func (api *API) StopImpersonatingAccount(address common.Address) {
+	api.logger.Debug("hetu_stopImpersonatingAccount", "address", address)
+	api.controller.StopImpersonatingAccount(address)
+}
+
+// SetBalance sets the balance of the account, effective from the next block.
+func (api *API) SetBalance(address common.Address, balance hexutil.Big) error {
+	api.logger.Debug("hetu_setBalance", "address", address, "balance", balance)
+	if balance.ToInt().Sign() < 0 {
+		return fmt.Errorf("negative balance %s", balance.String())
+	}
+	api.controller.SetBalance(address, balance.ToInt())
+	return nil
+}
+
+// SetCode sets the code of the account, effective from the next block.
+func (api *API) SetCode(address common.Address, code hexutil.Bytes) {
+	api.logger.Debug("hetu_setCode", "address", address, "size", len(code))
+	api.controller.SetCode(address, code)
+}
+
+// Mine waits for the given number of blocks, one by default, to be committed
+// and returns the latest block number. The blocks are sealed right away if the
+// chain runs in dev mode. The pending overrides are applied in the first of
+// them.
+func (api *API) Mine(blocks *hexutil.Uint64) (hexutil.Uint64, error) {
+	api.logger.Debug("hetu_mine", "blocks", blocks)
+
+	n := uint64(1)
+	if blocks != nil {
+		n = uint64(*blocks)
+	}
+
+	if mine := api.controller.Miner(); mine != nil && n > 0 {
+		var height int64
+		for i := uint64(0); i < n; i++ {
+			var err error
+			if height, err = mine(); err != nil {
+				return 0, err
+			}
+		}
+		return hexutil.Uint64(height), nil
+	}
+
+	start, err := api.backend.BlockNumber()
+	if err != nil {
+		return 0, err
+	}
+	target := uint64(start) + n
+
+	deadline := time.Now().Add(time.Duration(n) * blockTimeout)
+	for {
+		latest, err := api.backend.BlockNumber()
+		if err != nil {
+			return 0, err
+		}
+		if uint64(latest) >= target {
+			return latest, nil
+		}
+		if time.Now().After(deadline) {
+			return latest, fmt.Errorf("timed out waiting for block %d, latest is %d", target, latest)
+		}
+		time.Sleep(pollInterval)
+	}
+}
@@ -17,7 +17,10 @@ package personal
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"os"
 	"time"
 
@@ -36,9 +39,14 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 
+	walletaccounts "github.com/hetu-project/hetu/v1/wallets/accounts"
 	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
 )
 
+// defaultUnlockDuration is the unlock duration of personal_unlockAccount if
+// none is given.
+const defaultUnlockDuration = 300 * time.Second
+
 // PrivateAccountAPI is the personal_ prefixed set of APIs in the Web3 JSON-RPC spec.
 type PrivateAccountAPI struct {
 	backend    backend.EVMBackend
@@ -76,6 +84,21 @@ func (api *PrivateAccountAPI) ImportRawKey(privkey, password string) (common.Add
 	return api.backend.ImportRawKey(privkey, password)
 }
 
+// ImportKeystore decrypts a keystore file (Web3 Secret Storage v3, as written by geth
+// and MetaMask) with the given password and stores its key into the key directory,
+// in the same way as ImportRawKey. The keystore is either a JSON object or a string.
+func (api *PrivateAccountAPI) ImportKeystore(keystore json.RawMessage, password string) (common.Address, error) {
+	api.logger.Debug("personal_importKeystore")
+
+	// unquote the keystore passed as a JSON string
+	var keyJSON string
+	if err := json.Unmarshal(keystore, &keyJSON); err == nil {
+		keystore = json.RawMessage(keyJSON)
+	}
+
+	return api.backend.ImportKeystore(keystore, password)
+}
+
 // ListAccounts will return a list of addresses for accounts this node manages.
 func (api *PrivateAccountAPI) ListAccounts() ([]common.Address, error) {
 	api.logger.Debug("personal_listAccounts")
@@ -83,12 +106,10 @@ func (api *PrivateAccountAPI) ListAccounts() ([]common.Address, error) {
 }
 
 // LockAccount will lock the account associated with the given address when it's unlocked.
-// It removes the key corresponding to the given address from the API's local keys.
+// It returns false if the account was not unlocked.
 func (api *PrivateAccountAPI) LockAccount(address common.Address) bool {
 	api.logger.Debug("personal_lockAccount", "address", address.String())
-	api.logger.Info("personal_lockAccount not supported")
-	// TODO: Not supported. See underlying issue  https://github.com/99designs/keyring/issues/85
-	return false
+	return api.backend.LockAccount(address)
 }
 
 // NewAccount will create a new account and returns the address for the new account.
@@ -116,13 +137,28 @@ func (api *PrivateAccountAPI) NewAccount(password string) (common.Address, error
 	return addr, nil
 }
 
-// UnlockAccount will unlock the account associated with the given address with
-// the given password for duration seconds. If duration is nil it will use a
-// default of 300 seconds. It returns an indication if the account was unlocked.
-func (api *PrivateAccountAPI) UnlockAccount(_ context.Context, addr common.Address, _ string, _ *uint64) (bool, error) {
+// UnlockAccount will unlock the account associated with the given address
+// for duration seconds, allowing the node's signer to sign with it. If duration
+// is nil it will use a default of 300 seconds, and a duration of 0 unlocks the
+// account until it is locked. It returns an indication if the account was
+// unlocked.
+//
+// NOTE: The keys of the keyring signer share the keyring passphrase, which is
+// the password of all its accounts. The password is not used by the external
+// signer, which approves the signing requests itself.
+func (api *PrivateAccountAPI) UnlockAccount(_ context.Context, addr common.Address, password string, duration *uint64) (bool, error) {
 	api.logger.Debug("personal_unlockAccount", "address", addr.String())
-	// TODO: Not supported. See underlying issue  https://github.com/99designs/keyring/issues/85
-	return false, nil
+
+	const maxDuration = uint64(math.MaxInt64 / int64(time.Second))
+	d := defaultUnlockDuration
+	if duration != nil {
+		if *duration > maxDuration {
+			return false, errors.New("unlock duration too large")
+		}
+		d = time.Duration(*duration) * time.Second
+	}
+
+	return api.backend.UnlockAccount(addr, password, d)
 }
 
 // SendTransaction will create a transaction from the given arguments and
@@ -206,6 +242,88 @@ type RawWallet struct {
 // ListWallets will return a list of wallets this node manages.
 func (api *PrivateAccountAPI) ListWallets() []RawWallet {
 	api.logger.Debug("personal_ListWallets")
-	api.logger.Info("currently wallet level that manages accounts is not supported")
-	return ([]RawWallet)(nil)
+
+	wallets := make([]RawWallet, 0) // return [] instead of nil if empty
+	for _, wallet := range api.backend.Wallets() {
+		status, failure := wallet.Status()
+
+		raw := RawWallet{
+			URL:    wallet.URL().String(),
+			Status: status,
+		}
+		if failure != nil {
+			raw.Failure = failure.Error()
+		}
+		for _, account := range wallet.Accounts() {
+			raw.Accounts = append(raw.Accounts, accounts.Account{
+				Address: account.Address,
+				URL:     wallet.URL(),
+			})
+		}
+		wallets = append(wallets, raw)
+	}
+	return wallets
+}
+
+// OpenWallet initiates a hardware wallet opening procedure, establishing a USB
+// connection and attempting to authenticate via the provided passphrase. Note,
+// the method may return an extra challenge requiring a second open (e.g. the
+// Trezor PIN matrix challenge).
+func (api *PrivateAccountAPI) OpenWallet(url string, passphrase *string) error {
+	api.logger.Debug("personal_openWallet", "url", url)
+
+	wallet, err := api.findWallet(url)
+	if err != nil {
+		return err
+	}
+
+	pass := ""
+	if passphrase != nil {
+		pass = *passphrase
+	}
+	return wallet.Open(pass)
+}
+
+// DeriveAccount requests an HD wallet to derive a new account, optionally pinning
+// it for later reuse.
+func (api *PrivateAccountAPI) DeriveAccount(url string, path string, pin *bool) (accounts.Account, error) {
+	api.logger.Debug("personal_deriveAccount", "url", url, "path", path)
+
+	wallet, err := api.findWallet(url)
+	if err != nil {
+		return accounts.Account{}, err
+	}
+
+	derivPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return accounts.Account{}, err
+	}
+
+	if pin == nil {
+		pin = new(bool)
+	}
+
+	account, err := wallet.Derive(derivPath, *pin)
+	if err != nil {
+		return accounts.Account{}, err
+	}
+	return accounts.Account{Address: account.Address, URL: wallet.URL()}, nil
+}
+
+// SignCosmosTx signs the EIP-712 representation of the Amino JSON or Protobuf
+// encoded sign doc of a Cosmos tx, with the key or hardware wallet of the
+// address. The signature V value will be 27 or 28.
+func (api *PrivateAccountAPI) SignCosmosTx(_ context.Context, addr common.Address, signDoc hexutil.Bytes) (hexutil.Bytes, error) {
+	api.logger.Debug("personal_signCosmosTx", "address", addr.String())
+	return api.backend.SignCosmosTx(addr, signDoc)
+}
+
+// findWallet returns the hardware wallet of the node with the given URL.
+func (api *PrivateAccountAPI) findWallet(url string) (walletaccounts.Wallet, error) {
+	for _, wallet := range api.backend.Wallets() {
+		if wallet.URL().String() == url {
+			return wallet, nil
+		}
+	}
+	return nil, accounts.ErrUnknownWallet
 }
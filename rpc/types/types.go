@@ -21,6 +21,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -65,6 +67,43 @@ type RPCTransaction struct {
 	V                *hexutil.Big         `json:"v"`
 	R                *hexutil.Big         `json:"r"`
 	S                *hexutil.Big         `json:"s"`
+
+	AuthorizationList []SetCodeAuthorization `json:"authorizationList,omitempty"`
+}
+
+// SetCodeAuthorization represents an authorization of a set code transaction
+// that will serialize to the RPC representation.
+type SetCodeAuthorization struct {
+	ChainID hexutil.Big    `json:"chainId"`
+	Address common.Address `json:"address"`
+	Nonce   hexutil.Uint64 `json:"nonce"`
+	YParity hexutil.Uint64 `json:"yParity"`
+	R       hexutil.Big    `json:"r"`
+	S       hexutil.Big    `json:"s"`
+}
+
+// NewSetCodeAuthorization returns the RPC representation of the given authorization.
+func NewSetCodeAuthorization(auth evmtypes.SetCodeAuthorization) SetCodeAuthorization {
+	rpcAuth := SetCodeAuthorization{
+		Address: auth.GetAddress(),
+		Nonce:   hexutil.Uint64(auth.Nonce),
+		YParity: hexutil.Uint64(new(big.Int).SetBytes(auth.V).Uint64()),
+		R:       hexutil.Big(*new(big.Int).SetBytes(auth.R)),
+		S:       hexutil.Big(*new(big.Int).SetBytes(auth.S)),
+	}
+	if !auth.ChainID.IsNil() {
+		rpcAuth.ChainID = hexutil.Big(*auth.ChainID.BigInt())
+	}
+	return rpcAuth
+}
+
+// ToAuthorization returns the authorization of the set code transaction data.
+func (a SetCodeAuthorization) ToAuthorization() evmtypes.SetCodeAuthorization {
+	auth := evmtypes.NewSetCodeAuthorization(a.ChainID.ToInt(), a.Address, uint64(a.Nonce))
+	auth.V = new(big.Int).SetUint64(uint64(a.YParity)).Bytes()
+	auth.R = a.R.ToInt().Bytes()
+	auth.S = a.S.ToInt().Bytes()
+	return auth
 }
 
 // StateOverride is the collection of overridden accounts.
@@ -56,7 +56,7 @@ func RawTxToEthTx(clientCtx client.Context, txBz tmtypes.Tx) ([]*evmtypes.MsgEth
 		if !ok {
 			return nil, fmt.Errorf("invalid message type %T, expected %T", msg, &evmtypes.MsgEthereumTx{})
 		}
-		ethTx.Hash = ethTx.AsTransaction().Hash().Hex()
+		ethTx.Hash = ethTx.TxHash().Hex()
 		ethTxs[i] = ethTx
 	}
 	return ethTxs, nil
@@ -169,7 +169,30 @@ func NewTransactionFromMsg(
 	chainID *big.Int,
 ) (*RPCTransaction, error) {
 	tx := msg.AsTransaction()
-	return NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	result, err := NewRPCTransaction(tx, blockHash, blockNumber, index, baseFee, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+	setCodeTx, ok := txData.(*evmtypes.SetCodeTx)
+	if !ok {
+		return result, nil
+	}
+
+	// the ethereum transaction only carries the fee fields of set code transactions
+	from, _ := setCodeTx.Sender() // #nosec G703
+	result.Type = hexutil.Uint64(evmtypes.SetCodeTxType)
+	result.From = from
+	result.Hash = setCodeTx.Hash()
+	result.AuthorizationList = make([]SetCodeAuthorization, len(setCodeTx.Authorizations))
+	for i, auth := range setCodeTx.Authorizations {
+		result.AuthorizationList[i] = NewSetCodeAuthorization(auth)
+	}
+	return result, nil
 }
 
 // NewTransactionFromData returns a transaction that will serialize to the RPC
@@ -192,7 +215,7 @@ func NewRPCTransaction(
 	} else {
 		signer = ethtypes.HomesteadSigner{}
 	}
-	from, _ := ethtypes.Sender(signer, tx) // #nosec G703
+	from, _ := evmtypes.Sender(signer, tx) // #nosec G703
 	v, r, s := tx.RawSignatureValues()
 	result := &RPCTransaction{
 		Type:     hexutil.Uint64(tx.Type()),
@@ -284,8 +307,14 @@ func TxExceedBlockGasLimit(res *abci.ExecTxResult) bool {
 	return strings.Contains(res.Log, ExceedBlockGasLimitError)
 }
 
+// TxBatchReverted returns true if the tx is an atomic batch reverted by one of its messages.
+// Like the txs exceeding the block gas limit, the fees and nonces are consumed in ante handler.
+func TxBatchReverted(res *abci.ExecTxResult) bool {
+	return res.Codespace == evmtypes.ErrBatchReverted.Codespace() && res.Code == evmtypes.ErrBatchReverted.ABCICode()
+}
+
 // TxSuccessOrExceedsBlockGasLimit returnsrue if the transaction was successful
-// or if it failed with an ExceedBlockGasLimit error
+// or if it failed with an ExceedBlockGasLimit or a batch reverted error
 func TxSuccessOrExceedsBlockGasLimit(res *abci.ExecTxResult) bool {
-	return res.Code == 0 || TxExceedBlockGasLimit(res)
+	return res.Code == 0 || TxExceedBlockGasLimit(res) || TxBatchReverted(res)
 }
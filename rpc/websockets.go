@@ -26,6 +26,7 @@ import (
 	"net/http"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gorilla/mux"
@@ -42,7 +43,9 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	tmtypes "github.com/cometbft/cometbft/types"
 
+	"github.com/hetu-project/hetu/v1/rpc/access"
 	"github.com/hetu-project/hetu/v1/rpc/ethereum/pubsub"
+	"github.com/hetu-project/hetu/v1/rpc/health"
 	rpcfilters "github.com/hetu-project/hetu/v1/rpc/namespaces/ethereum/eth/filters"
 	"github.com/hetu-project/hetu/v1/rpc/types"
 	"github.com/hetu-project/hetu/v1/server/config"
@@ -51,6 +54,9 @@ import (
 
 type WebsocketsServer interface {
 	Start()
+	// Shutdown stops accepting connections and closes the open ones, cancelling their
+	// subscriptions.
+	Shutdown(ctx context.Context) error
 }
 
 type SubscriptionResponseJSON struct {
@@ -87,10 +93,23 @@ type websocketsServer struct {
 	certFile string
 	keyFile  string
 	api      *pubSubAPI
+	access   *access.Controller
+	drain    *health.Drain
 	logger   log.Logger
+
+	srv   *http.Server
+	mtx   sync.Mutex
+	conns map[*wsConn]struct{}
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	cfg *config.Config,
+	accessCtrl *access.Controller,
+	drain *health.Drain,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address) // #nosec G703
 
@@ -100,7 +119,10 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient
 		certFile: cfg.TLS.CertificatePath,
 		keyFile:  cfg.TLS.KeyPath,
 		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
+		access:   accessCtrl,
+		drain:    drain,
 		logger:   logger,
+		conns:    make(map[*wsConn]struct{}),
 	}
 }
 
@@ -108,13 +130,15 @@ func (s *websocketsServer) Start() {
 	ws := mux.NewRouter()
 	ws.Handle("/", s)
 
+	/* #nosec G112 -- the websocket connections are long lived */
+	s.srv = &http.Server{Addr: s.wsAddr, Handler: ws}
+
 	go func() {
 		var err error
-		/* #nosec G114 -- http functions have no support for timeouts */
 		if s.certFile == "" || s.keyFile == "" {
-			err = http.ListenAndServe(s.wsAddr, ws)
+			err = s.srv.ListenAndServe()
 		} else {
-			err = http.ListenAndServeTLS(s.wsAddr, s.certFile, s.keyFile, ws)
+			err = s.srv.ListenAndServeTLS(s.certFile, s.keyFile)
 		}
 
 		if err != nil {
@@ -127,7 +151,35 @@ func (s *websocketsServer) Start() {
 	}()
 }
 
+// Shutdown implements WebsocketsServer.
+func (s *websocketsServer) Shutdown(ctx context.Context) error {
+	var err error
+	if s.srv != nil {
+		// the hijacked websocket connections are not tracked by the HTTP server
+		err = s.srv.Shutdown(ctx)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	for conn := range s.conns {
+		_ = conn.closeGoingAway() // #nosec G703
+	}
+	return err
+}
+
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.drain.Draining() {
+		http.Error(w, "server is shutting down", http.StatusServiceUnavailable)
+		return
+	}
+
+	// the API key is checked once for the connection
+	client, err := s.access.Identify(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
 	upgrader := websocket.Upgrader{
 		CheckOrigin: func(r *http.Request) bool {
 			return true
@@ -140,10 +192,22 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
-	})
+	wsConn := &wsConn{
+		mux:    new(sync.Mutex),
+		conn:   conn,
+		client: client,
+	}
+
+	s.mtx.Lock()
+	s.conns[wsConn] = struct{}{}
+	s.mtx.Unlock()
+	defer func() {
+		s.mtx.Lock()
+		delete(s.conns, wsConn)
+		s.mtx.Unlock()
+	}()
+
+	s.readLoop(wsConn)
 }
 
 func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
@@ -160,8 +224,9 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 }
 
 type wsConn struct {
-	conn *websocket.Conn
-	mux  *sync.Mutex
+	conn   *websocket.Conn
+	mux    *sync.Mutex
+	client *access.Client
 }
 
 func (w *wsConn) WriteJSON(v interface{}) error {
@@ -178,6 +243,17 @@ func (w *wsConn) Close() error {
 	return w.conn.Close()
 }
 
+// closeGoingAway notifies the client that the server is shutting down and closes the
+// connection.
+func (w *wsConn) closeGoingAway() error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	msg := websocket.FormatCloseMessage(websocket.CloseGoingAway, "server is shutting down")
+	_ = w.conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(time.Second)) // #nosec G703
+	return w.conn.Close()
+}
+
 func (w *wsConn) ReadMessage() (messageType int, p []byte, err error) {
 	// not protected by write mutex
 
@@ -203,6 +279,10 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			return
 		}
 
+		if !s.authorize(wsConn, mb) {
+			continue
+		}
+
 		if isBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
@@ -305,7 +385,21 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 	}
 }
 
-// tcpGetAndSendResponse sends error response to client if params is invalid
+// authorize checks the methods of the message against the access control, sending the
+// error responses if rejected
+func (s *websocketsServer) authorize(wsConn *wsConn, mb []byte) bool {
+	if !s.access.Enabled() {
+		return true
+	}
+
+	if reqs, batch, err := s.access.AuthorizeMessage(wsConn.client, mb); err != nil {
+		_ = wsConn.WriteJSON(access.NewErrorResponse(reqs, batch, err)) // #nosec G703
+		return false
+	}
+	return true
+}
+
+// getParamsAndCheckValid sends error response to client if params is invalid
 func (s *websocketsServer) getParamsAndCheckValid(msg map[string]interface{}, wsConn *wsConn) ([]interface{}, bool) {
 	params, ok := msg["params"].([]interface{})
 	if !ok {
@@ -330,6 +424,7 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	s.access.SetInternal(req)
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
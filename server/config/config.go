@@ -18,7 +18,9 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"path"
+	gostrings "strings"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -69,6 +71,12 @@ const (
 
 	DefaultBlockRangeCap int32 = 10000
 
+	// DefaultBlockCacheSize is the default number of blocks cached by the JSON-RPC backend
+	DefaultBlockCacheSize = 128
+
+	// DefaultTxCacheSize is the default number of transactions cached by the JSON-RPC backend
+	DefaultTxCacheSize = 4096
+
 	DefaultEVMTimeout = 5 * time.Second
 
 	// default 1.0 eth
@@ -78,6 +86,12 @@ const (
 
 	DefaultHTTPIdleTimeout = 120 * time.Second
 
+	// DefaultShutdownTimeout is the time given to the in-flight requests to finish on shutdown
+	DefaultShutdownTimeout = 10 * time.Second
+
+	// DefaultMaxIndexerLag is the number of blocks the EVM indexer can lag behind for the node to be ready
+	DefaultMaxIndexerLag int64 = 5
+
 	// DefaultAllowUnprotectedTxs value is false
 	DefaultAllowUnprotectedTxs = false
 
@@ -87,6 +101,12 @@ const (
 	// DefaultReturnDataLimit is maximum number of bytes returned from eth_call or similar invocations
 	DefaultReturnDataLimit = 100000
 
+	// DefaultRateLimit is the default number of JSON-RPC tokens per second of each client
+	DefaultRateLimit = 50
+
+	// DefaultRateBurst is the default capacity of the JSON-RPC token bucket of each client
+	DefaultRateBurst = 200
+
 	// DefaultRosettaEnable is the default value for the parameter that defines if the Rosetta API server is enabled
 	DefaultRosettaEnable = false
 
@@ -121,10 +141,11 @@ var (
 type Config struct {
 	config.Config
 
-	EVM     EVMConfig     `mapstructure:"evm"`
-	JSONRPC JSONRPCConfig `mapstructure:"json-rpc"`
-	TLS     TLSConfig     `mapstructure:"tls"`
-	Rosetta RosettaConfig `mapstructure:"rosetta"`
+	EVM       EVMConfig       `mapstructure:"evm"`
+	JSONRPC   JSONRPCConfig   `mapstructure:"json-rpc"`
+	TLS       TLSConfig       `mapstructure:"tls"`
+	Rosetta   RosettaConfig   `mapstructure:"rosetta"`
+	VersionDB VersionDBConfig `mapstructure:"versiondb"`
 }
 
 // EVMConfig defines the application configuration values for the EVM.
@@ -166,10 +187,22 @@ type JSONRPCConfig struct {
 	LogsCap int32 `mapstructure:"logs-cap"`
 	// BlockRangeCap defines the max block range allowed for `eth_getLogs` query.
 	BlockRangeCap int32 `mapstructure:"block-range-cap"`
+	// BlockCacheSize defines the number of blocks, block results and Ethereum blocks cached
+	// by the backend shared by the namespaces. 0 disables the caching.
+	BlockCacheSize int `mapstructure:"block-cache-size"`
+	// TxCacheSize defines the number of transaction lookups and receipts cached by the backend.
+	// 0 disables the caching.
+	TxCacheSize int `mapstructure:"tx-cache-size"`
 	// HTTPTimeout is the read/write timeout of http json-rpc server.
 	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
 	// HTTPIdleTimeout is the idle timeout of http json-rpc server.
 	HTTPIdleTimeout time.Duration `mapstructure:"http-idle-timeout"`
+	// ShutdownTimeout is the time given to the in-flight requests to finish on shutdown, the
+	// new requests are refused meanwhile.
+	ShutdownTimeout time.Duration `mapstructure:"shutdown-timeout"`
+	// MaxIndexerLag is the number of blocks the EVM indexer can lag behind the chain for the
+	// node to be reported ready.
+	MaxIndexerLag int64 `mapstructure:"max-indexer-lag"`
 	// AllowUnprotectedTxs restricts unprotected (non EIP155 signed) transactions to be submitted via
 	// the node's RPC when global parameter is disabled.
 	AllowUnprotectedTxs bool `mapstructure:"allow-unprotected-txs"`
@@ -180,12 +213,73 @@ type JSONRPCConfig struct {
 	EnableIndexer bool `mapstructure:"enable-indexer"`
 	// AllowIndexerGap defines if allow block gap for the custom indexer service.
 	AllowIndexerGap bool `mapstructure:"allow-indexer-gap"`
+	// EnableLedger defines if the JSON-RPC server signs with the Ledger
+	// wallets connected to the node, for accounts not in the keyring.
+	EnableLedger bool `mapstructure:"enable-ledger"`
+	// Signer defines the signer of the signing methods: disabled if empty, the
+	// node's keyring for "keyring", or the endpoint of an external signer.
+	Signer string `mapstructure:"signer"`
+	// BundlerKey defines the name of the keyring key signing the ERC-4337 bundles
+	// of the bundler namespace.
+	BundlerKey string `mapstructure:"bundler-key"`
+	// ArchiveUpstream defines the JSON-RPC endpoint of an archive node serving the
+	// state queries at the heights pruned by this node, disabled if empty.
+	ArchiveUpstream string `mapstructure:"archive-upstream"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
 	// ReturnDataLimit defines maximum number of bytes returned from `eth_call` or similar invocations
 	ReturnDataLimit int64 `mapstructure:"return-data-limit"`
+	// Access defines the access control of the JSON-RPC methods
+	Access JSONRPCAccessConfig `mapstructure:"access"`
+}
+
+// JSONRPCAccessConfig defines the access control of the HTTP and WebSocket JSON-RPC servers.
+// The method patterns are either a method name, a namespace wildcard (e.g "debug_*") or "*".
+type JSONRPCAccessConfig struct {
+	// Enable defines if the access control is enabled.
+	Enable bool `mapstructure:"enable"`
+	// AllowedMethods defines the methods callable without API key, all if empty.
+	AllowedMethods []string `mapstructure:"allowed-methods"`
+	// DeniedMethods defines the methods not callable without API key.
+	DeniedMethods []string `mapstructure:"denied-methods"`
+	// RequireAPIKey rejects the requests without a valid API key.
+	RequireAPIKey bool `mapstructure:"require-api-key"`
+	// APIKeys defines the API keys accepted as bearer token or X-API-Key header.
+	APIKeys []JSONRPCAPIKey `mapstructure:"api-keys"`
+	// RateLimit defines the number of tokens per second refilled in the bucket of each
+	// client IP or API key, 0 disables the rate limiting.
+	RateLimit float64 `mapstructure:"rate-limit"`
+	// RateBurst defines the capacity of the token buckets.
+	RateBurst int `mapstructure:"rate-burst"`
+	// MethodCosts defines the tokens consumed by the expensive methods, the other
+	// methods cost a single token.
+	MethodCosts []JSONRPCMethodCost `mapstructure:"method-costs"`
+	// TrustForwardedFor identifies the clients by the X-Forwarded-For header set by a
+	// reverse proxy instead of the address of the connection.
+	TrustForwardedFor bool `mapstructure:"trust-forwarded-for"`
+}
+
+// JSONRPCAPIKey defines an API key and the methods it's allowed to call.
+type JSONRPCAPIKey struct {
+	// Key is the secret sent by the client.
+	Key string `mapstructure:"key"`
+	// Methods defines the method patterns callable with the key, the allow and deny
+	// lists apply if empty.
+	Methods []string `mapstructure:"methods"`
+	// RateLimit overrides the rate limit for the key if not zero.
+	RateLimit float64 `mapstructure:"rate-limit"`
+	// RateBurst overrides the rate burst for the key if not zero.
+	RateBurst int `mapstructure:"rate-burst"`
+}
+
+// JSONRPCMethodCost defines the rate limiting cost of a method.
+type JSONRPCMethodCost struct {
+	// Method is the method pattern.
+	Method string `mapstructure:"method"`
+	// Cost is the number of tokens consumed by each call.
+	Cost float64 `mapstructure:"cost"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -196,6 +290,14 @@ type TLSConfig struct {
 	KeyPath string `mapstructure:"key-path"`
 }
 
+// VersionDBConfig defines the configuration of the versioned state store serving the
+// historical queries.
+type VersionDBConfig struct {
+	// Enable defines if the application state is written to the versioned store on commit
+	// and the historical queries are served from it.
+	Enable bool `mapstructure:"enable"`
+}
+
 // RosettaConfig defines configuration for the Rosetta server.
 type RosettaConfig struct {
 	rosetta.Config
@@ -227,10 +329,11 @@ func AppConfig(denom string) (string, interface{}) {
 	}
 
 	customAppConfig := Config{
-		Config:  *srvCfg,
-		EVM:     *DefaultEVMConfig(),
-		JSONRPC: *DefaultJSONRPCConfig(),
-		TLS:     *DefaultTLSConfig(),
+		Config:    *srvCfg,
+		EVM:       *DefaultEVMConfig(),
+		JSONRPC:   *DefaultJSONRPCConfig(),
+		TLS:       *DefaultTLSConfig(),
+		VersionDB: *DefaultVersionDBConfig(),
 	}
 
 	customAppTemplate := config.DefaultConfigTemplate + DefaultConfigTemplate
@@ -241,11 +344,12 @@ func AppConfig(denom string) (string, interface{}) {
 // DefaultConfig returns server's default configuration.
 func DefaultConfig() *Config {
 	return &Config{
-		Config:  *config.DefaultConfig(),
-		EVM:     *DefaultEVMConfig(),
-		JSONRPC: *DefaultJSONRPCConfig(),
-		TLS:     *DefaultTLSConfig(),
-		Rosetta: *DefaultRosettaConfig(),
+		Config:    *config.DefaultConfig(),
+		EVM:       *DefaultEVMConfig(),
+		JSONRPC:   *DefaultJSONRPCConfig(),
+		TLS:       *DefaultTLSConfig(),
+		Rosetta:   *DefaultRosettaConfig(),
+		VersionDB: *DefaultVersionDBConfig(),
 	}
 }
 
@@ -278,7 +382,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "bundler", "admin"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -295,18 +399,115 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		FeeHistoryCap:            DefaultFeeHistoryCap,
 		BlockRangeCap:            DefaultBlockRangeCap,
 		LogsCap:                  DefaultLogsCap,
+		BlockCacheSize:           DefaultBlockCacheSize,
+		TxCacheSize:              DefaultTxCacheSize,
 		HTTPTimeout:              DefaultHTTPTimeout,
 		HTTPIdleTimeout:          DefaultHTTPIdleTimeout,
+		ShutdownTimeout:          DefaultShutdownTimeout,
+		MaxIndexerLag:            DefaultMaxIndexerLag,
 		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            false,
 		AllowIndexerGap:          true,
+		EnableLedger:             false,
+		Signer:                   "",
+		BundlerKey:               "",
+		ArchiveUpstream:          "",
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 		ReturnDataLimit:          DefaultReturnDataLimit,
+		Access:                   *DefaultJSONRPCAccessConfig(),
 	}
 }
 
+// DefaultJSONRPCAccessConfig returns the default JSON-RPC access control configuration,
+// which is disabled.
+func DefaultJSONRPCAccessConfig() *JSONRPCAccessConfig {
+	return &JSONRPCAccessConfig{
+		Enable:         false,
+		AllowedMethods: []string{},
+		DeniedMethods:  []string{"debug_*", "personal_*", "miner_*"},
+		RequireAPIKey:  false,
+		APIKeys:        []JSONRPCAPIKey{},
+		RateLimit:      DefaultRateLimit,
+		RateBurst:      DefaultRateBurst,
+		MethodCosts: []JSONRPCMethodCost{
+			{Method: "eth_getLogs", Cost: 10},
+			{Method: "eth_call", Cost: 5},
+			{Method: "eth_estimateGas", Cost: 5},
+			{Method: "debug_*", Cost: 50},
+		},
+		TrustForwardedFor: false,
+	}
+}
+
+// Validate returns an error if the JSON-RPC access control configuration fields are invalid.
+func (c JSONRPCAccessConfig) Validate() error {
+	for _, pattern := range append(append([]string{}, c.AllowedMethods...), c.DeniedMethods...) {
+		if err := ValidateMethodPattern(pattern); err != nil {
+			return err
+		}
+	}
+
+	if c.RateLimit < 0 {
+		return errors.New("JSON-RPC rate limit cannot be negative")
+	}
+
+	if c.RateBurst < 0 || (c.RateLimit > 0 && c.RateBurst == 0) {
+		return errors.New("JSON-RPC rate burst must be positive when rate limiting is enabled")
+	}
+
+	seenKeys := make(map[string]bool)
+	for i, key := range c.APIKeys {
+		if key.Key == "" {
+			return fmt.Errorf("JSON-RPC API key %d cannot be empty", i)
+		}
+		if seenKeys[key.Key] {
+			return fmt.Errorf("repeated JSON-RPC API key %d", i)
+		}
+		seenKeys[key.Key] = true
+
+		for _, pattern := range key.Methods {
+			if err := ValidateMethodPattern(pattern); err != nil {
+				return err
+			}
+		}
+
+		if key.RateLimit < 0 || key.RateBurst < 0 {
+			return fmt.Errorf("JSON-RPC API key %d rate limit cannot be negative", i)
+		}
+	}
+
+	for _, cost := range c.MethodCosts {
+		if err := ValidateMethodPattern(cost.Method); err != nil {
+			return err
+		}
+		if cost.Cost <= 0 {
+			return fmt.Errorf("JSON-RPC cost of method %s must be positive", cost.Method)
+		}
+	}
+
+	return nil
+}
+
+// ValidateMethodPattern returns an error if the pattern isn't a method name, a
+// namespace wildcard (e.g "debug_*") or "*".
+func ValidateMethodPattern(pattern string) error {
+	if pattern == "*" {
+		return nil
+	}
+
+	namespace, method, ok := gostrings.Cut(pattern, "_")
+	if !ok || namespace == "" || method == "" {
+		return fmt.Errorf("invalid JSON-RPC method pattern '%s'", pattern)
+	}
+
+	if gostrings.Contains(method, "*") && method != "*" {
+		return fmt.Errorf("invalid JSON-RPC method pattern '%s', only namespace wildcards are supported", pattern)
+	}
+	return nil
+}
+
 // Validate returns an error if the JSON-RPC configuration fields are invalid.
 func (c JSONRPCConfig) Validate() error {
 	if c.Enable && len(c.API) == 0 {
@@ -337,6 +538,14 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC block range cap cannot be negative")
 	}
 
+	if c.BlockCacheSize < 0 {
+		return errors.New("JSON-RPC block cache size cannot be negative")
+	}
+
+	if c.TxCacheSize < 0 {
+		return errors.New("JSON-RPC transaction cache size cannot be negative")
+	}
+
 	if c.HTTPTimeout < 0 {
 		return errors.New("JSON-RPC HTTP timeout duration cannot be negative")
 	}
@@ -345,6 +554,20 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.ShutdownTimeout < 0 {
+		return errors.New("JSON-RPC shutdown timeout duration cannot be negative")
+	}
+
+	if c.MaxIndexerLag < 0 {
+		return errors.New("JSON-RPC max indexer lag cannot be negative")
+	}
+
+	if c.ArchiveUpstream != "" {
+		if _, err := url.ParseRequestURI(c.ArchiveUpstream); err != nil {
+			return fmt.Errorf("invalid JSON-RPC archive upstream: %w", err)
+		}
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -355,7 +578,7 @@ func (c JSONRPCConfig) Validate() error {
 		seenAPIs[api] = true
 	}
 
-	return nil
+	return c.Access.Validate()
 }
 
 // DefaultTLSConfig returns the default TLS configuration
@@ -366,6 +589,13 @@ func DefaultTLSConfig() *TLSConfig {
 	}
 }
 
+// DefaultVersionDBConfig returns the default versioned state store configuration
+func DefaultVersionDBConfig() *VersionDBConfig {
+	return &VersionDBConfig{
+		Enable: false,
+	}
+}
+
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultRosettaConfig() *RosettaConfig {
 	return &RosettaConfig{
@@ -410,6 +640,13 @@ func GetConfig(v *viper.Viper) (Config, error) {
 		return Config{}, err
 	}
 
+	access := *DefaultJSONRPCAccessConfig()
+	if v.IsSet("json-rpc.access") {
+		if err := v.UnmarshalKey("json-rpc.access", &access); err != nil {
+			return Config{}, errorsmod.Wrap(err, "failed to parse json-rpc access config")
+		}
+	}
+
 	return Config{
 		Config: cfg,
 		EVM: EVMConfig{
@@ -431,19 +668,31 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			EVMTimeout:               v.GetDuration("json-rpc.evm-timeout"),
 			LogsCap:                  v.GetInt32("json-rpc.logs-cap"),
 			BlockRangeCap:            v.GetInt32("json-rpc.block-range-cap"),
+			BlockCacheSize:           v.GetInt("json-rpc.block-cache-size"),
+			TxCacheSize:              v.GetInt("json-rpc.tx-cache-size"),
 			HTTPTimeout:              v.GetDuration("json-rpc.http-timeout"),
 			HTTPIdleTimeout:          v.GetDuration("json-rpc.http-idle-timeout"),
+			ShutdownTimeout:          v.GetDuration("json-rpc.shutdown-timeout"),
+			MaxIndexerLag:            v.GetInt64("json-rpc.max-indexer-lag"),
 			MaxOpenConnections:       v.GetInt("json-rpc.max-open-connections"),
 			EnableIndexer:            v.GetBool("json-rpc.enable-indexer"),
 			AllowIndexerGap:          v.GetBool("json-rpc.allow-indexer-gap"),
+			EnableLedger:             v.GetBool("json-rpc.enable-ledger"),
+			Signer:                   v.GetString("json-rpc.signer"),
+			BundlerKey:               v.GetString("json-rpc.bundler-key"),
+			ArchiveUpstream:          v.GetString("json-rpc.archive-upstream"),
 			MetricsAddress:           v.GetString("json-rpc.metrics-address"),
 			FixRevertGasRefundHeight: v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
 			ReturnDataLimit:          v.GetInt64("json-rpc.return-data-limit"),
+			Access:                   access,
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
 			KeyPath:         v.GetString("tls.key-path"),
 		},
+		VersionDB: VersionDBConfig{
+			Enable: v.GetBool("versiondb.enable"),
+		},
 	}, nil
 }
 
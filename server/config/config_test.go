@@ -1,8 +1,11 @@
 package config
 
 import (
+	"bytes"
 	"testing"
+	"text/template"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 )
 
@@ -12,3 +15,88 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestJSONRPCAccessConfigTemplate(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.JSONRPC.Access.Enable = true
+	cfg.JSONRPC.Access.AllowedMethods = []string{"eth_*", "net_version"}
+	cfg.JSONRPC.Access.APIKeys = []JSONRPCAPIKey{
+		{Key: "secret", Methods: []string{"debug_*"}, RateLimit: 2.5, RateBurst: 10},
+		{Key: "other", Methods: []string{}},
+	}
+
+	tmpl, err := template.New("appConfigFileTemplate").Parse(DefaultConfigTemplate)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, cfg))
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(&buf))
+
+	parsed, err := GetConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, cfg.JSONRPC.Access, parsed.JSONRPC.Access)
+}
+
+func TestJSONRPCAccessConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(cfg *JSONRPCAccessConfig)
+		expPass  bool
+	}{
+		{
+			"default",
+			func(_ *JSONRPCAccessConfig) {},
+			true,
+		},
+		{
+			"invalid method pattern",
+			func(cfg *JSONRPCAccessConfig) { cfg.DeniedMethods = []string{"debug"} },
+			false,
+		},
+		{
+			"unsupported wildcard",
+			func(cfg *JSONRPCAccessConfig) { cfg.AllowedMethods = []string{"eth_get*"} },
+			false,
+		},
+		{
+			"negative rate limit",
+			func(cfg *JSONRPCAccessConfig) { cfg.RateLimit = -1 },
+			false,
+		},
+		{
+			"zero burst",
+			func(cfg *JSONRPCAccessConfig) { cfg.RateBurst = 0 },
+			false,
+		},
+		{
+			"empty API key",
+			func(cfg *JSONRPCAccessConfig) { cfg.APIKeys = []JSONRPCAPIKey{{Key: ""}} },
+			false,
+		},
+		{
+			"repeated API key",
+			func(cfg *JSONRPCAccessConfig) { cfg.APIKeys = []JSONRPCAPIKey{{Key: "a"}, {Key: "a"}} },
+			false,
+		},
+		{
+			"zero method cost",
+			func(cfg *JSONRPCAccessConfig) { cfg.MethodCosts = []JSONRPCMethodCost{{Method: "eth_call"}} },
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCAccessConfig()
+			tc.malleate(cfg)
+			if tc.expPass {
+				require.NoError(t, cfg.Validate())
+			} else {
+				require.Error(t, cfg.Validate())
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ReloadableKeys are the app.toml keys applied to the running JSON-RPC server
+// when the file changes. The other keys need a restart.
+var ReloadableKeys = []string{
+	"json-rpc.gas-cap",
+	"json-rpc.evm-timeout",
+	"json-rpc.filter-cap",
+	"json-rpc.logs-cap",
+	"json-rpc.block-range-cap",
+}
+
+// Change is a value modified between two configurations.
+type Change struct {
+	// Key is the app.toml key of the value, e.g "json-rpc.gas-cap".
+	Key string
+	Old interface{}
+	New interface{}
+}
+
+// String implements fmt.Stringer.
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %v -> %v", c.Key, c.Old, c.New)
+}
+
+// IsReloadable returns true if the value can be changed without a restart.
+func (c Change) IsReloadable() bool {
+	for _, key := range ReloadableKeys {
+		if c.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyReloadable sets the reloadable values of the configuration from the
+// given one and leaves the others untouched.
+func (c *JSONRPCConfig) ApplyReloadable(from JSONRPCConfig) {
+	c.GasCap = from.GasCap
+	c.EVMTimeout = from.EVMTimeout
+	c.FilterCap = from.FilterCap
+	c.LogsCap = from.LogsCap
+	c.BlockRangeCap = from.BlockRangeCap
+}
+
+// Flatten returns the values of the configuration keyed by their app.toml key.
+// The slices and maps are not flattened.
+func Flatten(cfg Config) map[string]interface{} {
+	values := make(map[string]interface{})
+	flatten(reflect.ValueOf(cfg), "", values)
+	return values
+}
+
+func flatten(v reflect.Value, prefix string, values map[string]interface{}) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+		squash := opts == "squash" || (field.Anonymous && name == "")
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+
+		key := prefix
+		if !squash {
+			key = prefix + name
+		}
+
+		fv := v.Field(i)
+		if fv.Kind() == reflect.Struct {
+			if !squash {
+				key += "."
+			}
+			flatten(fv, key, values)
+			continue
+		}
+		values[key] = fv.Interface()
+	}
+}
+
+// Diff returns the values changed between two configurations, sorted by key.
+func Diff(from, to Config) []Change {
+	oldValues, newValues := Flatten(from), Flatten(to)
+
+	var changes []Change
+	for key, newValue := range newValues {
+		if oldValue := oldValues[key]; !reflect.DeepEqual(oldValue, newValue) {
+			changes = append(changes, Change{Key: key, Old: oldValue, New: newValue})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+	return changes
+}
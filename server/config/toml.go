@@ -37,6 +37,9 @@ max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
 [json-rpc]
 
+# The gas-cap, evm-timeout, filter-cap, logs-cap and block-range-cap values are applied to the running
+# JSON-RPC server when this file is saved. Changing the other values of the file requires a restart.
+
 # Enable defines if the gRPC server should be enabled.
 enable = {{ .JSONRPC.Enable }}
 
@@ -71,12 +74,28 @@ logs-cap = {{ .JSONRPC.LogsCap }}
 # BlockRangeCap defines the max block range allowed for 'eth_getLogs' query.
 block-range-cap = {{ .JSONRPC.BlockRangeCap }}
 
+# BlockCacheSize defines the number of recent blocks, block results and Ethereum blocks cached by the
+# backend shared by the namespaces. The caches are invalidated on new heads. 0 disables the caching.
+block-cache-size = {{ .JSONRPC.BlockCacheSize }}
+
+# TxCacheSize defines the number of transaction lookups and receipts cached by the backend. 0 disables
+# the caching.
+tx-cache-size = {{ .JSONRPC.TxCacheSize }}
+
 # HTTPTimeout is the read/write timeout of http json-rpc server.
 http-timeout = "{{ .JSONRPC.HTTPTimeout }}"
 
 # HTTPIdleTimeout is the idle timeout of http json-rpc server.
 http-idle-timeout = "{{ .JSONRPC.HTTPIdleTimeout }}"
 
+# ShutdownTimeout is the time given to the in-flight requests to finish on shutdown. The new requests
+# are refused and the /ready endpoint reports the node as not ready meanwhile.
+shutdown-timeout = "{{ .JSONRPC.ShutdownTimeout }}"
+
+# MaxIndexerLag is the number of blocks the EVM indexer can lag behind the chain for the /ready endpoint
+# to report the node as ready.
+max-indexer-lag = {{ .JSONRPC.MaxIndexerLag }}
+
 # AllowUnprotectedTxs restricts unprotected (non EIP155 signed) transactions to be submitted via
 # the node's RPC when the global parameter is disabled.
 allow-unprotected-txs = {{ .JSONRPC.AllowUnprotectedTxs }}
@@ -88,6 +107,28 @@ max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# EnableLedger enables signing with the Ledger wallets connected to the node for the
+# accounts that are not in the keyring (eth_sendTransaction, eth_signTypedData_v4 and
+# personal_signCosmosTx).
+enable-ledger = {{ .JSONRPC.EnableLedger }}
+
+# Signer defines the signer of the signing methods (eth_sendTransaction, eth_sign,
+# eth_signTypedData and personal_*), which are disabled if empty. Set it to "keyring" to
+# sign with the node's keyring, or to the HTTP endpoint or IPC path of an external signer
+# implementing the Clef account_* API. Accounts must be unlocked with personal_unlockAccount
+# before signing, with the keyring passphrase as password for the keyring signer.
+signer = "{{ .JSONRPC.Signer }}"
+
+# BundlerKey defines the name of the keyring key signing the handleOps transactions of the
+# ERC-4337 bundler (eth_sendUserOperation), enabled with the "bundler" API namespace. The key
+# is also the beneficiary of the bundles.
+bundler-key = "{{ .JSONRPC.BundlerKey }}"
+
+# ArchiveUpstream defines the JSON-RPC endpoint of an archive node (e.g. "http://archive:8545").
+# The state queries (eth_getBalance, eth_call, eth_getProof...) at the heights pruned by this node
+# are forwarded to it instead of failing with a "missing trie node" error. Disabled if empty.
+archive-upstream = "{{ .JSONRPC.ArchiveUpstream }}"
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
@@ -95,6 +136,45 @@ metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 # Upgrade height for fix of revert gas refund logic when transaction reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+[json-rpc.access]
+
+# Enable defines if the access control of the HTTP and WebSocket JSON-RPC servers is enabled.
+enable = {{ .JSONRPC.Access.Enable }}
+
+# Method patterns are either a method name (e.g "eth_call"), a namespace wildcard (e.g "debug_*")
+# or "*".
+#
+# AllowedMethods defines the methods callable without API key, all of them if empty.
+allowed-methods = [{{range $index, $elmt := .JSONRPC.Access.AllowedMethods}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# DeniedMethods defines the methods not callable without API key, they take precedence over the
+# allowed methods.
+denied-methods = [{{range $index, $elmt := .JSONRPC.Access.DeniedMethods}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# RequireAPIKey rejects the requests without a valid API key.
+require-api-key = {{ .JSONRPC.Access.RequireAPIKey }}
+
+# APIKeys defines the keys sent with the "Authorization: Bearer <key>" or "X-API-Key: <key>" headers.
+# The methods of a key replace the allowed and denied methods, and its rate limit and burst replace
+# the global ones if not zero.
+# Example: [{key = "secret", methods = ["eth_*", "debug_*"], rate-limit = 100, rate-burst = 500}]
+api-keys = [{{range $index, $elmt := .JSONRPC.Access.APIKeys}}{{if $index}}, {{end}}{key = "{{$elmt.Key}}", methods = [{{range $i, $m := $elmt.Methods}}{{if $i}}, {{end}}"{{$m}}"{{end}}], rate-limit = {{$elmt.RateLimit}}, rate-burst = {{$elmt.RateBurst}}}{{end}}]
+
+# RateLimit defines the number of tokens refilled per second in the bucket of each API key, or of
+# each client IP without API key. 0 disables the rate limiting.
+rate-limit = {{ .JSONRPC.Access.RateLimit }}
+
+# RateBurst defines the number of tokens of the buckets.
+rate-burst = {{ .JSONRPC.Access.RateBurst }}
+
+# MethodCosts defines the tokens consumed by the methods, the others consume a single token. The cost
+# of a batch is the sum of the cost of its requests.
+method-costs = [{{range $index, $elmt := .JSONRPC.Access.MethodCosts}}{{if $index}}, {{end}}{method = "{{$elmt.Method}}", cost = {{$elmt.Cost}}}{{end}}]
+
+# TrustForwardedFor identifies the clients by the X-Forwarded-For header. Enable it only behind a
+# reverse proxy setting the header.
+trust-forwarded-for = {{ .JSONRPC.Access.TrustForwardedFor }}
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -106,4 +186,15 @@ certificate-path = "{{ .TLS.CertificatePath }}"
 
 # Key path defines the key.pem file path for the TLS configuration.
 key-path = "{{ .TLS.KeyPath }}"
+
+###############################################################################
+###                          VersionDB Configuration                        ###
+###############################################################################
+
+[versiondb]
+
+# Enable defines if the application state is written to the versioned store on commit and the
+# historical gRPC queries are served from it, independently of the IAVL pruning. An existing
+# node must build the store from its state with the "versiondb migrate" command.
+enable = {{ .VersionDB.Enable }}
 `
@@ -0,0 +1,148 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package config
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/spf13/viper"
+)
+
+// DefaultWatchInterval is the interval between two reads of the watched app.toml file.
+const DefaultWatchInterval = 2 * time.Second
+
+// Reloadable is a running service whose configuration is reloaded by a Watcher.
+type Reloadable interface {
+	// Config returns the effective configuration of the service.
+	Config() Config
+	// ReloadConfig applies the reloadable values of the configuration.
+	ReloadConfig(cfg Config)
+}
+
+// Watcher applies the changes of an app.toml file to a running service. The
+// changes are rejected if any of them needs a restart or if the resulting
+// configuration is invalid.
+type Watcher struct {
+	logger log.Logger
+	path   string
+	target Reloadable
+
+	content []byte
+	// current is the configuration of the file last applied
+	current Config
+}
+
+// NewWatcher creates a watcher of the app.toml file at the given path.
+func NewWatcher(logger log.Logger, path string, target Reloadable) (*Watcher, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := parseConfigFile(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return &Watcher{
+		logger:  logger,
+		path:    path,
+		target:  target,
+		content: content,
+		current: current,
+	}, nil
+}
+
+// Run checks the file at every interval until the context is done.
+func (w *Watcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.Check(); err != nil {
+				w.logger.Error("rejected app config change", "path", w.path, "error", err.Error())
+			}
+		}
+	}
+}
+
+// Check applies the changes of the file since the last check. A rejected
+// content is only reported once, the next changes are compared to the
+// configuration last applied.
+func (w *Watcher) Check() error {
+	content, err := os.ReadFile(w.path)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(content, w.content) {
+		return nil
+	}
+	w.content = content
+
+	cfg, err := parseConfigFile(content)
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %w", w.path, err)
+	}
+
+	changes := Diff(w.current, cfg)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	var restart []string
+	for _, change := range changes {
+		if !change.IsReloadable() {
+			restart = append(restart, change.Key)
+		}
+	}
+	if len(restart) > 0 {
+		return fmt.Errorf("%s cannot be changed without a restart", strings.Join(restart, ", "))
+	}
+
+	effective := w.target.Config()
+	effective.JSONRPC.ApplyReloadable(cfg.JSONRPC)
+	if err := effective.JSONRPC.Validate(); err != nil {
+		return fmt.Errorf("invalid json-rpc config value: %w", err)
+	}
+
+	for _, change := range changes {
+		w.logger.Info("app config changed", "change", change.String())
+	}
+
+	w.target.ReloadConfig(effective)
+	w.current = cfg
+	return nil
+}
+
+func parseConfigFile(content []byte) (Config, error) {
+	v := viper.New()
+	v.SetConfigType("toml")
+	if err := v.ReadConfig(bytes.NewReader(content)); err != nil {
+		return Config{}, err
+	}
+
+	return GetConfig(v)
+}
@@ -0,0 +1,134 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"text/template"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+)
+
+type reloadableService struct {
+	cfg Config
+}
+
+func (s *reloadableService) Config() Config {
+	return s.cfg
+}
+
+func (s *reloadableService) ReloadConfig(cfg Config) {
+	s.cfg.JSONRPC.ApplyReloadable(cfg.JSONRPC)
+}
+
+func writeConfigFile(t *testing.T, path string, cfg *Config) {
+	tmpl, err := template.New("appConfigFileTemplate").Parse(DefaultConfigTemplate)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, tmpl.Execute(&buf, cfg))
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+}
+
+func TestDiff(t *testing.T) {
+	from := DefaultConfig()
+	to := DefaultConfig()
+	require.Empty(t, Diff(*from, *to))
+
+	to.JSONRPC.GasCap = 1
+	to.JSONRPC.API = []string{"eth"}
+	to.MinGasPrices = "1gas"
+
+	changes := Diff(*from, *to)
+	require.Equal(t, []Change{
+		{Key: "json-rpc.api", Old: from.JSONRPC.API, New: []string{"eth"}},
+		{Key: "json-rpc.gas-cap", Old: from.JSONRPC.GasCap, New: uint64(1)},
+		{Key: "minimum-gas-prices", Old: from.MinGasPrices, New: "1gas"},
+	}, changes)
+	require.False(t, changes[0].IsReloadable())
+	require.True(t, changes[1].IsReloadable())
+	require.Equal(t, "json-rpc.gas-cap: 25000000 -> 1", changes[1].String())
+}
+
+func TestWatcher(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.toml")
+	fileCfg := DefaultConfig()
+	writeConfigFile(t, path, fileCfg)
+
+	// the running configuration is overridden by the command line flags
+	service := &reloadableService{cfg: *DefaultConfig()}
+	service.cfg.JSONRPC.Address = "0.0.0.0:8545"
+
+	watcher, err := NewWatcher(log.NewNopLogger(), path, service)
+	require.NoError(t, err)
+	require.NoError(t, watcher.Check())
+
+	testCases := []struct {
+		name     string
+		malleate func(cfg *Config)
+		expErr   string
+		expCfg   func(cfg *Config)
+	}{
+		{
+			"reloadable values",
+			func(cfg *Config) {
+				cfg.JSONRPC.GasCap = 1000
+				cfg.JSONRPC.EVMTimeout = time.Second
+				cfg.JSONRPC.LogsCap = 5
+			},
+			"",
+			func(cfg *Config) {
+				cfg.JSONRPC.GasCap = 1000
+				cfg.JSONRPC.EVMTimeout = time.Second
+				cfg.JSONRPC.LogsCap = 5
+			},
+		},
+		{
+			"values requiring a restart",
+			func(cfg *Config) {
+				cfg.JSONRPC.FilterCap = 10
+				cfg.JSONRPC.WsAddress = "0.0.0.0:8546"
+				cfg.EVM.MaxTxGasWanted = 1
+			},
+			"evm.max-tx-gas-wanted, json-rpc.ws-address cannot be changed without a restart",
+			func(*Config) {},
+		},
+		{
+			"invalid value",
+			func(cfg *Config) { cfg.JSONRPC.BlockRangeCap = -1 },
+			"invalid json-rpc config value: JSON-RPC block range cap cannot be negative",
+			func(*Config) {},
+		},
+		{
+			"unchanged file",
+			func(*Config) {},
+			"",
+			func(*Config) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			expCfg := service.cfg
+			tc.expCfg(&expCfg)
+
+			cfg := *fileCfg
+			tc.malleate(&cfg)
+			writeConfigFile(t, path, &cfg)
+
+			err := watcher.Check()
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+				// a rejected content is only reported once
+				require.NoError(t, watcher.Check())
+			} else {
+				require.NoError(t, err)
+				fileCfg = &cfg
+			}
+			require.Equal(t, expCfg, service.cfg)
+		})
+	}
+}
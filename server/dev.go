@@ -0,0 +1,76 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package server
+
+import (
+	"context"
+	"slices"
+
+	"github.com/cometbft/cometbft/proxy"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+
+	"github.com/hetu-project/hetu/v1/rpc"
+	svrcfg "github.com/hetu-project/hetu/v1/server/config"
+	"github.com/hetu-project/hetu/v1/server/dev"
+)
+
+// startDevNode starts the node of a dev chain, sealing the blocks on demand,
+// and serves its block controls through the evm JSON-RPC namespace.
+func startDevNode(
+	ctx context.Context,
+	svrCtx *server.Context,
+	config *svrcfg.Config,
+	app types.Application,
+	clientCreator proxy.ClientCreator,
+	genDocProvider func() (*cmttypes.GenesisDoc, error),
+) (*dev.Node, error) {
+	devNode, err := dev.NewNode(ctx, svrCtx.Config, svrCtx.Logger, app, clientCreator, genDocProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := devNode.StartRPC(); err != nil {
+		devNode.Stop()
+		return nil, err
+	}
+
+	engine := devNode.Engine()
+	if forkApp, ok := app.(forkApplication); ok && forkApp.ForkController() != nil {
+		forkApp.ForkController().SetMiner(func() (int64, error) {
+			return engine.Mine(nil)
+		})
+	}
+
+	if err := rpc.RegisterDevAPI(engine); err != nil {
+		devNode.Stop()
+		return nil, err
+	}
+	if !slices.Contains(config.JSONRPC.API, rpc.EvmNamespace) {
+		config.JSONRPC.API = append(config.JSONRPC.API, rpc.EvmNamespace)
+	}
+
+	// the blocks indexed by the evm indexer can't be reverted, the transactions
+	// are looked up in the CometBFT tx indexer, whose entries are reverted by
+	// the engine
+	if config.JSONRPC.EnableIndexer {
+		svrCtx.Logger.Info("the evm indexer is disabled in dev mode")
+		config.JSONRPC.EnableIndexer = false
+	}
+
+	return devNode, nil
+}
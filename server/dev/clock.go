@@ -0,0 +1,63 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package dev
+
+import (
+	"fmt"
+	"time"
+)
+
+// clock computes the time of the sealed blocks. The EVM block timestamps are
+// in seconds, so the block times are truncated to the second.
+type clock struct {
+	// offset is added to the wall clock
+	offset time.Duration
+	// timestamp is the time of the next block, if set
+	timestamp *time.Time
+}
+
+// increase moves the clock forward and returns the total offset.
+func (c *clock) increase(d time.Duration) time.Duration {
+	c.offset += d
+	return c.offset
+}
+
+// setNext sets the time of the next block, which must be after the time of
+// the last block.
+func (c *clock) setNext(timestamp, last time.Time) error {
+	if timestamp.Unix() <= last.Unix() {
+		return fmt.Errorf("timestamp %d is lower than or equal to the previous block's timestamp %d", timestamp.Unix(), last.Unix())
+	}
+	c.timestamp = &timestamp
+	return nil
+}
+
+// next returns the time of the next block and consumes the timestamp set with
+// setNext. The following blocks keep the same offset with the wall clock.
+func (c *clock) next(now, last time.Time) time.Time {
+	t := now.Add(c.offset)
+	if c.timestamp != nil {
+		t = *c.timestamp
+		c.offset = t.Sub(now)
+		c.timestamp = nil
+	}
+
+	t = t.Truncate(time.Second)
+	if earliest := last.Truncate(time.Second).Add(time.Second); t.Before(earliest) {
+		t = earliest
+	}
+	return t.UTC()
+}
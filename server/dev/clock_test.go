@@ -0,0 +1,30 @@
+package dev
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClock(t *testing.T) {
+	now := time.Unix(1000, 500)
+	last := time.Unix(990, 0)
+
+	var c clock
+	require.Equal(t, time.Unix(1000, 0).UTC(), c.next(now, last))
+
+	// the blocks are at least one second apart
+	require.Equal(t, time.Unix(1001, 0).UTC(), c.next(now, time.Unix(1000, 700)))
+
+	require.Equal(t, time.Hour, c.increase(time.Hour))
+	require.Equal(t, time.Unix(4600, 0).UTC(), c.next(now, last))
+
+	require.Error(t, c.setNext(time.Unix(990, 0), last))
+	require.NoError(t, c.setNext(time.Unix(2000, 0), last))
+	require.Equal(t, time.Unix(2000, 0).UTC(), c.next(now, last))
+
+	// the following blocks keep the offset of the timestamp
+	require.Nil(t, c.timestamp)
+	require.Equal(t, time.Unix(2010, 0).UTC(), c.next(now.Add(10*time.Second), time.Unix(2000, 0)))
+}
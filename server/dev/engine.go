@@ -0,0 +1,371 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package dev
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	"github.com/cometbft/cometbft/mempool"
+)
+
+// retryInterval is the time waited before sealing the transactions of the
+// mempool again after a failure.
+const retryInterval = time.Second
+
+// Engine seals a block as soon as a transaction enters the mempool, or on
+// demand, with the single validator of a dev chain. It replaces the CometBFT
+// consensus: the blocks are executed through the ABCI FinalizeBlock and Commit
+// calls of the block executor, and the block time is controlled by the evm
+// JSON-RPC namespace.
+type Engine struct {
+	logger     log.Logger
+	app        servertypes.Application
+	blockExec  *sm.BlockExecutor
+	blockStore *store.BlockStore
+	stateStore sm.Store
+	mempool    *mempool.CListMempool
+	indexer    *Indexer
+	privVal    cmttypes.PrivValidator
+	address    cmttypes.Address
+
+	// queries is write locked while the chain is reverted
+	queries sync.RWMutex
+
+	mu             sync.Mutex
+	state          sm.State
+	clock          clock
+	snapshots      map[uint64]snapshot
+	nextSnapshotID uint64
+}
+
+// snapshot is the height and the clock the chain can be reverted to.
+type snapshot struct {
+	height int64
+	clock  clock
+}
+
+// NewEngine creates the engine sealing the blocks of the given state. The
+// private validator must be the only validator of the chain.
+func NewEngine(
+	logger log.Logger,
+	app servertypes.Application,
+	blockExec *sm.BlockExecutor,
+	blockStore *store.BlockStore,
+	stateStore sm.Store,
+	mempool *mempool.CListMempool,
+	indexer *Indexer,
+	privVal cmttypes.PrivValidator,
+	state sm.State,
+) (*Engine, error) {
+	pubKey, err := privVal.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+	address := pubKey.Address()
+
+	if state.Validators.Size() != 1 || !state.Validators.HasAddress(address) {
+		return nil, fmt.Errorf("dev mode requires the node key %s to be the only validator, got %d validators", address, state.Validators.Size())
+	}
+	if state.ConsensusParams.ABCI.VoteExtensionsEnableHeight != 0 {
+		return nil, errors.New("dev mode doesn't support vote extensions")
+	}
+
+	return &Engine{
+		logger:     logger.With("module", "dev"),
+		app:        app,
+		blockExec:  blockExec,
+		blockStore: blockStore,
+		stateStore: stateStore,
+		mempool:    mempool,
+		indexer:    indexer,
+		privVal:    privVal,
+		address:    address,
+		state:      state,
+		snapshots:  make(map[uint64]snapshot),
+	}, nil
+}
+
+// State returns the state of the latest block.
+func (e *Engine) State() sm.State {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.state.Copy()
+}
+
+// QueryApplication returns the application serving its gRPC queries under the
+// read lock of the queries, so they don't race with the reverts.
+func (e *Engine) QueryApplication() servertypes.Application {
+	return queryApplication{Application: e.app, engine: e}
+}
+
+// Run seals a block at startup, then a block as long as transactions are
+// waiting in the mempool, until the context is done.
+func (e *Engine) Run(ctx context.Context) error {
+	// the EVM chain id is only known by the application from the first block
+	// it executes, the transactions are rejected until then
+	if _, err := e.Mine(nil); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		// the mempool doesn't notify the available transactions, the wait
+		// channel stays closed while the mempool isn't empty
+		case <-e.mempool.TxsWaitChan():
+			if _, err := e.Mine(nil); err != nil {
+				e.logger.Error("failed to seal block", "error", err.Error())
+
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-time.After(retryInterval):
+				}
+			}
+		}
+	}
+}
+
+// Mine seals a block with the transactions of the mempool, at the given time
+// if not nil, and returns its height.
+func (e *Engine) Mine(timestamp *time.Time) (int64, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if timestamp != nil {
+		if err := e.clock.setNext(*timestamp, e.state.LastBlockTime); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := e.sealBlock(); err != nil {
+		return 0, err
+	}
+	return e.state.LastBlockHeight, nil
+}
+
+// IncreaseTime moves the time of the next blocks forward and returns the total
+// time offset.
+func (e *Engine) IncreaseTime(d time.Duration) time.Duration {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.clock.increase(d)
+}
+
+// SetNextBlockTimestamp sets the time of the next block, the following blocks
+// keep the same offset with the wall clock.
+func (e *Engine) SetNextBlockTimestamp(timestamp time.Time) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.clock.setNext(timestamp, e.state.LastBlockTime)
+}
+
+// Snapshot records the latest height and the clock, and returns the id to
+// revert to them.
+func (e *Engine) Snapshot() uint64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.nextSnapshotID++
+	e.snapshots[e.nextSnapshotID] = snapshot{height: e.state.LastBlockHeight, clock: e.clock}
+	return e.nextSnapshotID
+}
+
+// Revert rolls the chain back to the height of the snapshot and restores its
+// clock, removing the reverted blocks from the indexes. The snapshot and the
+// later ones are deleted. It returns false if the snapshot doesn't exist.
+//
+// NOTE: the application can't be rolled back to the state of an uncommitted
+// height, so the chain continues with an empty block sealed right after the
+// snapshot height.
+func (e *Engine) Revert(id uint64) (bool, error) {
+	// the queries can't be served while the application is rolled back, their
+	// lock is taken first as they may read the state of the engine through the
+	// CometBFT RPC
+	e.queries.Lock()
+	defer e.queries.Unlock()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	s, ok := e.snapshots[id]
+	if !ok {
+		return false, nil
+	}
+	for snapshotID := range e.snapshots {
+		if snapshotID >= id {
+			delete(e.snapshots, snapshotID)
+		}
+	}
+
+	e.clock = s.clock
+	defer e.pruneIndexer()
+	if s.height == e.state.LastBlockHeight {
+		return true, nil
+	}
+
+	if err := e.rollback(s.height); err != nil {
+		return false, err
+	}
+	if err := e.sealBlock(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// rollback removes the blocks, their indexes and the application state above
+// the height.
+func (e *Engine) rollback(height int64) error {
+	// the transactions can't be checked while the application is rolled back
+	e.mempool.Lock()
+	for e.state.LastBlockHeight > height {
+		if _, _, err := sm.Rollback(e.blockStore, e.stateStore, true); err != nil {
+			e.mempool.Unlock()
+			return fmt.Errorf("failed to roll back block %d: %w", e.state.LastBlockHeight, err)
+		}
+
+		state, err := e.stateStore.Load()
+		if err != nil {
+			e.mempool.Unlock()
+			return err
+		}
+		e.state = state
+	}
+
+	err := e.app.CommitMultiStore().RollbackToVersion(height)
+	e.mempool.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to roll back the application to height %d: %w", height, err)
+	}
+
+	// the pending transactions were checked against the reverted state
+	e.mempool.Flush()
+
+	reverted, err := e.indexer.Revert(height)
+	if err != nil {
+		return fmt.Errorf("failed to revert the indexes to height %d: %w", height, err)
+	}
+	if !reverted {
+		e.logger.Info("the indexer doesn't support reverts, the reverted blocks stay indexed")
+	}
+
+	e.logger.Info("reverted chain", "height", height)
+	return nil
+}
+
+// sealBlock proposes, commits and executes the next block.
+func (e *Engine) sealBlock() error {
+	height := e.state.LastBlockHeight + 1
+
+	lastCommit := &cmttypes.ExtendedCommit{}
+	if height > e.state.InitialHeight {
+		// the time of a block is the time of the precommits of the last block
+		var err error
+		lastCommit, err = e.signCommit(e.state.LastBlockHeight, e.state.LastBlockID, e.clock.next(time.Now(), e.state.LastBlockTime))
+		if err != nil {
+			return err
+		}
+	}
+
+	block, err := e.blockExec.CreateProposalBlock(context.Background(), height, e.state, lastCommit, e.address)
+	if err != nil {
+		return err
+	}
+
+	partSet, err := block.MakePartSet(cmttypes.BlockPartSizeBytes)
+	if err != nil {
+		return err
+	}
+	blockID := cmttypes.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+
+	seenCommit, err := e.signCommit(height, blockID, block.Time)
+	if err != nil {
+		return err
+	}
+	e.blockStore.SaveBlock(block, partSet, seenCommit.ToCommit())
+
+	state, err := e.blockExec.ApplyBlock(e.state, blockID, block)
+	if err != nil {
+		// the block is sealed again on the next attempt
+		if delErr := e.blockStore.DeleteLatestBlock(); delErr != nil {
+			e.logger.Error("failed to delete the block not applied", "height", height, "error", delErr.Error())
+		}
+		return err
+	}
+	e.state = state
+
+	// the blocks above a snapshot are indexed with the records to revert them
+	resp, err := e.stateStore.LoadLastFinalizeBlockResponse(height)
+	if err == nil {
+		err = e.indexer.Index(block, resp, len(e.snapshots) > 0)
+	}
+	if err != nil {
+		e.logger.Error("failed to index block", "height", height, "error", err.Error())
+	}
+
+	e.logger.Info("sealed block", "height", height, "txs", len(block.Txs), "time", block.Time)
+	return nil
+}
+
+// pruneIndexer drops the indexer records of the blocks which can't be reverted
+// anymore, the ones up to the height of the oldest snapshot.
+func (e *Engine) pruneIndexer() {
+	oldest := e.state.LastBlockHeight
+	for _, s := range e.snapshots {
+		oldest = min(oldest, s.height)
+	}
+	e.indexer.Prune(oldest)
+}
+
+// signCommit signs the precommit of the single validator for the block.
+func (e *Engine) signCommit(height int64, blockID cmttypes.BlockID, timestamp time.Time) (*cmttypes.ExtendedCommit, error) {
+	vote := &cmttypes.Vote{
+		Type:             cmtproto.PrecommitType,
+		Height:           height,
+		BlockID:          blockID,
+		Timestamp:        timestamp,
+		ValidatorAddress: e.address,
+	}
+
+	protoVote := vote.ToProto()
+	if err := e.privVal.SignVote(e.state.ChainID, protoVote); err != nil {
+		return nil, err
+	}
+
+	return &cmttypes.ExtendedCommit{
+		Height:  height,
+		BlockID: blockID,
+		ExtendedSignatures: []cmttypes.ExtendedCommitSig{{
+			CommitSig: cmttypes.CommitSig{
+				BlockIDFlag:      cmttypes.BlockIDFlagCommit,
+				ValidatorAddress: e.address,
+				Timestamp:        timestamp,
+				Signature:        protoVote.Signature,
+			},
+		}},
+	}, nil
+}
@@ -0,0 +1,206 @@
+package dev
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	dbm "github.com/cometbft/cometbft-db"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/cometbft/cometbft/proxy"
+	cmttypes "github.com/cometbft/cometbft/types"
+	cosmosdb "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/app"
+	"github.com/hetu-project/hetu/v1/encoding"
+	utiltx "github.com/hetu-project/hetu/v1/testutil/tx"
+	"github.com/hetu-project/hetu/v1/utils"
+)
+
+// setupNode starts a dev node of a new chain, whose blocks are executed by the
+// application through the ABCI local client.
+func setupNode(t *testing.T) (*Node, *app.Evmos) {
+	t.Helper()
+
+	home := t.TempDir()
+	chainID := utils.TestnetChainID + "-1"
+
+	config := cmtcfg.DefaultConfig()
+	config.SetRoot(home)
+	config.DBBackend = string(cosmosdb.MemDBBackend)
+	require.NoError(t, os.MkdirAll(home+"/config", 0o755))
+	require.NoError(t, os.MkdirAll(home+"/data", 0o755))
+
+	filePV := privval.GenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	filePV.Save()
+
+	evmosApp := app.NewEvmos(
+		log.NewNopLogger(), cosmosdb.NewMemDB(), nil, true, map[int64]bool{}, home, 5, encoding.MakeConfig(),
+		simtestutil.NewAppOptionsWithFlagHome(home), baseapp.SetChainID(chainID),
+	)
+
+	validator := cmttypes.NewValidator(filePV.Key.PubKey, 1)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{validator})
+	acc := authtypes.NewBaseAccountWithAddress(sdk.AccAddress(utiltx.GenerateAddress().Bytes()))
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, math.NewInt(1e18))),
+	}
+	genesisState := app.GenesisStateWithValSet(evmosApp, app.NewDefaultGenesisState(), valSet, []authtypes.GenesisAccount{acc}, balance)
+
+	// the validator signs the blocks from the first one
+	consAddr := sdk.ConsAddress(validator.Address)
+	slashingGenesis := slashingtypes.NewGenesisState(slashingtypes.DefaultParams(), []slashingtypes.SigningInfo{{
+		Address:              consAddr.String(),
+		ValidatorSigningInfo: slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0),
+	}}, nil)
+	genesisState[slashingtypes.ModuleName] = evmosApp.AppCodec().MustMarshalJSON(slashingGenesis)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	genDoc := &cmttypes.GenesisDoc{
+		ChainID:         chainID,
+		GenesisTime:     time.Now().UTC().Add(-time.Hour),
+		InitialHeight:   1,
+		ConsensusParams: cmttypes.DefaultConsensusParams(),
+		Validators: []cmttypes.GenesisValidator{{
+			Address: validator.Address,
+			PubKey:  validator.PubKey,
+			Power:   validator.VotingPower,
+		}},
+		AppState: stateBytes,
+	}
+	require.NoError(t, genDoc.ValidateAndComplete())
+
+	node, err := NewNode(
+		context.Background(), config, log.NewNopLogger(), evmosApp,
+		proxy.NewLocalClientCreator(server.NewCometABCIWrapper(evmosApp)),
+		func() (*cmttypes.GenesisDoc, error) { return genDoc, nil },
+	)
+	require.NoError(t, err)
+	t.Cleanup(node.Stop)
+
+	return node, evmosApp
+}
+
+func TestEngineRevert(t *testing.T) {
+	node, evmosApp := setupNode(t)
+	engine := node.Engine()
+
+	requireHeight := func(height int64) {
+		t.Helper()
+		require.Equal(t, height, engine.State().LastBlockHeight)
+		require.Equal(t, height, evmosApp.LastBlockHeight())
+		require.Equal(t, height, node.blockStore.Height())
+
+		for h := int64(1); h <= height+1; h++ {
+			ok, err := engine.indexer.blockIndexer.Has(h)
+			require.NoError(t, err)
+			require.Equal(t, h <= height, ok, "block %d indexed", h)
+		}
+	}
+
+	height, err := engine.Mine(nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), height)
+
+	first := engine.Snapshot()
+	_, err = engine.Mine(nil)
+	require.NoError(t, err)
+	second := engine.Snapshot()
+	_, err = engine.Mine(nil)
+	require.NoError(t, err)
+	requireHeight(3)
+
+	// reverting to the latest height doesn't seal any block
+	_, err = engine.Mine(nil)
+	require.NoError(t, err)
+	third := engine.Snapshot()
+	ok, err := engine.Revert(third)
+	require.NoError(t, err)
+	require.True(t, ok)
+	requireHeight(4)
+
+	// the chain continues with an empty block right after the snapshot height
+	ok, err = engine.Revert(second)
+	require.NoError(t, err)
+	require.True(t, ok)
+	requireHeight(3)
+
+	ok, err = engine.Revert(first)
+	require.NoError(t, err)
+	require.True(t, ok)
+	requireHeight(2)
+
+	// the snapshots are deleted once reverted
+	ok, err = engine.Revert(second)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// the blocks sealed without snapshot aren't recorded
+	require.Empty(t, engine.indexer.journal.entries)
+	_, err = engine.Mine(nil)
+	require.NoError(t, err)
+	requireHeight(3)
+	require.Empty(t, engine.indexer.journal.entries)
+}
+
+func TestJournalDB(t *testing.T) {
+	db := &journalDB{DB: dbm.NewMemDB()}
+	require.NoError(t, db.Set([]byte("a"), []byte("1")))
+
+	db.record(2)
+	batch := db.NewBatch()
+	require.NoError(t, batch.Set([]byte("a"), []byte("2")))
+	require.NoError(t, batch.Set([]byte("b"), []byte("2")))
+	require.NoError(t, batch.WriteSync())
+	db.record(3)
+	require.NoError(t, db.Delete([]byte("a")))
+	require.NoError(t, db.Set([]byte("c"), []byte("3")))
+	db.record(0)
+
+	requireValues := func(expValues map[string]string) {
+		t.Helper()
+		for key, expValue := range expValues {
+			value, err := db.Get([]byte(key))
+			require.NoError(t, err)
+			if expValue == "" {
+				require.Nil(t, value, key)
+				continue
+			}
+			require.Equal(t, []byte(expValue), value, key)
+		}
+	}
+
+	requireValues(map[string]string{"a": "", "b": "2", "c": "3"})
+	require.NoError(t, db.revert(2))
+	requireValues(map[string]string{"a": "2", "b": "2", "c": ""})
+	require.Len(t, db.entries, 2)
+
+	require.NoError(t, db.revert(1))
+	requireValues(map[string]string{"a": "1", "b": "", "c": ""})
+	require.Empty(t, db.entries)
+
+	db.record(4)
+	require.NoError(t, db.Set([]byte("d"), []byte("4")))
+	db.record(5)
+	require.NoError(t, db.Set([]byte("d"), []byte("5")))
+	db.record(0)
+	db.prune(4)
+	require.Len(t, db.entries, 1)
+	require.NoError(t, db.revert(0))
+	requireValues(map[string]string{"d": "4"})
+}
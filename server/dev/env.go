@@ -0,0 +1,80 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package dev
+
+import (
+	"errors"
+
+	"github.com/cometbft/cometbft/p2p"
+	sm "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+var errNoPeers = errors.New("a dev node has no peers")
+
+// consensus implements the consensus state of the CometBFT RPC environment
+// from the state of the engine.
+type consensus struct {
+	engine *Engine
+}
+
+func (c consensus) GetState() sm.State {
+	return c.engine.State()
+}
+
+func (c consensus) GetValidators() (int64, []*cmttypes.Validator) {
+	state := c.engine.State()
+	return state.LastBlockHeight + 1, state.Validators.Copy().Validators
+}
+
+func (c consensus) GetLastHeight() int64 {
+	return c.engine.State().LastBlockHeight
+}
+
+// GetRoundStateJSON returns an empty round state, the blocks are sealed
+// without consensus rounds.
+func (c consensus) GetRoundStateJSON() ([]byte, error) {
+	return []byte("{}"), nil
+}
+
+func (c consensus) GetRoundStateSimpleJSON() ([]byte, error) {
+	return []byte("{}"), nil
+}
+
+// consensusReactor reports the node as synced.
+type consensusReactor struct{}
+
+func (consensusReactor) WaitSync() bool {
+	return false
+}
+
+// peers implements the empty peer set of a dev node.
+type peers struct{}
+
+func (peers) AddPersistentPeers([]string) error      { return errNoPeers }
+func (peers) AddUnconditionalPeerIDs([]string) error { return errNoPeers }
+func (peers) AddPrivatePeerIDs([]string) error       { return errNoPeers }
+func (peers) DialPeersAsync([]string) error          { return errNoPeers }
+func (peers) Peers() p2p.IPeerSet                    { return p2p.NewPeerSet() }
+
+// transport implements the p2p transport of a dev node, which doesn't listen.
+type transport struct {
+	nodeInfo p2p.NodeInfo
+}
+
+func (t transport) Listeners() []string    { return nil }
+func (t transport) IsListening() bool      { return false }
+func (t transport) NodeInfo() p2p.NodeInfo { return t.nodeInfo }
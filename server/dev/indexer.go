@@ -0,0 +1,252 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package dev
+
+import (
+	"sort"
+	"sync"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtindexer "github.com/cometbft/cometbft/state/indexer"
+	"github.com/cometbft/cometbft/state/indexer/block"
+	"github.com/cometbft/cometbft/state/txindex"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// Indexer indexes the sealed blocks in the CometBFT tx and block indexers. The
+// writes of the blocks above the oldest snapshot are recorded in a journal, so
+// the entries of the reverted blocks are removed from the indexes.
+type Indexer struct {
+	txIndexer    txindex.TxIndexer
+	blockIndexer cmtindexer.BlockIndexer
+	// journal is nil if the indexers don't write to a database
+	journal *journalDB
+}
+
+// NewIndexer creates the indexers configured for the node.
+func NewIndexer(config *cmtcfg.Config, chainID string) (*Indexer, error) {
+	idx := &Indexer{}
+
+	dbProvider := func(ctx *cmtcfg.DBContext) (dbm.DB, error) {
+		db, err := cmtcfg.DefaultDBProvider(ctx)
+		if err != nil {
+			return nil, err
+		}
+		idx.journal = &journalDB{DB: db}
+		return idx.journal, nil
+	}
+
+	var err error
+	idx.txIndexer, idx.blockIndexer, _, err = block.IndexerFromConfigWithDisabledIndexers(config, dbProvider, chainID)
+	if err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+// Index indexes the events of the block and its transactions. The writes are
+// recorded if revertible is true.
+func (idx *Indexer) Index(block *cmttypes.Block, resp *abci.ResponseFinalizeBlock, revertible bool) error {
+	if idx.journal != nil && revertible {
+		idx.journal.record(block.Height)
+		defer idx.journal.record(0)
+	}
+
+	batch := txindex.NewBatch(int64(len(block.Txs)))
+	for i, tx := range block.Txs {
+		if err := batch.Add(&abci.TxResult{
+			Height: block.Height,
+			Index:  uint32(i), //#nosec G115 -- the number of txs of a block fits in uint32
+			Tx:     tx,
+			Result: *resp.TxResults[i],
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := idx.blockIndexer.Index(cmttypes.EventDataNewBlockEvents{
+		Height: block.Height,
+		Events: resp.Events,
+		NumTxs: int64(len(block.Txs)),
+	}); err != nil {
+		return err
+	}
+	return idx.txIndexer.AddBatch(batch)
+}
+
+// Revert removes the entries of the blocks above the height. It returns false
+// if the indexers don't write to a database.
+func (idx *Indexer) Revert(height int64) (bool, error) {
+	if idx.journal == nil {
+		return false, nil
+	}
+	return true, idx.journal.revert(height)
+}
+
+// Prune drops the records of the blocks up to the height, which can't be
+// reverted anymore.
+func (idx *Indexer) Prune(height int64) {
+	if idx.journal != nil {
+		idx.journal.prune(height)
+	}
+}
+
+// journalDB records the previous values of the keys written while recording
+// the writes of a block.
+type journalDB struct {
+	dbm.DB
+
+	mu sync.Mutex
+	// height is the height of the recorded block, 0 if not recording
+	height  int64
+	entries []journalEntry
+}
+
+// journalEntry is the previous value of a key written at a height, nil if the
+// key wasn't set.
+type journalEntry struct {
+	height int64
+	key    []byte
+	value  []byte
+}
+
+var _ dbm.DB = (*journalDB)(nil)
+
+// record records the following writes as the writes of the block at the
+// height, 0 to stop recording.
+func (db *journalDB) record(height int64) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.height = height
+}
+
+// save records the previous value of the key if recording.
+func (db *journalDB) save(key []byte) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.height == 0 {
+		return nil
+	}
+
+	value, err := db.DB.Get(key)
+	if err != nil {
+		return err
+	}
+	db.entries = append(db.entries, journalEntry{
+		height: db.height,
+		key:    append([]byte(nil), key...),
+		value:  value,
+	})
+	return nil
+}
+
+// revert restores the previous values of the keys written above the height,
+// the latest writes first.
+func (db *journalDB) revert(height int64) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	batch := db.DB.NewBatch()
+	defer batch.Close()
+
+	i := len(db.entries)
+	for ; i > 0 && db.entries[i-1].height > height; i-- {
+		entry := db.entries[i-1]
+
+		var err error
+		if entry.value == nil {
+			err = batch.Delete(entry.key)
+		} else {
+			err = batch.Set(entry.key, entry.value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+	db.entries = db.entries[:i]
+	return nil
+}
+
+// prune drops the entries written up to the height.
+func (db *journalDB) prune(height int64) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	// the entries are sorted by height as the blocks are sealed in order
+	i := sort.Search(len(db.entries), func(i int) bool {
+		return db.entries[i].height > height
+	})
+	db.entries = append([]journalEntry(nil), db.entries[i:]...)
+}
+
+func (db *journalDB) Set(key, value []byte) error {
+	if err := db.save(key); err != nil {
+		return err
+	}
+	return db.DB.Set(key, value)
+}
+
+func (db *journalDB) SetSync(key, value []byte) error {
+	if err := db.save(key); err != nil {
+		return err
+	}
+	return db.DB.SetSync(key, value)
+}
+
+func (db *journalDB) Delete(key []byte) error {
+	if err := db.save(key); err != nil {
+		return err
+	}
+	return db.DB.Delete(key)
+}
+
+func (db *journalDB) DeleteSync(key []byte) error {
+	if err := db.save(key); err != nil {
+		return err
+	}
+	return db.DB.DeleteSync(key)
+}
+
+func (db *journalDB) NewBatch() dbm.Batch {
+	return journalBatch{Batch: db.DB.NewBatch(), db: db}
+}
+
+// journalBatch records the previous values of the keys written by a batch.
+type journalBatch struct {
+	dbm.Batch
+	db *journalDB
+}
+
+func (b journalBatch) Set(key, value []byte) error {
+	if err := b.db.save(key); err != nil {
+		return err
+	}
+	return b.Batch.Set(key, value)
+}
+
+func (b journalBatch) Delete(key []byte) error {
+	if err := b.db.save(key); err != nil {
+		return err
+	}
+	return b.Batch.Delete(key)
+}
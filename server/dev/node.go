@@ -0,0 +1,279 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package dev
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"cosmossdk.io/log"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cs "github.com/cometbft/cometbft/consensus"
+	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
+	"github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/p2p"
+	pvm "github.com/cometbft/cometbft/privval"
+	"github.com/cometbft/cometbft/proxy"
+	rpccore "github.com/cometbft/cometbft/rpc/core"
+	rpcserver "github.com/cometbft/cometbft/rpc/jsonrpc/server"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/state/txindex/null"
+	"github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	servercmtlog "github.com/cosmos/cosmos-sdk/server/log"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// Node runs a single-validator chain without consensus nor p2p networking:
+// the blocks are sealed by the Engine and the CometBFT RPC is served from its
+// stores, so the clients of a full node work unchanged.
+type Node struct {
+	logger log.Logger
+	config *cmtcfg.Config
+
+	engine     *Engine
+	proxyApp   proxy.AppConns
+	eventBus   *cmttypes.EventBus
+	indexer    *txindex.IndexerService
+	blockStore *store.BlockStore
+	stateStore sm.Store
+	rpcEnv     *rpccore.Environment
+	listeners  []net.Listener
+}
+
+// NewNode creates the dev node of the application and replays the blocks the
+// application is missing.
+func NewNode(
+	ctx context.Context,
+	config *cmtcfg.Config,
+	logger log.Logger,
+	app servertypes.Application,
+	clientCreator proxy.ClientCreator,
+	genDocProvider node.GenesisDocProvider,
+) (n *Node, err error) {
+	cmtLogger := servercmtlog.CometLoggerWrapper{Logger: logger.With("server", "node")}
+	n = &Node{logger: logger.With("module", "dev"), config: config}
+	defer func() {
+		if err != nil {
+			n.Stop()
+		}
+	}()
+
+	blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return nil, err
+	}
+	n.blockStore = store.NewBlockStore(blockStoreDB)
+
+	stateDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return nil, err
+	}
+	n.stateStore = sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+	})
+
+	state, genDoc, err := node.LoadStateFromDBOrGenesisDocProvider(stateDB, genDocProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	n.proxyApp = proxy.NewAppConns(clientCreator, proxy.NopMetrics())
+	n.proxyApp.SetLogger(cmtLogger.With("module", "proxy"))
+	if err := n.proxyApp.Start(); err != nil {
+		return nil, err
+	}
+
+	n.eventBus = cmttypes.NewEventBus()
+	n.eventBus.SetLogger(cmtLogger.With("module", "events"))
+	if err := n.eventBus.Start(); err != nil {
+		return nil, err
+	}
+
+	idx, err := NewIndexer(config, genDoc.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the blocks replayed by the handshake are indexed from their events, the
+	// sealed blocks by the engine
+	n.indexer = txindex.NewIndexerService(idx.txIndexer, idx.blockIndexer, n.eventBus, false)
+	n.indexer.SetLogger(cmtLogger.With("module", "txindex"))
+	if err := n.indexer.Start(); err != nil {
+		return nil, err
+	}
+
+	// sync the application with the stored blocks
+	handshaker := cs.NewHandshaker(n.stateStore, state, n.blockStore, genDoc)
+	handshaker.SetLogger(cmtLogger.With("module", "consensus"))
+	handshaker.SetEventBus(n.eventBus)
+	if err := handshaker.HandshakeWithContext(ctx, n.proxyApp); err != nil {
+		return nil, err
+	}
+	if err := n.indexer.Stop(); err != nil {
+		return nil, err
+	}
+	if state, err = n.stateStore.Load(); err != nil {
+		return nil, err
+	}
+
+	mp := mempool.NewCListMempool(
+		config.Mempool,
+		n.proxyApp.Mempool(),
+		state.LastBlockHeight,
+		mempool.WithPreCheck(sm.TxPreCheck(state)),
+		mempool.WithPostCheck(sm.TxPostCheck(state)),
+	)
+	mp.SetLogger(cmtLogger.With("module", "mempool"))
+
+	blockExec := sm.NewBlockExecutor(n.stateStore, cmtLogger.With("module", "state"), n.proxyApp.Consensus(), mp, sm.EmptyEvidencePool{}, n.blockStore)
+	blockExec.SetEventBus(n.eventBus)
+
+	// the blocks are only signed by this node, so the double signing
+	// protection of the file private validator is not needed
+	filePV := pvm.LoadOrGenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	privVal := cmttypes.NewMockPVWithParams(filePV.Key.PrivKey, false, false)
+
+	n.engine, err = NewEngine(logger, app, blockExec, n.blockStore, n.stateStore, mp, idx, privVal, state)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+	if err != nil {
+		return nil, err
+	}
+	txIndexerStatus := "on"
+	if _, ok := idx.txIndexer.(*null.TxIndex); ok {
+		txIndexerStatus = "off"
+	}
+
+	n.rpcEnv = &rpccore.Environment{
+		ProxyAppQuery:    queryConn{AppConnQuery: n.proxyApp.Query(), engine: n.engine},
+		ProxyAppMempool:  n.proxyApp.Mempool(),
+		StateStore:       n.stateStore,
+		BlockStore:       n.blockStore,
+		EvidencePool:     sm.EmptyEvidencePool{},
+		ConsensusState:   consensus{engine: n.engine},
+		ConsensusReactor: consensusReactor{},
+		P2PPeers:         peers{},
+		P2PTransport: transport{nodeInfo: p2p.DefaultNodeInfo{
+			ProtocolVersion: p2p.NewProtocolVersion(version.P2PProtocol, state.Version.Consensus.Block, state.Version.Consensus.App),
+			DefaultNodeID:   nodeKey.ID(),
+			Network:         genDoc.ChainID,
+			Version:         version.TMCoreSemVer,
+			Moniker:         config.Moniker,
+			Other: p2p.DefaultNodeInfoOther{
+				TxIndex:    txIndexerStatus,
+				RPCAddress: config.RPC.ListenAddress,
+			},
+		}},
+		PubKey:       filePV.Key.PubKey,
+		GenDoc:       genDoc,
+		TxIndexer:    idx.txIndexer,
+		BlockIndexer: idx.blockIndexer,
+		EventBus:     n.eventBus,
+		Mempool:      mp,
+		Logger:       cmtLogger.With("module", "rpc"),
+		Config:       *config.RPC,
+	}
+	if err := n.rpcEnv.InitGenesisChunks(); err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// Engine returns the engine sealing the blocks.
+func (n *Node) Engine() *Engine {
+	return n.engine
+}
+
+// StartRPC serves the CometBFT RPC on the configured listen address.
+func (n *Node) StartRPC() error {
+	rpcLogger := servercmtlog.CometLoggerWrapper{Logger: n.logger.With("module", "rpc-server")}
+	routes := n.rpcEnv.GetRoutes()
+
+	config := rpcserver.DefaultConfig()
+	config.MaxRequestBatchSize = n.config.RPC.MaxRequestBatchSize
+	config.MaxBodyBytes = n.config.RPC.MaxBodyBytes
+	config.MaxHeaderBytes = n.config.RPC.MaxHeaderBytes
+	config.MaxOpenConnections = n.config.RPC.MaxOpenConnections
+	if config.WriteTimeout <= n.config.RPC.TimeoutBroadcastTxCommit {
+		config.WriteTimeout = n.config.RPC.TimeoutBroadcastTxCommit + time.Second
+	}
+
+	mux := http.NewServeMux()
+	wm := rpcserver.NewWebsocketManager(routes,
+		rpcserver.OnDisconnect(func(remoteAddr string) {
+			err := n.eventBus.UnsubscribeAll(context.Background(), remoteAddr)
+			if err != nil && err != cmtpubsub.ErrSubscriptionNotFound {
+				n.logger.Error("failed to unsubscribe addr from events", "addr", remoteAddr, "error", err.Error())
+			}
+		}),
+		rpcserver.ReadLimit(config.MaxBodyBytes),
+		rpcserver.WriteChanCapacity(n.config.RPC.WebSocketWriteBufferSize),
+	)
+	wm.SetLogger(rpcLogger.With("protocol", "websocket"))
+	mux.HandleFunc("/websocket", wm.WebsocketHandler)
+	rpcserver.RegisterRPCFuncs(mux, routes, rpcLogger)
+
+	listener, err := rpcserver.Listen(n.config.RPC.ListenAddress, config.MaxOpenConnections)
+	if err != nil {
+		return err
+	}
+	n.listeners = append(n.listeners, listener)
+
+	go func() {
+		if err := rpcserver.Serve(listener, mux, rpcLogger, config); err != nil {
+			n.logger.Error("failed to serve the CometBFT RPC", "error", err.Error())
+		}
+	}()
+	return nil
+}
+
+// Stop stops the RPC and the services of the node.
+func (n *Node) Stop() {
+	for _, l := range n.listeners {
+		if err := l.Close(); err != nil {
+			n.logger.Error("failed to close the RPC listener", "error", err.Error())
+		}
+	}
+	if n.indexer != nil && n.indexer.IsRunning() {
+		_ = n.indexer.Stop()
+	}
+	if n.eventBus != nil && n.eventBus.IsRunning() {
+		_ = n.eventBus.Stop()
+	}
+	if n.proxyApp != nil && n.proxyApp.IsRunning() {
+		_ = n.proxyApp.Stop()
+	}
+	if n.blockStore != nil {
+		if err := n.blockStore.Close(); err != nil {
+			n.logger.Error("failed to close the block store", "error", err.Error())
+		}
+	}
+	if n.stateStore != nil {
+		if err := n.stateStore.Close(); err != nil {
+			n.logger.Error("failed to close the state store", "error", err.Error())
+		}
+	}
+}
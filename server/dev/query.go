@@ -0,0 +1,78 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package dev
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/proxy"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// The queries read the IAVL trees of the application lazily, while a revert
+// deletes their versions above the snapshot height, so the gRPC and ABCI
+// queries are served under the read lock of the engine's queries.
+
+// queryApplication registers the gRPC services of the application, holding
+// the read lock of the queries while serving them.
+type queryApplication struct {
+	servertypes.Application
+	engine *Engine
+}
+
+// RegisterGRPCServer implements servertypes.Application.
+func (app queryApplication) RegisterGRPCServer(server gogogrpc.Server) {
+	app.Application.RegisterGRPCServer(queryServer{Server: server, engine: app.engine})
+}
+
+// queryServer wraps the unary handlers of the registered services.
+type queryServer struct {
+	gogogrpc.Server
+	engine *Engine
+}
+
+// RegisterService implements gogogrpc.Server.
+func (s queryServer) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	desc := *sd
+	desc.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		handler := method.Handler
+		method.Handler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			s.engine.queries.RLock()
+			defer s.engine.queries.RUnlock()
+			return handler(srv, ctx, dec, interceptor)
+		}
+		desc.Methods[i] = method
+	}
+	s.Server.RegisterService(&desc, ss)
+}
+
+// queryConn serves the ABCI queries of the CometBFT RPC.
+type queryConn struct {
+	proxy.AppConnQuery
+	engine *Engine
+}
+
+// Query implements proxy.AppConnQuery.
+func (c queryConn) Query(ctx context.Context, req *abci.RequestQuery) (*abci.ResponseQuery, error) {
+	c.engine.queries.RLock()
+	defer c.engine.queries.RUnlock()
+	return c.AppConnQuery.Query(ctx, req)
+}
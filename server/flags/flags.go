@@ -60,12 +60,20 @@ const (
 	JSONRPCFilterCap           = "json-rpc.filter-cap"
 	JSONRPCLogsCap             = "json-rpc.logs-cap"
 	JSONRPCBlockRangeCap       = "json-rpc.block-range-cap"
+	JSONRPCBlockCacheSize      = "json-rpc.block-cache-size"
+	JSONRPCTxCacheSize         = "json-rpc.tx-cache-size"
 	JSONRPCHTTPTimeout         = "json-rpc.http-timeout"
 	JSONRPCHTTPIdleTimeout     = "json-rpc.http-idle-timeout"
+	JSONRPCShutdownTimeout     = "json-rpc.shutdown-timeout"
+	JSONRPCMaxIndexerLag       = "json-rpc.max-indexer-lag"
 	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
 	JSONRPCAllowIndexerGap     = "json-rpc.allow-indexer-gap"
+	JSONRPCEnableLedger        = "json-rpc.enable-ledger"
+	JSONRPCSigner              = "json-rpc.signer"
+	JSONRPCBundlerKey          = "json-rpc.bundler-key"
+	JSONRPCArchiveUpstream     = "json-rpc.archive-upstream"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -85,6 +93,22 @@ const (
 	TLSKeyPath  = "tls.key-path"
 )
 
+// VersionDB flags
+const (
+	VersionDBEnable = "versiondb.enable"
+)
+
+// Fork flags
+const (
+	ForkFrom   = "fork-from"
+	ForkHeight = "fork-height"
+)
+
+// Dev flags
+const (
+	Dev = "dev"
+)
+
 // AddTxFlags adds common flags for commands to post tx
 func AddTxFlags(cmd *cobra.Command) (*cobra.Command, error) {
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "Specify Chain ID for sending Tx")
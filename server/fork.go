@@ -0,0 +1,53 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package server
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/hetu-project/hetu/v1/rpc"
+	svrcfg "github.com/hetu-project/hetu/v1/server/config"
+	"github.com/hetu-project/hetu/v1/x/evm/fork"
+)
+
+// forkApplication is implemented by the applications able to fork the EVM
+// state of a remote network, see the --fork-from flag.
+type forkApplication interface {
+	ForkController() *fork.Controller
+}
+
+// setupFork serves the development controls of a chain forked from a remote
+// network through the hetu JSON-RPC namespace.
+func setupFork(svrCtx *server.Context, config *svrcfg.Config, controller *fork.Controller) error {
+	// the state is loaded and overridden outside of the consensus, so the
+	// forked chain can't be shared with other nodes
+	p2p := svrCtx.Config.P2P
+	if p2p.PersistentPeers != "" || p2p.Seeds != "" {
+		return errors.New("a forked chain runs a single validator, peers and seeds must be empty")
+	}
+
+	if err := rpc.RegisterForkAPI(controller); err != nil {
+		return err
+	}
+
+	if !slices.Contains(config.JSONRPC.API, rpc.HetuNamespace) {
+		config.JSONRPC.API = append(config.JSONRPC.API, rpc.HetuNamespace)
+	}
+	return nil
+}
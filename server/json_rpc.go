@@ -16,9 +16,13 @@
 package server
 
 import (
+	"context"
 	"net/http"
 	"time"
 
+	"cosmossdk.io/log"
+	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
 
@@ -27,11 +31,24 @@ import (
 	ethlog "github.com/ethereum/go-ethereum/log"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/hetu-project/hetu/v1/rpc"
+	"github.com/hetu-project/hetu/v1/rpc/access"
+	"github.com/hetu-project/hetu/v1/rpc/health"
 
 	svrcfg "github.com/hetu-project/hetu/v1/server/config"
 	evmostypes "github.com/hetu-project/hetu/v1/types"
 )
 
+// JSONRPCServer is a running JSON-RPC server, serving the HTTP and WebSocket APIs and the
+// health endpoints.
+type JSONRPCServer struct {
+	logger      log.Logger
+	httpSrv     *http.Server
+	httpSrvDone chan struct{}
+	wsSrv       rpc.WebsocketsServer
+	tmWsClients []*rpcclient.WSClient
+	drain       *health.Drain
+}
+
 // StartJSONRPC starts the JSON-RPC server
 func StartJSONRPC(ctx *server.Context,
 	clientCtx client.Context,
@@ -39,7 +56,7 @@ func StartJSONRPC(ctx *server.Context,
 	tmEndpoint string,
 	config *svrcfg.Config,
 	indexer evmostypes.EVMTxIndexer,
-) (*http.Server, chan struct{}, error) {
+) (*JSONRPCServer, error) {
 	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
 
 	logger := ctx.Logger.With("module", "geth")
@@ -69,12 +86,27 @@ func StartJSONRPC(ctx *server.Context,
 				"namespace", api.Namespace,
 				"service", api.Service,
 			)
-			return nil, nil, err
+			return nil, err
 		}
 	}
 
+	accessCtrl, err := access.NewController(config.JSONRPC.Access)
+	if err != nil {
+		return nil, err
+	}
+
+	// the websocket client of the subscriptions is allocated before the readiness checker
+	wsTmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
+
+	drain := health.NewDrain()
+	checker := health.NewChecker(clientCtx.Client, indexer, config.JSONRPC.MaxIndexerLag, func() bool {
+		return wsTmWsClient != nil && wsTmWsClient.IsActive()
+	}, drain)
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", drain.Middleware(accessCtrl.Middleware(rpcServer))).Methods("POST")
+	r.HandleFunc("/health", checker.HealthHandler).Methods("GET")
+	r.HandleFunc("/ready", checker.ReadyHandler).Methods("GET")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -93,7 +125,7 @@ func StartJSONRPC(ctx *server.Context,
 
 	ln, err := Listen(httpSrv.Addr, config)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	errCh := make(chan error)
@@ -113,15 +145,54 @@ func StartJSONRPC(ctx *server.Context,
 	select {
 	case err := <-errCh:
 		ctx.Logger.Error("failed to boot JSON-RPC server", "error", err.Error())
-		return nil, nil, err
+		return nil, err
 	case <-time.After(svrcfg.ServerStartTime): // assume JSON RPC server started successfully
 	}
 
 	ctx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
 
-	// allocate separate WS connection to Tendermint
-	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
+	// the WS server uses a separate WS connection to Tendermint
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, wsTmWsClient, config, accessCtrl, drain)
 	wsSrv.Start()
-	return httpSrv, httpSrvDone, nil
+
+	return &JSONRPCServer{
+		logger:      ctx.Logger,
+		httpSrv:     httpSrv,
+		httpSrvDone: httpSrvDone,
+		wsSrv:       wsSrv,
+		tmWsClients: []*rpcclient.WSClient{tmWsClient, wsTmWsClient},
+		drain:       drain,
+	}, nil
+}
+
+// Shutdown drains the server: the new requests are refused and the readiness endpoint
+// fails while the in-flight requests finish, until the context is done. The websocket
+// connections are then closed with their subscriptions, and the HTTP server is stopped.
+func (s *JSONRPCServer) Shutdown(ctx context.Context) error {
+	s.logger.Info("draining JSON-RPC server", "in_flight", s.drain.InFlight())
+	s.drain.Start()
+	if err := s.drain.Wait(ctx); err != nil {
+		s.logger.Error("JSON-RPC requests still in flight on shutdown", "in_flight", s.drain.InFlight())
+	}
+
+	if err := s.wsSrv.Shutdown(ctx); err != nil {
+		s.logger.Error("failed to shut down JSON WebSocket server", "error", err.Error())
+	}
+
+	err := s.httpSrv.Shutdown(ctx)
+	if err == nil {
+		select {
+		case <-s.httpSrvDone:
+		case <-ctx.Done():
+		}
+	}
+
+	for _, client := range s.tmWsClients {
+		if client != nil && client.IsRunning() {
+			_ = client.Stop() // #nosec G703
+		}
+	}
+
+	s.logger.Info("JSON-RPC server shut down")
+	return err
 }
@@ -20,11 +20,9 @@ import (
 	"fmt"
 	"io"
 	"net"
-	"net/http"
 	"os"
 	"path/filepath"
 	"runtime/pprof"
-	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -45,6 +43,7 @@ import (
 	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	"github.com/cometbft/cometbft/rpc/client/local"
 	cmttypes "github.com/cometbft/cometbft/types"
 	dbm "github.com/cosmos/cosmos-db"
@@ -68,6 +67,7 @@ import (
 	"github.com/hetu-project/hetu/v1/indexer"
 	ethdebug "github.com/hetu-project/hetu/v1/rpc/namespaces/ethereum/debug"
 	"github.com/hetu-project/hetu/v1/server/config"
+	"github.com/hetu-project/hetu/v1/server/dev"
 	srvflags "github.com/hetu-project/hetu/v1/server/flags"
 	evmostypes "github.com/hetu-project/hetu/v1/types"
 )
@@ -214,12 +214,20 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, config.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPTimeout, config.DefaultHTTPTimeout, "Sets a read/write timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, config.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
+	cmd.Flags().Duration(srvflags.JSONRPCShutdownTimeout, config.DefaultShutdownTimeout, "Sets the time given to the in-flight json-rpc requests to finish on shutdown")                                                        //nolint:lll
+	cmd.Flags().Int64(srvflags.JSONRPCMaxIndexerLag, config.DefaultMaxIndexerLag, "Sets the number of blocks the custom tx indexer can lag behind for the node to be ready")                                                    //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCAllowUnprotectedTxs, config.DefaultAllowUnprotectedTxs, "Allow for unprotected (non EIP155 signed) transactions to be submitted via the node's RPC when the global parameter is disabled") //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, config.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
+	cmd.Flags().Int(srvflags.JSONRPCBlockCacheSize, config.DefaultBlockCacheSize, "Sets the number of blocks cached by the json-rpc backend (0=disabled)")
+	cmd.Flags().Int(srvflags.JSONRPCTxCacheSize, config.DefaultTxCacheSize, "Sets the number of transactions and receipts cached by the json-rpc backend (0=disabled)")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
 	cmd.Flags().Bool(srvflags.JSONRPCAllowIndexerGap, true, "Allow block gap for the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLedger, false, "Enable signing with the connected Ledger wallets for json-rpc")
+	cmd.Flags().String(srvflags.JSONRPCSigner, "", "Signer of the json-rpc signing methods, disabled if empty (keyring|<external signer endpoint>)")
+	cmd.Flags().String(srvflags.JSONRPCBundlerKey, "", "Name of the keyring key signing the bundles of the json-rpc ERC-4337 bundler")
+	cmd.Flags().String(srvflags.JSONRPCArchiveUpstream, "", "JSON-RPC endpoint of an archive node serving the state queries at the pruned heights (disabled if empty)") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
@@ -228,6 +236,13 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
 
+	cmd.Flags().Bool(srvflags.VersionDBEnable, false, "Define if the historical queries are served from the versioned state store")
+
+	cmd.Flags().String(srvflags.ForkFrom, "", "gRPC endpoint of the remote node the EVM state of a single-validator dev chain is forked from (disabled if empty)") //nolint:lll
+	cmd.Flags().Int64(srvflags.ForkHeight, 0, "Height of the remote node the EVM state is forked at (0=latest)")
+
+	cmd.Flags().Bool(srvflags.Dev, false, "Seal a block as soon as a transaction is received, without consensus nor peers, and enable the evm JSON-RPC namespace") //nolint:lll
+
 	cmd.Flags().Uint64(server.FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(server.FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 	cmd.Flags().Int(server.FlagMempoolMaxTxs, config.DefaultMaxTxs, "Sets MaxTx value for the app-side mempool")
@@ -334,6 +349,13 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		return err
 	}
 
+	if svrCtx.Viper.GetBool(srvflags.Dev) {
+		// the inter-block cache and the IAVL fast node index would keep the
+		// state of the blocks removed by evm_revert
+		svrCtx.Viper.Set(server.FlagInterBlockCache, false)
+		svrCtx.Viper.Set(server.FlagDisableIAVLFastNode, true)
+	}
+
 	app := opts.AppCreator(svrCtx.Logger, db, traceWriter, svrCtx.Viper)
 	defer func() {
 		if err := app.Close(); err != nil {
@@ -341,6 +363,13 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		}
 	}()
 
+	if forkApp, ok := app.(forkApplication); ok && forkApp.ForkController() != nil {
+		if err := setupFork(svrCtx, &config, forkApp.ForkController()); err != nil {
+			logger.Error("failed to setup fork", "error", err.Error())
+			return err
+		}
+	}
+
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
 		logger.Error("failed load or gen node key", "error", err.Error())
@@ -351,9 +380,15 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	var (
 		tmNode   *node.Node
+		devNode  *dev.Node
 		gRPCOnly = svrCtx.Viper.GetBool(srvflags.GRPCOnly)
+		devMode  = svrCtx.Viper.GetBool(srvflags.Dev)
 	)
 
+	if gRPCOnly && devMode {
+		return fmt.Errorf("--%s and --%s are mutually exclusive", srvflags.GRPCOnly, srvflags.Dev)
+	}
+
 	if gRPCOnly {
 		logger.Info("starting node in query only mode; CometBFT is disabled")
 		config.GRPC.Enable = true
@@ -371,39 +406,70 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 			clientCreator = proxy.NewLocalClientCreator(cmtApp)
 		}
 
-		tmNode, err = node.NewNodeWithContext(
-			ctx,
-			cfg,
-			pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
-			nodeKey,
-			clientCreator,
-			genDocProvider,
-			cmtcfg.DefaultDBProvider,
-			node.DefaultMetricsProvider(cfg.Instrumentation),
-			servercmtlog.CometLoggerWrapper{Logger: svrCtx.Logger.With("server", "node")},
-		)
-		if err != nil {
-			logger.Error("failed init node", "error", err.Error())
-			return err
-		}
+		if devMode {
+			logger.Info("starting node in dev mode; blocks are sealed on demand")
 
-		if err := tmNode.Start(); err != nil {
-			logger.Error("failed start tendermint server", "error", err.Error())
-			return err
-		}
+			devNode, err = startDevNode(ctx, svrCtx, &config, app, clientCreator, genDocProvider)
+			if err != nil {
+				logger.Error("failed start dev node", "error", err.Error())
+				return err
+			}
+			defer devNode.Stop()
 
-		defer func() {
-			if tmNode.IsRunning() {
-				_ = tmNode.Stop()
+			g.Go(func() error {
+				return devNode.Engine().Run(ctx)
+			})
+		} else {
+			tmNode, err = node.NewNodeWithContext(
+				ctx,
+				cfg,
+				pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
+				nodeKey,
+				clientCreator,
+				genDocProvider,
+				cmtcfg.DefaultDBProvider,
+				node.DefaultMetricsProvider(cfg.Instrumentation),
+				servercmtlog.CometLoggerWrapper{Logger: svrCtx.Logger.With("server", "node")},
+			)
+			if err != nil {
+				logger.Error("failed init node", "error", err.Error())
+				return err
 			}
-		}()
+
+			if err := tmNode.Start(); err != nil {
+				logger.Error("failed start tendermint server", "error", err.Error())
+				return err
+			}
+
+			defer func() {
+				if tmNode.IsRunning() {
+					_ = tmNode.Stop()
+				}
+			}()
+		}
 	}
 
 	// Add the tx service to the gRPC router. We only need to register this
 	// service if API or gRPC or JSONRPC is enabled, and avoid doing so in the general
 	// case, because it spawns a new local tendermint RPC client.
-	if (config.API.Enable || config.GRPC.Enable || config.JSONRPC.Enable || config.JSONRPC.EnableIndexer) && tmNode != nil {
-		clientCtx = clientCtx.WithClient(local.New(tmNode))
+	if (config.API.Enable || config.GRPC.Enable || config.JSONRPC.Enable || config.JSONRPC.EnableIndexer) && (tmNode != nil || devNode != nil) {
+		if devNode != nil {
+			// a dev node is only reachable through its RPC, the client is
+			// started to subscribe to its events
+			client, err := rpchttp.New(cfg.RPC.ListenAddress, "/websocket")
+			if err != nil {
+				return err
+			}
+			if err := client.Start(); err != nil {
+				return err
+			}
+			defer func() {
+				_ = client.Stop()
+			}()
+			clientCtx = clientCtx.WithClient(client)
+		} else {
+			clientCtx = clientCtx.WithClient(local.New(tmNode))
+		}
 
 		app.RegisterTxService(clientCtx)
 		app.RegisterTendermintService(clientCtx)
@@ -454,28 +520,21 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 			WithChainID(chainID)
 	}
 
-	grpcSrv, clientCtx, err := startGrpcServer(ctx, svrCtx, clientCtx, g, config.GRPC, app)
+	grpcApp := app
+	if devNode != nil {
+		// the queries of a dev chain don't race with its reverts
+		grpcApp = devNode.Engine().QueryApplication()
+	}
+	grpcSrv, clientCtx, err := startGrpcServer(ctx, svrCtx, clientCtx, g, config.GRPC, grpcApp)
 	if err != nil {
 		return err
 	}
 
 	startAPIServer(ctx, svrCtx, clientCtx, g, config.Config, app, grpcSrv, metrics)
 
-	clientCtx, httpSrv, httpSrvDone, err := startJSONRPCServer(svrCtx, clientCtx, g, config, genDocProvider, cfg.RPC.ListenAddress, idxer)
-	if httpSrv != nil {
-		defer func() {
-			shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
-			defer cancelFn()
-			if err := httpSrv.Shutdown(shutdownCtx); err != nil {
-				logger.Error("HTTP server shutdown produced a warning", "error", err.Error())
-			} else {
-				logger.Info("HTTP server shut down, waiting 5 sec")
-				select {
-				case <-time.Tick(5 * time.Second):
-				case <-httpSrvDone:
-				}
-			}
-		}()
+	clientCtx, err = startJSONRPCServer(ctx, svrCtx, clientCtx, g, config, genDocProvider, cfg.RPC.ListenAddress, idxer)
+	if err != nil {
+		return err
 	}
 
 	// At this point it is safe to block the process if we're in query only mode as
@@ -645,8 +704,10 @@ func startAPIServer(
 	})
 }
 
-// startJSONRPCServer starts a JSON-RPC server based on the provided configuration.
+// startJSONRPCServer starts a JSON-RPC server based on the provided configuration. The
+// server is drained and shut down when the context is done.
 // Parameters:
+// - ctx: The context whose cancellation shuts the server down.
 // - svrCtx: The server context containing configuration, logger, and stateful components.
 // - clientCtx: The client context, which may be updated with additional chain information.
 // - g: An errgroup.Group to manage concurrent goroutines and error handling.
@@ -655,6 +716,7 @@ func startAPIServer(
 // - cmtRPCAddr: The address of the CometBFT RPC server for WebSocket connections.
 // - idxer: The EVM transaction indexer for indexing transactions.
 func startJSONRPCServer(
+	ctx context.Context,
 	svrCtx *server.Context,
 	clientCtx client.Context,
 	g *errgroup.Group,
@@ -662,24 +724,32 @@ func startJSONRPCServer(
 	genDocProvider node.GenesisDocProvider,
 	cmtRPCAddr string,
 	idxer evmostypes.EVMTxIndexer,
-) (ctx client.Context, httpSrv *http.Server, httpSrvDone chan struct{}, err error) {
-	ctx = clientCtx
+) (client.Context, error) {
 	if !config.JSONRPC.Enable {
-		return
+		return clientCtx, nil
 	}
 
 	genDoc, err := genDocProvider()
 	if err != nil {
-		return ctx, httpSrv, httpSrvDone, err
+		return clientCtx, err
 	}
 
-	ctx = clientCtx.WithChainID(genDoc.ChainID)
 	cmtEndpoint := "/websocket"
 	g.Go(func() error {
-		httpSrv, httpSrvDone, err = StartJSONRPC(svrCtx, clientCtx, cmtRPCAddr, cmtEndpoint, &config, idxer)
-		return err
+		jsonRPCSrv, err := StartJSONRPC(svrCtx, clientCtx, cmtRPCAddr, cmtEndpoint, &config, idxer)
+		if err != nil {
+			return err
+		}
+
+		<-ctx.Done()
+		shutdownCtx, cancelFn := context.WithTimeout(context.Background(), config.JSONRPC.ShutdownTimeout)
+		defer cancelFn()
+		if err := jsonRPCSrv.Shutdown(shutdownCtx); err != nil {
+			svrCtx.Logger.Error("JSON-RPC server shutdown produced a warning", "error", err.Error())
+		}
+		return nil
 	})
-	return
+	return clientCtx.WithChainID(genDoc.ChainID), nil
 }
 
 func startRosettaServer(
@@ -69,6 +69,7 @@ func AddCommands(
 
 		// custom tx indexer command
 		NewIndexTxCmd(),
+		NewVersionDBCmd(),
 	)
 }
 
@@ -89,7 +90,7 @@ func ConnectTmWS(tmRPCAddr, tmEndpoint string, logger tmlog.Logger) *rpcclient.W
 			"address", tmRPCAddr+tmEndpoint,
 			"error", err,
 		)
-	} else if err := tmWsClient.OnStart(); err != nil {
+	} else if err := tmWsClient.Start(); err != nil {
 		logger.Error(
 			"Tendermint WS client could not start",
 			"address", tmRPCAddr+tmEndpoint,
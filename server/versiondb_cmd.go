@@ -0,0 +1,171 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/hetu-project/hetu/v1/versiondb"
+)
+
+const flagMigrateBatchSize = "batch-size"
+
+// NewVersionDBCmd returns the versioned state store commands.
+func NewVersionDBCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "versiondb",
+		Short: "Versioned state store subcommands",
+	}
+
+	cmd.AddCommand(NewVersionDBMigrateCmd())
+	return cmd
+}
+
+// NewVersionDBMigrateCmd returns the command building the versioned state store from the
+// latest IAVL state.
+func NewVersionDBMigrateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Build the versioned state store from the IAVL state",
+		Long: `Build the versioned state store from the latest IAVL state into an empty versiondb.
+The node must be stopped, and started with versiondb enabled right after the migration so that
+no block is missing. The historical queries are served from the store from the migrated height,
+the older ones from the IAVL state.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			home := serverCtx.Config.RootDir
+			logger := serverCtx.Logger
+			backend := server.GetAppDBBackend(serverCtx.Viper)
+
+			batchSize, err := cmd.Flags().GetInt(flagMigrateBatchSize)
+			if err != nil {
+				return err
+			}
+			if batchSize <= 0 {
+				return fmt.Errorf("invalid batch size %d", batchSize)
+			}
+
+			db, err := openDB(serverCtx.Viper, home, backend)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			height := rootmulti.GetLatestVersion(db)
+			if height == 0 {
+				return fmt.Errorf("the application state is empty")
+			}
+
+			versionDB, err := versiondb.OpenDB(home, backend)
+			if err != nil {
+				return err
+			}
+			defer versionDB.Close()
+			store := versiondb.NewStore(versionDB)
+
+			// the keys missing from the migrated state would need deletions at the migrated
+			// height, so only an empty store is migrated
+			versionLatest, err := store.LatestVersion()
+			if err != nil {
+				return err
+			}
+			if versionLatest != 0 {
+				return fmt.Errorf("versiondb is not empty, it holds the version %d: remove it before migrating", versionLatest)
+			}
+
+			rs := rootmulti.NewStore(db, logger, metrics.NewNoOpMetrics())
+			info, err := rs.GetCommitInfo(height)
+			if err != nil {
+				return err
+			}
+			keys := make(map[string]storetypes.StoreKey, len(info.StoreInfos))
+			for _, storeInfo := range info.StoreInfos {
+				key := storetypes.NewKVStoreKey(storeInfo.Name)
+				keys[storeInfo.Name] = key
+				rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+			}
+			if err := rs.LoadLatestVersion(); err != nil {
+				return err
+			}
+			cms := rs.CacheMultiStore()
+
+			// mark the store as incomplete until the migration succeeds
+			if err := store.SetEarliestVersion(0); err != nil {
+				return err
+			}
+
+			for _, storeInfo := range info.StoreInfos {
+				count, err := migrateStore(store, cms.GetKVStore(keys[storeInfo.Name]), storeInfo.Name, height, batchSize)
+				if err != nil {
+					return fmt.Errorf("failed to migrate store %s: %w", storeInfo.Name, err)
+				}
+				logger.Info("migrated store", "store", storeInfo.Name, "keys", count)
+			}
+
+			if err := store.PutAtVersion(height, nil); err != nil {
+				return err
+			}
+			if err := store.SetEarliestVersion(height); err != nil {
+				return err
+			}
+
+			logger.Info("versiondb migrated", "height", height)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(flagMigrateBatchSize, 10000, "the number of keys written per batch")
+	return cmd
+}
+
+// migrateStore writes all the keys of the store at the version, returning their number.
+func migrateStore(store *versiondb.Store, kvStore storetypes.KVStore, name string, version int64, batchSize int) (int, error) {
+	it := kvStore.Iterator(nil, nil)
+	defer it.Close()
+
+	count := 0
+	batch := make([]*storetypes.StoreKVPair, 0, batchSize)
+	for ; it.Valid(); it.Next() {
+		batch = append(batch, &storetypes.StoreKVPair{
+			StoreKey: name,
+			Key:      it.Key(),
+			Value:    it.Value(),
+		})
+
+		if len(batch) == batchSize {
+			if err := store.PutAtVersion(version, batch); err != nil {
+				return 0, err
+			}
+			count += len(batch)
+			batch = batch[:0]
+		}
+	}
+
+	if err := store.PutAtVersion(version, batch); err != nil {
+		return 0, err
+	}
+	return count + len(batch), nil
+}
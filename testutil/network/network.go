@@ -70,6 +70,7 @@ import (
 	"github.com/hetu-project/hetu/v1/crypto/hd"
 
 	"github.com/hetu-project/hetu/v1/encoding"
+	evmosserver "github.com/hetu-project/hetu/v1/server"
 	"github.com/hetu-project/hetu/v1/server/config"
 	testutilconfig "github.com/hetu-project/hetu/v1/testutil/config"
 	evmostypes "github.com/hetu-project/hetu/v1/types"
@@ -209,14 +210,13 @@ type (
 		RPCClient     tmclient.Client
 		JSONRPCClient *ethclient.Client
 
-		tmNode      *node.Node
-		api         *api.Server
-		grpc        *grpc.Server
-		grpcWeb     *http.Server
-		jsonrpc     *http.Server
-		jsonrpcDone chan struct{}
-		errGroup    *errgroup.Group
-		cancelFn    context.CancelFunc
+		tmNode   *node.Node
+		api      *api.Server
+		grpc     *grpc.Server
+		grpcWeb  *http.Server
+		jsonrpc  *evmosserver.JSONRPCServer
+		errGroup *errgroup.Group
+		cancelFn context.CancelFunc
 	}
 )
 
@@ -666,12 +666,6 @@ func (n *Network) Cleanup() {
 
 			if err := v.jsonrpc.Shutdown(shutdownCtx); err != nil {
 				v.tmNode.Logger.Error("HTTP server shutdown produced a warning", "error", err.Error())
-			} else {
-				v.tmNode.Logger.Info("HTTP server shut down, waiting 5 sec")
-				select {
-				case <-time.Tick(5 * time.Second):
-				case <-v.jsonrpcDone:
-				}
 			}
 		}
 	}
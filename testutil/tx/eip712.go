@@ -39,6 +39,9 @@ type EIP712TxArgs struct {
 	CosmosTxArgs       CosmosTxArgs
 	UseLegacyExtension bool
 	UseLegacyTypedData bool
+	// UseProtoEncoding signs the tx with the proto EIP-712 encoding, selected
+	// with the ExtensionOptionsEIP712 extension option
+	UseProtoEncoding bool
 }
 
 type typedDataArgs struct {
@@ -130,6 +133,12 @@ func PrepareEIP712CosmosTx(
 		return nil, err
 	}
 
+	if args.UseProtoEncoding {
+		if typedData, err = createProtoTypedData(builder, ctx.ChainID(), accNumber, nonce); err != nil {
+			return nil, err
+		}
+	}
+
 	return signCosmosEIP712Tx(
 		ctx,
 		appEvmos,
@@ -224,6 +233,25 @@ func createTypedData(args typedDataArgs, useLegacy bool) (apitypes.TypedData, er
 	return eip712.WrapTxToTypedData(args.chainID, args.data)
 }
 
+// createProtoTypedData sets the ExtensionOptionsEIP712 extension option on the
+// builder and returns the TypedData object of the tx with the proto encoding.
+func createProtoTypedData(builder authtx.ExtensionOptionsTxBuilder, chainID string, accNumber, nonce uint64) (apitypes.TypedData, error) {
+	option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionsEIP712{
+		Version: eip712.ProtoEncodingVersion,
+	})
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+	builder.SetExtensionOptions(option)
+
+	txData, err := eip712.NewProtoTxData(builder.GetTx(), chainID, accNumber, nonce)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	return eip712.ProtoWrapTxToTypedData(txData)
+}
+
 // setBuilderLegacyWeb3Extension creates a legacy ExtensionOptionsWeb3Tx and
 // appends it to the builder options.
 func setBuilderLegacyWeb3Extension(builder authtx.ExtensionOptionsTxBuilder, args legacyWeb3ExtensionArgs) error {
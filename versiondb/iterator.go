@@ -0,0 +1,168 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package versiondb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+var _ dbm.Iterator = (*versionIterator)(nil)
+
+// versionIterator iterates over the keys of a store at a version. The underlying iterator
+// visits all the versions of each key, which are grouped and ordered by version, and the
+// iterator returns the latest value written at or before the version.
+type versionIterator struct {
+	it        dbm.Iterator
+	prefixLen int
+	start     []byte
+	end       []byte
+	version   int64
+	ascending bool
+
+	key   []byte
+	value []byte
+	valid bool
+	err   error
+}
+
+func newVersionIterator(it dbm.Iterator, prefixLen int, start, end []byte, version int64, ascending bool) *versionIterator {
+	vi := &versionIterator{
+		it:        it,
+		prefixLen: prefixLen,
+		start:     start,
+		end:       end,
+		version:   version,
+		ascending: ascending,
+	}
+	vi.advance()
+	return vi
+}
+
+// Domain implements dbm.Iterator.
+func (vi *versionIterator) Domain() ([]byte, []byte) {
+	return vi.start, vi.end
+}
+
+// Valid implements dbm.Iterator.
+func (vi *versionIterator) Valid() bool {
+	return vi.valid
+}
+
+// Next implements dbm.Iterator.
+func (vi *versionIterator) Next() {
+	if !vi.valid {
+		panic("iterator is invalid")
+	}
+	vi.advance()
+}
+
+// Key implements dbm.Iterator.
+func (vi *versionIterator) Key() []byte {
+	if !vi.valid {
+		panic("iterator is invalid")
+	}
+	return vi.key
+}
+
+// Value implements dbm.Iterator.
+func (vi *versionIterator) Value() []byte {
+	if !vi.valid {
+		panic("iterator is invalid")
+	}
+	return vi.value
+}
+
+// Error implements dbm.Iterator.
+func (vi *versionIterator) Error() error {
+	if vi.err != nil {
+		return vi.err
+	}
+	return vi.it.Error()
+}
+
+// Close implements dbm.Iterator.
+func (vi *versionIterator) Close() error {
+	return vi.it.Close()
+}
+
+// advance moves to the next key set at the version.
+func (vi *versionIterator) advance() {
+	vi.valid = false
+	for vi.it.Valid() {
+		key, value, err := vi.consumeKey()
+		if err != nil {
+			vi.err = err
+			return
+		}
+
+		if value != nil {
+			vi.key, vi.value, vi.valid = key, value, true
+			return
+		}
+	}
+}
+
+// consumeKey moves the underlying iterator past the versions of its current key, returning
+// the key and its value at the version, nil if not set.
+func (vi *versionIterator) consumeKey() (key, value []byte, err error) {
+	key, version, err := vi.decodeKey(vi.it.Key())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	found := false
+	for {
+		// the versions are ascending in ascending iterators and descending otherwise,
+		// the latest version at or before the iterator version is kept
+		if version <= vi.version && (vi.ascending || !found) {
+			value = decodeValue(vi.it.Value())
+			found = true
+		}
+
+		vi.it.Next()
+		if !vi.it.Valid() {
+			return key, value, nil
+		}
+
+		var next []byte
+		next, version, err = vi.decodeKey(vi.it.Key())
+		if err != nil {
+			return nil, nil, err
+		}
+		if !bytes.Equal(next, key) {
+			return key, value, nil
+		}
+	}
+}
+
+// decodeKey returns the key and the version of a database key.
+func (vi *versionIterator) decodeKey(dbKey []byte) ([]byte, int64, error) {
+	key, n, err := unescape(dbKey[vi.prefixLen:])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bz := dbKey[vi.prefixLen+n:]
+	if len(bz) != versionLength {
+		return nil, 0, errors.New("invalid version encoding")
+	}
+	return key, int64(binary.BigEndian.Uint64(bz)), nil //#nosec G115 -- versions are positive
+}
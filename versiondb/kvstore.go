@@ -0,0 +1,98 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package versiondb
+
+import (
+	"io"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var _ storetypes.KVStore = (*KVStore)(nil)
+
+// KVStore is a read-only view of a store of the versioned store at a version.
+type KVStore struct {
+	store    *Store
+	storeKey string
+	version  int64
+}
+
+// NewKVStore returns a read-only view of the store at the version.
+func NewKVStore(store *Store, storeKey string, version int64) *KVStore {
+	return &KVStore{store: store, storeKey: storeKey, version: version}
+}
+
+// GetStoreType implements storetypes.Store.
+func (s *KVStore) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeDB
+}
+
+// CacheWrap implements storetypes.Store.
+func (s *KVStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements storetypes.Store.
+func (s *KVStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// Get implements storetypes.KVStore.
+func (s *KVStore) Get(key []byte) []byte {
+	storetypes.AssertValidKey(key)
+
+	value, err := s.store.GetAtVersion(s.storeKey, key, s.version)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Has implements storetypes.KVStore.
+func (s *KVStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+// Set implements storetypes.KVStore. The store is read-only.
+func (s *KVStore) Set(_, _ []byte) {
+	panic("versiondb: historical store is read-only")
+}
+
+// Delete implements storetypes.KVStore. The store is read-only.
+func (s *KVStore) Delete(_ []byte) {
+	panic("versiondb: historical store is read-only")
+}
+
+// Iterator implements storetypes.KVStore.
+func (s *KVStore) Iterator(start, end []byte) storetypes.Iterator {
+	it, err := s.store.IteratorAtVersion(s.storeKey, start, end, s.version, true)
+	if err != nil {
+		panic(err)
+	}
+	return it
+}
+
+// ReverseIterator implements storetypes.KVStore.
+func (s *KVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	it, err := s.store.IteratorAtVersion(s.storeKey, start, end, s.version, false)
+	if err != nil {
+		panic(err)
+	}
+	return it
+}
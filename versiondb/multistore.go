@@ -0,0 +1,150 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package versiondb
+
+import (
+	"io"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/cachemulti"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var _ storetypes.MultiStore = (*MultiStore)(nil)
+
+// MultiStore is the multi store serving the queries. The queries at a historical version
+// covered by the versioned store are served from it, the others from the parent store.
+type MultiStore struct {
+	parent    storetypes.MultiStore
+	versionDB *Store
+
+	// keys are all the store keys, by name
+	keys map[string]storetypes.StoreKey
+	// versionedKeys are the keys of the persistent stores, held by the versioned store
+	versionedKeys []storetypes.StoreKey
+	// delegatedKeys are the keys of the transient and memory stores, read from the parent
+	delegatedKeys []storetypes.StoreKey
+
+	traceWriter  io.Writer
+	traceContext storetypes.TraceContext
+}
+
+// NewMultiStore returns a multi store serving the historical queries of the persistent
+// stores from the versioned store.
+func NewMultiStore(
+	parent storetypes.MultiStore,
+	versionDB *Store,
+	keys map[string]*storetypes.KVStoreKey,
+	delegatedKeys []storetypes.StoreKey,
+) *MultiStore {
+	ms := &MultiStore{
+		parent:        parent,
+		versionDB:     versionDB,
+		keys:          make(map[string]storetypes.StoreKey, len(keys)+len(delegatedKeys)),
+		versionedKeys: make([]storetypes.StoreKey, 0, len(keys)),
+		delegatedKeys: delegatedKeys,
+	}
+
+	for _, key := range keys {
+		ms.keys[key.Name()] = key
+		ms.versionedKeys = append(ms.versionedKeys, key)
+	}
+	for _, key := range delegatedKeys {
+		ms.keys[key.Name()] = key
+	}
+
+	return ms
+}
+
+// GetStoreType implements storetypes.Store.
+func (ms *MultiStore) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+// CacheWrap implements storetypes.Store.
+func (ms *MultiStore) CacheWrap() storetypes.CacheWrap {
+	return ms.parent.CacheWrap()
+}
+
+// CacheWrapWithTrace implements storetypes.Store.
+func (ms *MultiStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return ms.parent.CacheWrapWithTrace(w, tc)
+}
+
+// CacheMultiStore implements storetypes.MultiStore.
+func (ms *MultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return ms.parent.CacheMultiStore()
+}
+
+// CacheMultiStoreWithVersion implements storetypes.MultiStore. The latest version and the
+// versions not covered by the versioned store are loaded from the parent store.
+func (ms *MultiStore) CacheMultiStoreWithVersion(version int64) (storetypes.CacheMultiStore, error) {
+	if version == 0 || version >= ms.parent.LatestVersion() {
+		return ms.parent.CacheMultiStoreWithVersion(version)
+	}
+
+	ok, err := ms.versionDB.HasVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return ms.parent.CacheMultiStoreWithVersion(version)
+	}
+
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(ms.keys))
+	for _, key := range ms.versionedKeys {
+		stores[key] = NewKVStore(ms.versionDB, key.Name(), version)
+	}
+	for _, key := range ms.delegatedKeys {
+		stores[key] = ms.parent.GetKVStore(key)
+	}
+
+	return cachemulti.NewStore(dbm.NewMemDB(), stores, ms.keys, ms.traceWriter, ms.traceContext), nil
+}
+
+// GetStore implements storetypes.MultiStore.
+func (ms *MultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.parent.GetStore(key)
+}
+
+// GetKVStore implements storetypes.MultiStore.
+func (ms *MultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return ms.parent.GetKVStore(key)
+}
+
+// TracingEnabled implements storetypes.MultiStore.
+func (ms *MultiStore) TracingEnabled() bool {
+	return ms.traceWriter != nil
+}
+
+// SetTracer implements storetypes.MultiStore.
+func (ms *MultiStore) SetTracer(w io.Writer) storetypes.MultiStore {
+	ms.traceWriter = w
+	return ms
+}
+
+// SetTracingContext implements storetypes.MultiStore.
+func (ms *MultiStore) SetTracingContext(tc storetypes.TraceContext) storetypes.MultiStore {
+	ms.traceContext = tc
+	return ms
+}
+
+// LatestVersion implements storetypes.MultiStore.
+func (ms *MultiStore) LatestVersion() int64 {
+	return ms.parent.LatestVersion()
+}
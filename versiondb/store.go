@@ -0,0 +1,335 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+// Package versiondb implements a versioned key-value store holding the history of the
+// application state, written on commit and serving the gRPC queries at historical heights
+// independently of the IAVL trees.
+package versiondb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+const (
+	// DBName is the name of the versiondb database in the data directory.
+	DBName = "versiondb"
+
+	prefixData = 'd'
+	prefixMeta = 'm'
+
+	// the values are prefixed with a flag distinguishing the deletions
+	flagDeleted = 0x00
+	flagSet     = 0x01
+
+	versionLength = 8
+
+	// the number of keys moved per batch by the store upgrades
+	upgradeBatchSize = 10000
+)
+
+var (
+	keyLatestVersion   = []byte{prefixMeta, 'l'}
+	keyEarliestVersion = []byte{prefixMeta, 'e'}
+)
+
+// OpenDB opens the versiondb database of the node, using the backend of the application database.
+func OpenDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB(DBName, backendType, dataDir)
+}
+
+// Store is a versioned key-value store. Each write is stored under a flat key made of the
+// store name, the key and the version, so a key at a version is read with a single seek.
+//
+// The store is complete from its earliest version, which is either the initial height of
+// the chain or the height of the IAVL state it's migrated from, up to its latest version.
+type Store struct {
+	db dbm.DB
+}
+
+// NewStore returns a versioned store persisted in the database.
+func NewStore(db dbm.DB) *Store {
+	return &Store{db: db}
+}
+
+// LatestVersion returns the latest version written, 0 if empty.
+func (s *Store) LatestVersion() (int64, error) {
+	return s.getVersion(keyLatestVersion)
+}
+
+// EarliestVersion returns the version from which the store is complete, 0 if incomplete.
+func (s *Store) EarliestVersion() (int64, error) {
+	return s.getVersion(keyEarliestVersion)
+}
+
+// HasVersion returns true if the store is complete at the version.
+func (s *Store) HasVersion(version int64) (bool, error) {
+	earliest, err := s.EarliestVersion()
+	if err != nil || earliest == 0 {
+		return false, err
+	}
+
+	latest, err := s.LatestVersion()
+	if err != nil {
+		return false, err
+	}
+
+	return version >= earliest && version <= latest, nil
+}
+
+// SetEarliestVersion sets the version from which the store is complete, 0 marking it as
+// incomplete.
+func (s *Store) SetEarliestVersion(version int64) error {
+	if version == 0 {
+		return s.db.DeleteSync(keyEarliestVersion)
+	}
+	return s.db.SetSync(keyEarliestVersion, encodeVersion(version))
+}
+
+// PutAtVersion writes the changes of a version atomically, updating the latest version.
+func (s *Store) PutAtVersion(version int64, changeSet []*storetypes.StoreKVPair) error {
+	if version <= 0 {
+		return fmt.Errorf("invalid version %d", version)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, pair := range changeSet {
+		value := []byte{flagDeleted}
+		if !pair.Delete {
+			value = append([]byte{flagSet}, pair.Value...)
+		}
+		if err := batch.Set(dataKey(pair.StoreKey, pair.Key, version), value); err != nil {
+			return err
+		}
+	}
+
+	latest, err := s.LatestVersion()
+	if err != nil {
+		return err
+	}
+	if version > latest {
+		if err := batch.Set(keyLatestVersion, encodeVersion(version)); err != nil {
+			return err
+		}
+	}
+
+	return batch.WriteSync()
+}
+
+// ApplyStoreUpgrades writes the store upgrades applied at the version: the keys of the
+// deleted stores are deleted and the keys of the renamed stores are moved to their new name.
+func (s *Store) ApplyStoreUpgrades(version int64, upgrades *storetypes.StoreUpgrades) error {
+	for _, name := range upgrades.Deleted {
+		if err := s.moveStore(name, "", version); err != nil {
+			return fmt.Errorf("failed to delete store %s: %w", name, err)
+		}
+	}
+	for _, rename := range upgrades.Renamed {
+		if err := s.moveStore(rename.OldKey, rename.NewKey, version); err != nil {
+			return fmt.Errorf("failed to rename store %s to %s: %w", rename.OldKey, rename.NewKey, err)
+		}
+	}
+	return nil
+}
+
+// moveStore deletes the keys of a store at the version, writing them to the new store if
+// its name isn't empty. The keys are read by batches, the iterator being closed before
+// each write as some databases lock on iteration.
+func (s *Store) moveStore(storeKey, newStoreKey string, version int64) error {
+	var start []byte
+	for {
+		changeSet, next, err := s.readMoveBatch(storeKey, newStoreKey, start, version)
+		if err != nil {
+			return err
+		}
+		if err := s.PutAtVersion(version, changeSet); err != nil {
+			return err
+		}
+		if next == nil {
+			return nil
+		}
+		start = next
+	}
+}
+
+// readMoveBatch returns the changes moving a batch of keys of a store from the start key
+// and the key following the batch, nil if it's the last one.
+func (s *Store) readMoveBatch(storeKey, newStoreKey string, start []byte, version int64) ([]*storetypes.StoreKVPair, []byte, error) {
+	it, err := s.IteratorAtVersion(storeKey, start, nil, version-1, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	changeSet := make([]*storetypes.StoreKVPair, 0, upgradeBatchSize)
+	for count := 0; it.Valid(); it.Next() {
+		if count == upgradeBatchSize {
+			return changeSet, it.Key(), nil
+		}
+		count++
+
+		changeSet = append(changeSet, &storetypes.StoreKVPair{StoreKey: storeKey, Key: it.Key(), Delete: true})
+		if newStoreKey != "" {
+			changeSet = append(changeSet, &storetypes.StoreKVPair{StoreKey: newStoreKey, Key: it.Key(), Value: it.Value()})
+		}
+	}
+	return changeSet, nil, it.Error()
+}
+
+// GetAtVersion returns the value of the key of a store at the version, nil if not set.
+func (s *Store) GetAtVersion(storeKey string, key []byte, version int64) ([]byte, error) {
+	prefix := keyPrefix(storeKey, key)
+	it, err := s.db.ReverseIterator(
+		concat(prefix, encodeVersion(0)),
+		concat(prefix, encodeVersion(version+1)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return nil, it.Error()
+	}
+	return decodeValue(it.Value()), nil
+}
+
+// IteratorAtVersion returns an iterator over the keys of a store in the [start, end)
+// domain at the version.
+func (s *Store) IteratorAtVersion(storeKey string, start, end []byte, version int64, ascending bool) (dbm.Iterator, error) {
+	storePrefix := storePrefix(storeKey)
+
+	dbStart := storePrefix
+	if start != nil {
+		dbStart = concat(storePrefix, escape(start))
+	}
+
+	dbEnd := storetypes.PrefixEndBytes(storePrefix)
+	if end != nil {
+		dbEnd = concat(storePrefix, escape(end))
+	}
+
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if ascending {
+		it, err = s.db.Iterator(dbStart, dbEnd)
+	} else {
+		it, err = s.db.ReverseIterator(dbStart, dbEnd)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newVersionIterator(it, len(storePrefix), start, end, version, ascending), nil
+}
+
+func (s *Store) getVersion(key []byte) (int64, error) {
+	bz, err := s.db.Get(key)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	if len(bz) != versionLength {
+		return 0, errors.New("invalid version encoding")
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil //#nosec G115 -- versions are positive
+}
+
+// storePrefix returns the prefix of the keys of a store.
+func storePrefix(storeKey string) []byte {
+	return concat([]byte{prefixData}, escape([]byte(storeKey)))
+}
+
+// keyPrefix returns the prefix of the versions of a key.
+func keyPrefix(storeKey string, key []byte) []byte {
+	return concat(storePrefix(storeKey), escape(key))
+}
+
+// dataKey returns the flat key of a key at a version.
+func dataKey(storeKey string, key []byte, version int64) []byte {
+	return concat(keyPrefix(storeKey, key), encodeVersion(version))
+}
+
+// concat returns a new slice holding the bytes of a followed by the bytes of b.
+func concat(a, b []byte) []byte {
+	bz := make([]byte, 0, len(a)+len(b))
+	return append(append(bz, a...), b...)
+}
+
+func encodeVersion(version int64) []byte {
+	bz := make([]byte, versionLength)
+	binary.BigEndian.PutUint64(bz, uint64(version)) //#nosec G115 -- versions are positive
+	return bz
+}
+
+// decodeValue returns the value of an entry, nil for a deletion.
+func decodeValue(bz []byte) []byte {
+	if len(bz) == 0 || bz[0] == flagDeleted {
+		return nil
+	}
+	return bz[1:]
+}
+
+// escape encodes a key preserving the order and without any encoded key being a prefix of
+// another one: 0x00 bytes are escaped as 0x00 0xFF and the key is terminated by 0x00 0x01.
+func escape(key []byte) []byte {
+	escaped := make([]byte, 0, len(key)+2)
+	for _, b := range key {
+		if b == 0x00 {
+			escaped = append(escaped, 0x00, 0xFF)
+			continue
+		}
+		escaped = append(escaped, b)
+	}
+	return append(escaped, 0x00, 0x01)
+}
+
+// unescape decodes the key at the beginning of the data, returning the key and the length
+// of its encoding.
+func unescape(bz []byte) ([]byte, int, error) {
+	key := make([]byte, 0, len(bz))
+	for i := 0; i < len(bz); i++ {
+		if bz[i] != 0x00 {
+			key = append(key, bz[i])
+			continue
+		}
+
+		if i+1 >= len(bz) {
+			return nil, 0, errors.New("invalid key encoding")
+		}
+
+		switch bz[i+1] {
+		case 0xFF:
+			key = append(key, 0x00)
+			i++
+		case 0x01:
+			return key, i + 2, nil
+		default:
+			return nil, 0, errors.New("invalid key encoding")
+		}
+	}
+	return nil, 0, errors.New("unterminated key encoding")
+}
@@ -0,0 +1,267 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package versiondb
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func set(storeKey, key, value string) *storetypes.StoreKVPair {
+	return &storetypes.StoreKVPair{StoreKey: storeKey, Key: []byte(key), Value: []byte(value)}
+}
+
+func del(storeKey, key string) *storetypes.StoreKVPair {
+	return &storetypes.StoreKVPair{StoreKey: storeKey, Key: []byte(key), Delete: true}
+}
+
+func newTestStore(t *testing.T) *Store {
+	store := NewStore(dbm.NewMemDB())
+	require.NoError(t, store.PutAtVersion(1, []*storetypes.StoreKVPair{
+		set("bank", "a", "1"),
+		set("bank", "b", "1"),
+		set("bank", "c\x00", "1"),
+		set("evm", "a", "evm"),
+	}))
+	require.NoError(t, store.PutAtVersion(2, []*storetypes.StoreKVPair{
+		set("bank", "a", "2"),
+		del("bank", "b"),
+	}))
+	require.NoError(t, store.PutAtVersion(4, []*storetypes.StoreKVPair{
+		set("bank", "b", "4"),
+		set("bank", "c", "4"),
+	}))
+	return store
+}
+
+func collect(it dbm.Iterator) []string {
+	defer it.Close()
+
+	var kvs []string
+	for ; it.Valid(); it.Next() {
+		kvs = append(kvs, string(it.Key())+"="+string(it.Value()))
+	}
+	return kvs
+}
+
+func TestGetAtVersion(t *testing.T) {
+	store := newTestStore(t)
+
+	testCases := []struct {
+		storeKey string
+		key      string
+		version  int64
+		expValue []byte
+	}{
+		{"bank", "a", 1, []byte("1")},
+		{"bank", "a", 2, []byte("2")},
+		{"bank", "a", 10, []byte("2")},
+		{"bank", "b", 1, []byte("1")},
+		{"bank", "b", 2, nil},
+		{"bank", "b", 3, nil},
+		{"bank", "b", 4, []byte("4")},
+		{"bank", "c", 3, nil},
+		{"bank", "c\x00", 4, []byte("1")},
+		{"evm", "a", 4, []byte("evm")},
+		{"evm", "b", 4, nil},
+		{"bankevm", "a", 4, nil},
+	}
+
+	for _, tc := range testCases {
+		value, err := store.GetAtVersion(tc.storeKey, []byte(tc.key), tc.version)
+		require.NoError(t, err)
+		require.Equal(t, tc.expValue, value, "%s/%q at %d", tc.storeKey, tc.key, tc.version)
+	}
+}
+
+func TestIteratorAtVersion(t *testing.T) {
+	store := newTestStore(t)
+
+	testCases := []struct {
+		name      string
+		start     string
+		end       string
+		version   int64
+		ascending bool
+		expKVs    []string
+	}{
+		{"version 1", "", "", 1, true, []string{"a=1", "b=1", "c\x00=1"}},
+		{"version 2 skips deletions", "", "", 2, true, []string{"a=2", "c\x00=1"}},
+		{"version 4", "", "", 4, true, []string{"a=2", "b=4", "c=4", "c\x00=1"}},
+		{"version 4 reverse", "", "", 4, false, []string{"c\x00=1", "c=4", "b=4", "a=2"}},
+		{"domain", "b", "c\x00", 4, true, []string{"b=4", "c=4"}},
+		{"domain reverse", "b", "c\x00", 4, false, []string{"c=4", "b=4"}},
+		{"before the first version", "", "", 0, true, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var start, end []byte
+			if tc.start != "" {
+				start = []byte(tc.start)
+			}
+			if tc.end != "" {
+				end = []byte(tc.end)
+			}
+
+			it, err := store.IteratorAtVersion("bank", start, end, tc.version, tc.ascending)
+			require.NoError(t, err)
+			require.Equal(t, tc.expKVs, collect(it))
+		})
+	}
+}
+
+func TestVersions(t *testing.T) {
+	store := newTestStore(t)
+
+	latest, err := store.LatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(4), latest)
+
+	// incomplete until the earliest version is set
+	ok, err := store.HasVersion(2)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, store.SetEarliestVersion(2))
+	for version, expOk := range map[int64]bool{1: false, 2: true, 4: true, 5: false} {
+		ok, err := store.HasVersion(version)
+		require.NoError(t, err)
+		require.Equal(t, expOk, ok, "version %d", version)
+	}
+
+	require.NoError(t, store.SetEarliestVersion(0))
+	ok, err = store.HasVersion(4)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.Error(t, store.PutAtVersion(0, nil))
+}
+
+func TestKVStore(t *testing.T) {
+	store := newTestStore(t)
+	kvStore := NewKVStore(store, "bank", 2)
+
+	require.Equal(t, []byte("2"), kvStore.Get([]byte("a")))
+	require.False(t, kvStore.Has([]byte("b")))
+	require.Panics(t, func() { kvStore.Set([]byte("a"), []byte("3")) })
+
+	// writes to the cache wrapper stay in the cache
+	cache := kvStore.CacheWrap().(storetypes.KVStore)
+	cache.Set([]byte("b"), []byte("cached"))
+	require.Equal(t, []string{"a=2", "b=cached", "c\x00=1"}, collect(cache.Iterator(nil, nil)))
+	require.False(t, kvStore.Has([]byte("b")))
+}
+
+func TestMultiStore(t *testing.T) {
+	key := storetypes.NewKVStoreKey("bank")
+	tkey := storetypes.NewTransientStoreKey("transient_bank")
+
+	parent := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	parent.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	parent.MountStoreWithDB(tkey, storetypes.StoreTypeTransient, nil)
+	require.NoError(t, parent.LoadLatestVersion())
+
+	// the versioned store diverges from the IAVL state to tell them apart
+	versionDB := NewStore(dbm.NewMemDB())
+	for _, value := range []string{"1", "2", "3"} {
+		parent.GetKVStore(key).Set([]byte("a"), []byte(value))
+		version := parent.Commit().Version
+		require.NoError(t, versionDB.PutAtVersion(version, []*storetypes.StoreKVPair{set("bank", "a", "versiondb"+value)}))
+	}
+
+	ms := NewMultiStore(parent, versionDB, map[string]*storetypes.KVStoreKey{"bank": key}, []storetypes.StoreKey{tkey})
+
+	testCases := []struct {
+		name     string
+		earliest int64
+		version  int64
+		expValue string
+	}{
+		{"incomplete store", 0, 1, "1"},
+		{"historical version", 1, 1, "versiondb1"},
+		{"before the earliest version", 2, 1, "1"},
+		{"latest version", 1, 3, "3"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, versionDB.SetEarliestVersion(tc.earliest))
+
+			cms, err := ms.CacheMultiStoreWithVersion(tc.version)
+			require.NoError(t, err)
+			require.Equal(t, []byte(tc.expValue), cms.GetKVStore(key).Get([]byte("a")))
+			require.NotNil(t, cms.GetKVStore(tkey))
+		})
+	}
+}
+
+func TestApplyStoreUpgrades(t *testing.T) {
+	store := newTestStore(t)
+	require.NoError(t, store.ApplyStoreUpgrades(5, &storetypes.StoreUpgrades{
+		Renamed: []storetypes.StoreRename{{OldKey: "bank", NewKey: "bank2"}},
+		Deleted: []string{"evm"},
+	}))
+
+	testCases := []struct {
+		storeKey string
+		version  int64
+		expKVs   []string
+	}{
+		{"bank", 4, []string{"a=2", "b=4", "c=4", "c\x00=1"}},
+		{"bank", 5, nil},
+		{"bank2", 4, nil},
+		{"bank2", 5, []string{"a=2", "b=4", "c=4", "c\x00=1"}},
+		{"evm", 4, []string{"a=evm"}},
+		{"evm", 5, nil},
+	}
+
+	for _, tc := range testCases {
+		it, err := store.IteratorAtVersion(tc.storeKey, nil, nil, tc.version, true)
+		require.NoError(t, err)
+		require.Equal(t, tc.expKVs, collect(it), "store %s at version %d", tc.storeKey, tc.version)
+	}
+}
+
+func TestStreamingServiceStoreUpgrades(t *testing.T) {
+	store := newTestStore(t)
+	service := NewStreamingService(store, log.NewNopLogger())
+	service.SetStoreUpgrades(5, &storetypes.StoreUpgrades{Deleted: []string{"bank"}})
+
+	// the changes of the upgrade block are written after the store upgrades
+	ctx := sdk.NewContext(nil, cmtproto.Header{Height: 5}, false, log.NewNopLogger())
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}, []*storetypes.StoreKVPair{set("bank", "b", "5")}))
+
+	it, err := store.IteratorAtVersion("bank", nil, nil, 5, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"b=5"}, collect(it))
+
+	latest, err := store.LatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), latest)
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package versiondb
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ storetypes.ABCIListener = (*StreamingService)(nil)
+
+// StreamingService writes the changes of each committed block to the versioned store.
+type StreamingService struct {
+	store  *Store
+	logger log.Logger
+
+	// the store upgrades applied by the upgrade store loader at the upgrade height
+	upgradeHeight int64
+	storeUpgrades *storetypes.StoreUpgrades
+}
+
+// NewStreamingService returns a streaming service writing to the versioned store.
+func NewStreamingService(store *Store, logger log.Logger) *StreamingService {
+	return &StreamingService{
+		store:  store,
+		logger: logger.With("module", "versiondb"),
+	}
+}
+
+// Init marks the store as complete from the initial height when the node starts a new
+// chain, lastHeight being the height of the latest committed block.
+func (s *StreamingService) Init(lastHeight int64) error {
+	latest, err := s.store.LatestVersion()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case lastHeight == 0 && latest == 0:
+		return s.store.SetEarliestVersion(1)
+	case latest == 0:
+		s.logger.Info("versiondb is empty, historical queries are served from the IAVL state until it's migrated")
+	case lastHeight > latest:
+		s.logger.Error("versiondb is behind the application state, migrate it again", "latest", latest, "height", lastHeight)
+	}
+	return nil
+}
+
+// SetStoreUpgrades sets the store upgrades applied to the IAVL state at the upgrade height,
+// which are written to the versioned store before the changes of the block.
+func (s *StreamingService) SetStoreUpgrades(height int64, upgrades *storetypes.StoreUpgrades) {
+	s.upgradeHeight = height
+	s.storeUpgrades = upgrades
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (s *StreamingService) ListenFinalizeBlock(_ context.Context, _ abci.RequestFinalizeBlock, _ abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener. The changes are written at the height of
+// the block, and a missing version marks the store as incomplete.
+// The deleted and renamed stores don't emit any change, so the store upgrades are written
+// at the upgrade height.
+func (s *StreamingService) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	version := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	latest, err := s.store.LatestVersion()
+	if err != nil {
+		return err
+	}
+
+	if latest != 0 && version > latest+1 {
+		s.logger.Error("versiondb is missing versions, migrate it again", "latest", latest, "version", version)
+		if err := s.store.SetEarliestVersion(0); err != nil {
+			return err
+		}
+	}
+
+	if s.storeUpgrades != nil && version == s.upgradeHeight && latest != 0 {
+		if err := s.store.ApplyStoreUpgrades(version, s.storeUpgrades); err != nil {
+			return err
+		}
+	}
+
+	return s.store.PutAtVersion(version, changeSet)
+}
@@ -19,9 +19,11 @@ package accounts
 
 import (
 	"crypto/ecdsa"
+	"math/big"
 
 	gethaccounts "github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 )
 
@@ -74,6 +76,11 @@ type Wallet interface {
 	// to the wallet's tracked account list.
 	Derive(path gethaccounts.DerivationPath, pin bool) (Account, error)
 
+	// SignTx requests the wallet to sign the given transaction for the chain ID.
+	// It returns the [R||S||V] signature, with V as 0 or 1, to be set on the
+	// transaction with the signer of the chain.
+	SignTx(account Account, tx *types.Transaction, chainID *big.Int) ([]byte, error)
+
 	// SignTypedData signs a TypedData object using EIP-712 encoding
 	SignTypedData(account Account, typedData apitypes.TypedData) ([]byte, error)
 }
@@ -0,0 +1,133 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package signer
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+var _ Signer = (*ExternalSigner)(nil)
+
+// ExternalSigner is the Signer forwarding the signing requests to an external
+// signer implementing the Clef account_* JSON-RPC API, over HTTP or IPC. The
+// external signer is responsible for the approval of the requests.
+type ExternalSigner struct {
+	client *rpc.Client
+}
+
+// signTransactionResult is the result of the account_signTransaction method.
+type signTransactionResult struct {
+	Raw hexutil.Bytes         `json:"raw"`
+	Tx  *ethtypes.Transaction `json:"tx"`
+}
+
+// NewExternalSigner creates a new ExternalSigner for the HTTP endpoint or IPC
+// path of the external signer.
+func NewExternalSigner(endpoint string) (*ExternalSigner, error) {
+	client, err := rpc.Dial(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the external signer: %w", err)
+	}
+
+	return &ExternalSigner{
+		client: client,
+	}, nil
+}
+
+// Accounts implements Signer, calling account_list.
+func (s *ExternalSigner) Accounts() ([]common.Address, error) {
+	var addresses []common.Address
+	if err := s.client.Call(&addresses, "account_list"); err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+// SignTx implements Signer, calling account_signTransaction.
+func (s *ExternalSigner) SignTx(address common.Address, tx *ethtypes.Transaction, chainID *big.Int) (*ethtypes.Transaction, error) {
+	data := hexutil.Bytes(tx.Data())
+	var to *common.MixedcaseAddress
+	if tx.To() != nil {
+		t := common.NewMixedcaseAddress(*tx.To())
+		to = &t
+	}
+
+	args := &apitypes.SendTxArgs{
+		From:    common.NewMixedcaseAddress(address),
+		To:      to,
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   hexutil.Big(*tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    &data,
+		ChainID: (*hexutil.Big)(chainID),
+	}
+
+	switch tx.Type() {
+	case ethtypes.LegacyTxType, ethtypes.AccessListTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case ethtypes.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	default:
+		return nil, fmt.Errorf("unsupported tx type %d", tx.Type())
+	}
+
+	if tx.Type() != ethtypes.LegacyTxType {
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	}
+
+	var res signTransactionResult
+	if err := s.client.Call(&res, "account_signTransaction", args); err != nil {
+		return nil, err
+	}
+	if res.Tx == nil {
+		return nil, fmt.Errorf("external signer returned no transaction")
+	}
+	return res.Tx, nil
+}
+
+// SignData implements Signer, calling account_signData with the text/plain
+// content type. The external signer signs the EIP-191 personal message hash
+// of the data.
+func (s *ExternalSigner) SignData(address common.Address, data []byte) ([]byte, error) {
+	var signature hexutil.Bytes
+	if err := s.client.Call(
+		&signature, "account_signData",
+		accounts.MimetypeTextPlain, address, hexutil.Encode(data),
+	); err != nil {
+		return nil, err
+	}
+	return signature, nil
+}
+
+// SignTypedData implements Signer, calling account_signTypedData.
+func (s *ExternalSigner) SignTypedData(address common.Address, typedData apitypes.TypedData) ([]byte, error) {
+	var signature hexutil.Bytes
+	if err := s.client.Call(&signature, "account_signTypedData", address, typedData); err != nil {
+		return nil, err
+	}
+	return signature, nil
+}
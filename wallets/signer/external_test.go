@@ -0,0 +1,157 @@
+package signer
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+)
+
+var errDenied = errors.New("request denied")
+
+// clefAPI implements the account_* methods of Clef used by ExternalSigner,
+// approving all the requests unless deny is set.
+type clefAPI struct {
+	key  *ecdsa.PrivateKey
+	deny bool
+}
+
+func (api *clefAPI) List() []common.Address {
+	return []common.Address{crypto.PubkeyToAddress(api.key.PublicKey)}
+}
+
+func (api *clefAPI) SignTransaction(args apitypes.SendTxArgs) (*signTransactionResult, error) {
+	if api.deny {
+		return nil, errDenied
+	}
+
+	tx, err := ethtypes.SignTx(args.ToTransaction(), ethtypes.LatestSignerForChainID((*big.Int)(args.ChainID)), api.key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &signTransactionResult{Raw: raw, Tx: tx}, nil
+}
+
+func (api *clefAPI) SignData(contentType string, _ common.MixedcaseAddress, data hexutil.Bytes) (hexutil.Bytes, error) {
+	if api.deny || contentType != accounts.MimetypeTextPlain {
+		return nil, errDenied
+	}
+	return api.sign(accounts.TextHash(data))
+}
+
+func (api *clefAPI) SignTypedData(_ common.MixedcaseAddress, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	if api.deny {
+		return nil, errDenied
+	}
+
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return api.sign(sigHash)
+}
+
+func (api *clefAPI) sign(hash []byte) (hexutil.Bytes, error) {
+	signature, err := crypto.Sign(hash, api.key)
+	if err != nil {
+		return nil, err
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+func setupExternalSigner(t *testing.T, api *clefAPI) *ExternalSigner {
+	t.Helper()
+
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("account", api))
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	t.Cleanup(server.Stop)
+
+	signer, err := NewExternalSigner(httpServer.URL)
+	require.NoError(t, err)
+	return signer
+}
+
+func TestExternalSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	chainID := big.NewInt(9000)
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}},
+			"Mail":         {{Name: "contents", Type: "string"}},
+		},
+		PrimaryType: "Mail",
+		Domain:      apitypes.TypedDataDomain{Name: "test"},
+		Message:     apitypes.TypedDataMessage{"contents": "hello"},
+	}
+
+	for _, deny := range []bool{false, true} {
+		signer := setupExternalSigner(t, &clefAPI{key: key, deny: deny})
+
+		addresses, err := signer.Accounts()
+		require.NoError(t, err)
+		require.Equal(t, []common.Address{addr}, addresses)
+
+		for _, tx := range []*ethtypes.Transaction{
+			ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1, To: &to, Gas: 21000, GasPrice: big.NewInt(10), Value: big.NewInt(1)}),
+			ethtypes.NewTx(&ethtypes.DynamicFeeTx{ChainID: chainID, Nonce: 1, To: &to, Gas: 21000, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10)}),
+		} {
+			signed, err := signer.SignTx(addr, tx, chainID)
+			if deny {
+				require.ErrorContains(t, err, errDenied.Error())
+				continue
+			}
+			require.NoError(t, err)
+
+			sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(chainID), signed)
+			require.NoError(t, err)
+			require.Equal(t, addr, sender)
+			require.Equal(t, tx.Type(), signed.Type())
+			require.Equal(t, tx.Nonce(), signed.Nonce())
+		}
+
+		signature, err := signer.SignData(addr, []byte("data"))
+		if deny {
+			require.ErrorContains(t, err, errDenied.Error())
+		} else {
+			require.NoError(t, err)
+			signature[crypto.RecoveryIDOffset] -= 27
+			pubKey, err := crypto.SigToPub(accounts.TextHash([]byte("data")), signature)
+			require.NoError(t, err)
+			require.Equal(t, addr, crypto.PubkeyToAddress(*pubKey))
+		}
+
+		signature, err = signer.SignTypedData(addr, typedData)
+		if deny {
+			require.ErrorContains(t, err, errDenied.Error())
+		} else {
+			require.NoError(t, err)
+			sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+			require.NoError(t, err)
+			signature[crypto.RecoveryIDOffset] -= 27
+			pubKey, err := crypto.SigToPub(sigHash, signature)
+			require.NoError(t, err)
+			require.Equal(t, addr, crypto.PubkeyToAddress(*pubKey))
+		}
+	}
+}
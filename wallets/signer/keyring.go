@@ -0,0 +1,168 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package signer
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// keyringFileDirName is the directory of the file keyring backend, holding
+	// the bcrypt hash of the keyring passphrase in the keyhash file.
+	keyringFileDirName = "keyring-file"
+	// testKeyringPassphrase is the fixed passphrase of the test keyring backend.
+	testKeyringPassphrase = "test"
+)
+
+var (
+	_ Signer          = KeyringSigner{}
+	_ PasswordChecker = KeyringSigner{}
+)
+
+// KeyringSigner is the Signer with the keys of the node's keyring.
+type KeyringSigner struct {
+	kr  keyring.Keyring
+	dir string
+}
+
+// NewKeyringSigner creates a new KeyringSigner with the keyring of the
+// directory.
+func NewKeyringSigner(kr keyring.Keyring, dir string) KeyringSigner {
+	return KeyringSigner{
+		kr:  kr,
+		dir: dir,
+	}
+}
+
+// Accounts implements Signer. It returns the addresses of the keyring keys.
+func (s KeyringSigner) Accounts() ([]common.Address, error) {
+	addresses := make([]common.Address, 0) // return [] instead of nil if empty
+
+	infos, err := s.kr.List()
+	if err != nil {
+		return addresses, err
+	}
+
+	for _, info := range infos {
+		pubKey, err := info.GetPubKey()
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, common.BytesToAddress(pubKey.Address().Bytes()))
+	}
+
+	return addresses, nil
+}
+
+// SignTx implements Signer. It signs the transaction with the latest signer of
+// the chain ID.
+func (s KeyringSigner) SignTx(address common.Address, tx *ethtypes.Transaction, chainID *big.Int) (*ethtypes.Transaction, error) {
+	ethSigner := ethtypes.LatestSignerForChainID(chainID)
+
+	signature, err := s.sign(address, ethSigner.Hash(tx).Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.WithSignature(ethSigner, signature)
+}
+
+// SignData implements Signer. It signs the EIP-191 personal message hash of
+// the data, like the external signer.
+func (s KeyringSigner) SignData(address common.Address, data []byte) ([]byte, error) {
+	signature, err := s.sign(address, accounts.TextHash(data))
+	if err != nil {
+		return nil, err
+	}
+
+	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
+	return signature, nil
+}
+
+// SignTypedData implements Signer.
+func (s KeyringSigner) SignTypedData(address common.Address, typedData apitypes.TypedData) ([]byte, error) {
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := s.sign(address, sigHash)
+	if err != nil {
+		return nil, err
+	}
+
+	signature[crypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
+	return signature, nil
+}
+
+// CheckPassword implements PasswordChecker. The keys of the keyring share the
+// keyring passphrase: the one whose hash is stored by the file backend, or the
+// fixed passphrase of the test backend. The memory backend has no passphrase,
+// and the passphrase of the backends of the OS can't be verified.
+func (s KeyringSigner) CheckPassword(address common.Address, password string) error {
+	if _, err := s.kr.KeyByAddress(sdk.AccAddress(address.Bytes())); err != nil {
+		return ErrUnknownAccount
+	}
+
+	switch backend := s.kr.Backend(); backend {
+	case keyring.BackendFile:
+		keyhash, err := os.ReadFile(filepath.Join(s.dir, keyringFileDirName, "keyhash"))
+		if err != nil {
+			return fmt.Errorf("failed to read the keyring passphrase hash: %w", err)
+		}
+		if err := bcrypt.CompareHashAndPassword(keyhash, []byte(password)); err != nil {
+			return keystore.ErrDecrypt
+		}
+	case keyring.BackendTest:
+		if password != testKeyringPassphrase {
+			return keystore.ErrDecrypt
+		}
+	case keyring.BackendMemory:
+		if password != "" {
+			return keystore.ErrDecrypt
+		}
+	default:
+		return fmt.Errorf("the passphrase of the %s keyring backend can't be verified, unlocking is not supported", backend)
+	}
+	return nil
+}
+
+// sign signs the bytes with the keyring key of the address.
+func (s KeyringSigner) sign(address common.Address, bz []byte) ([]byte, error) {
+	from := sdk.AccAddress(address.Bytes())
+
+	if _, err := s.kr.KeyByAddress(from); err != nil {
+		return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
+	}
+
+	signature, _, err := s.kr.SignByAddress(from, bz, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+	return signature, err
+}
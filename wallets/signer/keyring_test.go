@@ -0,0 +1,87 @@
+package signer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/crypto/hd"
+	"github.com/hetu-project/hetu/v1/encoding"
+)
+
+// newKeyring returns a keyring of the backend holding a single new key, and
+// its address.
+func newKeyring(t *testing.T, backend, dir, input string) (keyring.Keyring, common.Address) {
+	encCfg := encoding.MakeConfig()
+	kr, err := keyring.New(sdk.KeyringServiceName(), backend, dir, strings.NewReader(input), encCfg.Codec, hd.EthSecp256k1Option())
+	require.NoError(t, err)
+
+	record, _, err := kr.NewMnemonic("key", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+	return kr, common.BytesToAddress(addr)
+}
+
+func TestKeyringSignerCheckPassword(t *testing.T) {
+	testCases := []struct {
+		name     string
+		backend  string
+		input    string
+		password string
+		expErr   bool
+	}{
+		{"test backend - passphrase", keyring.BackendTest, "", "test", false},
+		{"test backend - wrong password", keyring.BackendTest, "", "", true},
+		{"file backend - passphrase", keyring.BackendFile, "passphrase\npassphrase\n", "passphrase", false},
+		{"file backend - wrong password", keyring.BackendFile, "passphrase\npassphrase\n", "wrong", true},
+		{"memory backend - no passphrase", keyring.BackendMemory, "", "", false},
+		{"memory backend - wrong password", keyring.BackendMemory, "", "test", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			kr, addr := newKeyring(t, tc.backend, dir, tc.input)
+			s := NewKeyringSigner(kr, dir)
+
+			err := s.CheckPassword(addr, tc.password)
+			if tc.expErr {
+				require.ErrorIs(t, err, keystore.ErrDecrypt)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("unknown account", func(t *testing.T) {
+		dir := t.TempDir()
+		kr, _ := newKeyring(t, keyring.BackendTest, dir, "")
+		err := NewKeyringSigner(kr, dir).CheckPassword(common.HexToAddress("0x1"), "test")
+		require.ErrorIs(t, err, ErrUnknownAccount)
+	})
+}
+
+func TestKeyringSignerSignData(t *testing.T) {
+	dir := t.TempDir()
+	kr, addr := newKeyring(t, keyring.BackendTest, dir, "")
+	s := NewKeyringSigner(kr, dir)
+
+	data := []byte("data")
+	signature, err := s.SignData(addr, data)
+	require.NoError(t, err)
+
+	// the signature recovers with the EIP-191 personal message hash, like the
+	// signatures of the external signer
+	signature[crypto.RecoveryIDOffset] -= 27
+	pubKey, err := crypto.SigToPub(accounts.TextHash(data), signature)
+	require.NoError(t, err)
+	require.Equal(t, addr, crypto.PubkeyToAddress(*pubKey))
+}
@@ -0,0 +1,134 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package signer
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+var (
+	_ Signer   = (*LockedSigner)(nil)
+	_ Unlocker = (*LockedSigner)(nil)
+)
+
+// LockedSigner wraps a Signer to only sign with the accounts unlocked with
+// Unlock, until they are locked or the unlock expires.
+type LockedSigner struct {
+	Signer
+
+	mu       sync.Mutex
+	unlocked map[common.Address]time.Time // unlock expiry, zero if none
+	now      func() time.Time
+}
+
+// NewLockedSigner creates a new LockedSigner with all the accounts locked.
+func NewLockedSigner(signer Signer) *LockedSigner {
+	return &LockedSigner{
+		Signer:   signer,
+		unlocked: make(map[common.Address]time.Time),
+		now:      time.Now,
+	}
+}
+
+// Unlock implements Unlocker. It fails if the wrapped signer doesn't hold the
+// account, or rejects the password. The password is not checked if the wrapped
+// signer doesn't implement PasswordChecker, as it approves the signing
+// requests itself.
+func (s *LockedSigner) Unlock(address common.Address, password string, duration time.Duration) error {
+	addresses, err := s.Signer.Accounts()
+	if err != nil {
+		return err
+	}
+	if !contains(addresses, address) {
+		return ErrUnknownAccount
+	}
+
+	if checker, ok := s.Signer.(PasswordChecker); ok {
+		if err := checker.CheckPassword(address, password); err != nil {
+			return err
+		}
+	}
+
+	var expiry time.Time
+	if duration > 0 {
+		expiry = s.now().Add(duration)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.unlocked[address] = expiry
+	return nil
+}
+
+// Lock implements Unlocker.
+func (s *LockedSigner) Lock(address common.Address) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, unlocked := s.unlocked[address]
+	delete(s.unlocked, address)
+	return unlocked
+}
+
+// SignTx implements Signer.
+func (s *LockedSigner) SignTx(address common.Address, tx *ethtypes.Transaction, chainID *big.Int) (*ethtypes.Transaction, error) {
+	if err := s.checkUnlocked(address); err != nil {
+		return nil, err
+	}
+	return s.Signer.SignTx(address, tx, chainID)
+}
+
+// SignData implements Signer.
+func (s *LockedSigner) SignData(address common.Address, data []byte) ([]byte, error) {
+	if err := s.checkUnlocked(address); err != nil {
+		return nil, err
+	}
+	return s.Signer.SignData(address, data)
+}
+
+// SignTypedData implements Signer.
+func (s *LockedSigner) SignTypedData(address common.Address, typedData apitypes.TypedData) ([]byte, error) {
+	if err := s.checkUnlocked(address); err != nil {
+		return nil, err
+	}
+	return s.Signer.SignTypedData(address, typedData)
+}
+
+// checkUnlocked returns ErrLocked if the account of the address is not
+// unlocked, locking it if its unlock expired.
+func (s *LockedSigner) checkUnlocked(address common.Address) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expiry, unlocked := s.unlocked[address]
+	if !unlocked {
+		return ErrLocked
+	}
+
+	if !expiry.IsZero() && !s.now().Before(expiry) {
+		delete(s.unlocked, address)
+		return ErrLocked
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package signer
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSigner is a Signer holding a fixed set of accounts, returning empty
+// signatures.
+type mockSigner []common.Address
+
+func (s mockSigner) Accounts() ([]common.Address, error) { return s, nil }
+
+func (s mockSigner) SignTx(_ common.Address, tx *ethtypes.Transaction, _ *big.Int) (*ethtypes.Transaction, error) {
+	return tx, nil
+}
+
+func (s mockSigner) SignData(common.Address, []byte) ([]byte, error) { return []byte{}, nil }
+
+func (s mockSigner) SignTypedData(common.Address, apitypes.TypedData) ([]byte, error) {
+	return []byte{}, nil
+}
+
+// passwordSigner is a mockSigner whose accounts have a single password.
+type passwordSigner struct {
+	mockSigner
+	password string
+}
+
+func (s passwordSigner) CheckPassword(_ common.Address, password string) error {
+	if password != s.password {
+		return keystore.ErrDecrypt
+	}
+	return nil
+}
+
+func TestLockedSignerPassword(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	s := NewLockedSigner(passwordSigner{mockSigner{addr}, "secret"})
+
+	require.ErrorIs(t, s.Unlock(addr, "wrong", 0), keystore.ErrDecrypt)
+	_, err := s.SignData(addr, []byte("data"))
+	require.ErrorIs(t, err, ErrLocked)
+
+	require.NoError(t, s.Unlock(addr, "secret", 0))
+	_, err = s.SignData(addr, []byte("data"))
+	require.NoError(t, err)
+}
+
+func TestLockedSigner(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	now := time.Unix(1_700_000_000, 0)
+
+	testCases := []struct {
+		name     string
+		unlock   func(s *LockedSigner) error
+		elapsed  time.Duration
+		expErr   error
+		expSigns bool
+	}{
+		{
+			"locked by default",
+			func(*LockedSigner) error { return nil },
+			0,
+			nil,
+			false,
+		},
+		{
+			"fail - unknown account",
+			func(s *LockedSigner) error {
+				return s.Unlock(common.HexToAddress("0x2"), "", time.Minute)
+			},
+			0,
+			ErrUnknownAccount,
+			false,
+		},
+		{
+			"unlocked until the expiry",
+			func(s *LockedSigner) error { return s.Unlock(addr, "", time.Minute) },
+			time.Minute - time.Second,
+			nil,
+			true,
+		},
+		{
+			"locked after the expiry",
+			func(s *LockedSigner) error { return s.Unlock(addr, "", time.Minute) },
+			time.Minute,
+			nil,
+			false,
+		},
+		{
+			"unlocked without expiry",
+			func(s *LockedSigner) error { return s.Unlock(addr, "", 0) },
+			24 * time.Hour,
+			nil,
+			true,
+		},
+		{
+			"locked after lock",
+			func(s *LockedSigner) error {
+				if err := s.Unlock(addr, "", 0); err != nil {
+					return err
+				}
+				require.True(t, s.Lock(addr))
+				return nil
+			},
+			0,
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := NewLockedSigner(mockSigner{addr})
+			s.now = func() time.Time { return now }
+
+			err := tc.unlock(s)
+			require.ErrorIs(t, err, tc.expErr)
+
+			s.now = func() time.Time { return now.Add(tc.elapsed) }
+
+			_, errTx := s.SignTx(addr, ethtypes.NewTx(&ethtypes.LegacyTx{}), big.NewInt(1))
+			_, errData := s.SignData(addr, []byte("data"))
+			_, errTypedData := s.SignTypedData(addr, apitypes.TypedData{})
+			for _, err := range []error{errTx, errData, errTypedData} {
+				if tc.expSigns {
+					require.NoError(t, err)
+				} else {
+					require.ErrorIs(t, err, ErrLocked)
+				}
+			}
+			require.Equal(t, tc.expSigns, s.Lock(addr))
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package signer
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// KeyringSignerName is the json-rpc.signer config value selecting the
+// signer with the keys of the node's keyring.
+const KeyringSignerName = "keyring"
+
+var (
+	// ErrDisabled is returned by the signing methods when no signer is configured.
+	ErrDisabled = errors.New("signing is disabled; no signer is configured on the node")
+	// ErrLocked is returned when signing with an account that is not unlocked.
+	ErrLocked = errors.New("account is locked; unlock it with personal_unlockAccount")
+	// ErrUnknownAccount is returned when the signer doesn't hold the account.
+	ErrUnknownAccount = errors.New("unknown account")
+)
+
+// Signer signs the transactions and data of the JSON-RPC signing methods
+// (eth_sendTransaction, eth_sign, eth_signTypedData and their personal
+// variants) with the keys it holds.
+type Signer interface {
+	// Accounts returns the addresses of the accounts the signer holds.
+	Accounts() ([]common.Address, error)
+
+	// SignTx signs the Ethereum transaction for the chain ID with the key of
+	// the address and returns the signed transaction.
+	SignTx(address common.Address, tx *ethtypes.Transaction, chainID *big.Int) (*ethtypes.Transaction, error)
+
+	// SignData signs the eth_sign data with the key of the address. The V value
+	// of the signature is 27 or 28.
+	SignData(address common.Address, data []byte) ([]byte, error)
+
+	// SignTypedData signs the EIP-712 typed data with the key of the address.
+	// The V value of the signature is 27 or 28.
+	SignTypedData(address common.Address, typedData apitypes.TypedData) ([]byte, error)
+}
+
+// Unlocker is implemented by the signers that only sign with the accounts
+// explicitly unlocked for a limited time.
+type Unlocker interface {
+	// Unlock unlocks the account of the address for the duration, if the
+	// password is the one of the account. A zero duration unlocks the account
+	// until it is locked.
+	Unlock(address common.Address, password string, duration time.Duration) error
+
+	// Lock locks the account of the address. It returns false if the account
+	// was not unlocked.
+	Lock(address common.Address) bool
+}
+
+// PasswordChecker is implemented by the signers verifying the password of an
+// account before it is unlocked.
+type PasswordChecker interface {
+	// CheckPassword returns keystore.ErrDecrypt if the password is not the
+	// one of the account of the address.
+	CheckPassword(address common.Address, password string) error
+}
+
+// New returns the signer for the json-rpc.signer config value: the keyring
+// signer for KeyringSignerName, or the external signer at the endpoint
+// otherwise. The signer only signs with unlocked accounts. It returns nil if
+// the value is empty, as signing is disabled. The keyring directory holds the
+// passphrase hash of the file keyring backend.
+func New(endpoint string, kr keyring.Keyring, keyringDir string) (Signer, error) {
+	var (
+		signer Signer
+		err    error
+	)
+
+	switch endpoint {
+	case "":
+		return nil, nil
+	case KeyringSignerName:
+		signer = NewKeyringSigner(kr, keyringDir)
+	default:
+		if signer, err = NewExternalSigner(endpoint); err != nil {
+			return nil, err
+		}
+	}
+
+	return NewLockedSigner(signer), nil
+}
+
+// contains returns whether the account of the address is in the addresses.
+func contains(addresses []common.Address, address common.Address) bool {
+	for _, addr := range addresses {
+		if addr == address {
+			return true
+		}
+	}
+	return false
+}
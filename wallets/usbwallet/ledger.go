@@ -27,10 +27,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 
 	gethaccounts "github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 // ledgerOpcode is an enumeration encoding the supported Ledger opcodes.
@@ -46,10 +49,13 @@ type ledgerParam2 byte
 
 const (
 	ledgerOpRetrieveAddress  ledgerOpcode = 0x02 // Returns the public key and Ethereum address for a given BIP 32 path
+	ledgerOpSignTransaction  ledgerOpcode = 0x04 // Signs an Ethereum transaction after having the user validate the parameters
 	ledgerOpGetConfiguration ledgerOpcode = 0x06 // Returns specific wallet application configuration
 	ledgerOpSignTypedMessage ledgerOpcode = 0x0c // Signs an Ethereum message following the EIP 712 specification
 
 	ledgerP1DirectlyFetchAddress    ledgerParam1 = 0x00 // Return address directly from the wallet
+	ledgerP1InitTransactionData     ledgerParam1 = 0x00 // First transaction data block for signing
+	ledgerP1ContTransactionData     ledgerParam1 = 0x80 // Subsequent transaction data block for signing
 	ledgerP1InitTypedMessageData    ledgerParam1 = 0x00 // First chunk of Typed Message data
 	ledgerP2DiscardAddressChainCode ledgerParam2 = 0x00 // Do not return the chain code along with the address
 )
@@ -145,6 +151,22 @@ func (w *ledgerDriver) Derive(path gethaccounts.DerivationPath) (common.Address,
 	return w.ledgerDerive(path)
 }
 
+// SignTx implements usbwallet.driver, sending the transaction to the Ledger and
+// waiting for the user to confirm or deny the transaction.
+func (w *ledgerDriver) SignTx(path gethaccounts.DerivationPath, tx *types.Transaction, chainID *big.Int) ([]byte, error) {
+	// If the Ethereum app doesn't run, abort
+	if w.offline() {
+		return nil, gethaccounts.ErrWalletClosed
+	}
+	// Ensure the wallet is capable of signing the given transaction
+	if w.version[0] <= 1 && w.version[1] <= 0 && w.version[2] <= 2 {
+		//nolint:stylecheck // ST1005 requires error strings to be lowercase but Ledger as a brand name should start with a capital letter
+		return nil, fmt.Errorf("Ledger v%d.%d.%d doesn't support signing this transaction, please update to v1.0.3 at least", w.version[0], w.version[1], w.version[2])
+	}
+	// All infos gathered and metadata checks out, request signing
+	return w.ledgerSign(path, tx, chainID)
+}
+
 // SignTypedMessage implements usbwallet.driver, sending the message to the Ledger and
 // waiting for the user to sign or deny the transaction.
 //
@@ -350,6 +372,125 @@ func (w *ledgerDriver) ledgerSignTypedMessage(derivationPath gethaccounts.Deriva
 	return signature, nil
 }
 
+// ledgerSign sends the transaction to the Ledger wallet, and waits for the user
+// to confirm or deny the transaction.
+//
+// The transaction signing protocol is defined as follows:
+//
+//	CLA | INS | P1 | P2 | Lc  | Le
+//	----+-----+----+----+-----+---
+//	 E0 | 04  | 00: first transaction data block
+//	            80: subsequent transaction data block
+//	               | 00 | variable | variable
+//
+// Where the input for the first transaction block (first 255 bytes) is:
+//
+//	Description                                      | Length
+//	-------------------------------------------------+----------
+//	Number of BIP 32 derivations to perform (max 10) | 1 byte
+//	First derivation index (big endian)              | 4 bytes
+//	...                                              | 4 bytes
+//	Last derivation index (big endian)               | 4 bytes
+//	Unsigned transaction payload                     | arbitrary
+//
+// And the input for subsequent transaction blocks (first 255 bytes) are:
+//
+//	Description                    | Length
+//	-------------------------------+----------
+//	Unsigned transaction payload   | arbitrary
+//
+// The unsigned transaction payload is the EIP-155 RLP list of legacy
+// transactions, or the EIP-2718 type byte followed by the RLP list of typed
+// transactions. The output data is:
+//
+//	Description | Length
+//	------------+---------
+//	signature V | 1 byte
+//	signature R | 32 bytes
+//	signature S | 32 bytes
+//
+// The returned signature is [R||S||V] with V as the 0 or 1 recovery ID.
+func (w *ledgerDriver) ledgerSign(derivationPath gethaccounts.DerivationPath, tx *types.Transaction, chainID *big.Int) ([]byte, error) {
+	// Flatten the derivation path into the Ledger request
+	path := make([]byte, 1+4*len(derivationPath))
+	path[0] = byte(len(derivationPath))
+	for i, component := range derivationPath {
+		binary.BigEndian.PutUint32(path[1+4*i:], component)
+	}
+	// Create the unsigned transaction payload, matching the signer hash preimage
+	txPayload, err := unsignedTxPayload(tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	payload := append(path, txPayload...)
+
+	// Send the request and wait for the response
+	var (
+		op    = ledgerP1InitTransactionData
+		reply []byte
+	)
+	for len(payload) > 0 {
+		// Calculate the size of the next data chunk
+		chunk := 255
+		if chunk > len(payload) {
+			chunk = len(payload)
+		}
+		// Send the chunk over, ensuring it's processed correctly
+		reply, err = w.ledgerExchange(ledgerOpSignTransaction, op, 0, payload[:chunk])
+		if err != nil {
+			return nil, err
+		}
+		// Shift the payload and ensure subsequent chunks are marked as such
+		payload = payload[chunk:]
+		op = ledgerP1ContTransactionData
+	}
+	// Extract the Ethereum signature and do a sanity validation
+	if len(reply) != crypto.SignatureLength {
+		return nil, errors.New("reply lacks signature")
+	}
+
+	var signature []byte
+	signature = append(signature, reply[1:]...)
+	signature = append(signature, reply[0])
+
+	// The device only returns the lowest byte of the EIP-155 V value of legacy
+	// transactions, which is enough to get the recovery ID back
+	if tx.Type() == types.LegacyTxType {
+		signature[crypto.RecoveryIDOffset] -= byte(chainID.Uint64()*2 + 35)
+	}
+	if signature[crypto.RecoveryIDOffset] > 1 {
+		return nil, fmt.Errorf("invalid signature recovery ID %d", signature[crypto.RecoveryIDOffset])
+	}
+
+	return signature, nil
+}
+
+// unsignedTxPayload returns the unsigned transaction payload the Ledger
+// Ethereum app expects, which is the preimage of the transaction signer hash.
+func unsignedTxPayload(tx *types.Transaction, chainID *big.Int) ([]byte, error) {
+	switch tx.Type() {
+	case types.LegacyTxType:
+		return rlp.EncodeToBytes([]interface{}{
+			tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(),
+			chainID, uint(0), uint(0),
+		})
+	case types.AccessListTxType:
+		payload, err := rlp.EncodeToBytes([]interface{}{
+			chainID, tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(),
+			tx.AccessList(),
+		})
+		return append([]byte{tx.Type()}, payload...), err
+	case types.DynamicFeeTxType:
+		payload, err := rlp.EncodeToBytes([]interface{}{
+			chainID, tx.Nonce(), tx.GasTipCap(), tx.GasFeeCap(), tx.Gas(), tx.To(), tx.Value(), tx.Data(),
+			tx.AccessList(),
+		})
+		return append([]byte{tx.Type()}, payload...), err
+	default:
+		return nil, types.ErrTxTypeNotSupported
+	}
+}
+
 // ledgerExchange performs a data exchange with the Ledger wallet, sending it a
 // message and retrieving the response.
 //
@@ -23,11 +23,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"sync"
 	"time"
 
 	gethaccounts "github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/hetu-project/hetu/v1/wallets/accounts"
@@ -60,6 +62,10 @@ type driver interface {
 	// address located on that path.
 	Derive(path gethaccounts.DerivationPath) (common.Address, *ecdsa.PublicKey, error)
 
+	// SignTx sends the transaction to the USB device and waits for the user to confirm
+	// or deny the transaction. It returns the [R||S||V] signature, with V as 0 or 1.
+	SignTx(path gethaccounts.DerivationPath, tx *types.Transaction, chainID *big.Int) ([]byte, error)
+
 	// SignTypedMessage sends the message to the Ledger and waits for the user to sign
 	// or deny the transaction.
 	SignTypedMessage(path gethaccounts.DerivationPath, messageHash []byte, domainHash []byte) ([]byte, error)
@@ -375,6 +381,57 @@ func (w *wallet) signData(account accounts.Account, mimeType string, data []byte
 	return signature, nil
 }
 
+// SignTx implements accounts.Wallet. It sends the transaction over to the Ledger
+// wallet to request a confirmation from the user. It returns the [R||S||V]
+// signature of the transaction, with V as 0 or 1.
+func (w *wallet) SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) ([]byte, error) {
+	w.stateLock.RLock() // Comms have own mutex, this is for the state fields
+	defer w.stateLock.RUnlock()
+
+	// If the wallet is closed, abort
+	if w.device == nil {
+		return nil, gethaccounts.ErrWalletClosed
+	}
+	// Make sure the requested account is contained within
+	path, ok := w.paths[account.Address]
+	if !ok {
+		return nil, gethaccounts.ErrUnknownAccount
+	}
+	// All infos gathered and metadata checks out, request signing
+	<-w.commsLock
+	defer func() { w.commsLock <- struct{}{} }()
+
+	// Ensure the device isn't screwed with while user confirmation is pending
+	// TODO(karalabe): remove if hotplug lands on Windows
+	w.hub.commsLock.Lock()
+	w.hub.commsPend++
+	w.hub.commsLock.Unlock()
+
+	defer func() {
+		w.hub.commsLock.Lock()
+		w.hub.commsPend--
+		w.hub.commsLock.Unlock()
+	}()
+	// Sign the transaction and verify the sender to avoid hardware fault surprises
+	signature, err := w.driver.SignTx(path, tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	signed, err := tx.WithSignature(types.LatestSignerForChainID(chainID), signature)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+	if err != nil {
+		return nil, err
+	}
+	if sender != account.Address {
+		return nil, fmt.Errorf("signer mismatch: expected %s, got %s", account.Address.Hex(), sender.Hex())
+	}
+	return signature, nil
+}
+
 func (w *wallet) verifyTypedDataSignature(account accounts.Account, rawData []byte, signature []byte) error {
 	if len(signature) != crypto.SignatureLength {
 		return fmt.Errorf("invalid signature length: %d", len(signature))
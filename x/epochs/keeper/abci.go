@@ -57,7 +57,9 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 					sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
 				),
 			)
-			k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+			if err := k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch); err != nil {
+				logger.Error("after epoch end hook failed", "identifier", epochInfo.Identifier, "error", err.Error())
+			}
 		default:
 			// continue
 			return false
@@ -73,7 +75,9 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 			),
 		)
 
-		k.BeforeEpochStart(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+		if err := k.BeforeEpochStart(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch); err != nil {
+			logger.Error("before epoch start hook failed", "identifier", epochInfo.Identifier, "error", err.Error())
+		}
 
 		return false
 	})
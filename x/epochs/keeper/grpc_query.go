@@ -82,3 +82,34 @@ func (k Keeper) CurrentEpoch(
 		CurrentEpoch: info.CurrentEpoch,
 	}, nil
 }
+
+// NextEpochStart provides the earliest start time of the next epoch of
+// specified identifier
+func (k Keeper) NextEpochStart(
+	c context.Context,
+	req *types.QueryNextEpochStartRequest,
+) (*types.QueryNextEpochStartResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	info, found := k.GetEpochInfo(ctx, req.Identifier)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "epoch info not found: %s", req.Identifier)
+	}
+
+	// the initial epoch starts on the first block at or after the start time
+	if !info.EpochCountingStarted {
+		return &types.QueryNextEpochStartResponse{
+			NextEpoch: 1,
+			StartTime: info.StartTime,
+		}, nil
+	}
+
+	return &types.QueryNextEpochStartResponse{
+		NextEpoch: info.CurrentEpoch + 1,
+		StartTime: info.CurrentEpochStartTime.Add(info.Duration),
+	}, nil
+}
@@ -192,3 +192,73 @@ func (suite *KeeperTestSuite) TestCurrentEpoch() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestNextEpochStart() {
+	var (
+		req    *types.QueryNextEpochStartRequest
+		expRes *types.QueryNextEpochStartResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"unknown identifier",
+			func() {
+				req = &types.QueryNextEpochStartRequest{Identifier: "unknown"}
+			},
+			false,
+		},
+		{
+			"epoch counting not started",
+			func() {
+				startTime := suite.ctx.BlockTime().Add(time.Hour)
+				epoch, _ := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, types.WeekEpochID)
+				epoch.StartTime = startTime
+				epoch.EpochCountingStarted = false
+				suite.app.EpochsKeeper.SetEpochInfo(suite.ctx, epoch)
+
+				req = &types.QueryNextEpochStartRequest{Identifier: types.WeekEpochID}
+				expRes = &types.QueryNextEpochStartResponse{
+					NextEpoch: 1,
+					StartTime: startTime,
+				}
+			},
+			true,
+		},
+		{
+			"epoch counting started",
+			func() {
+				epoch, _ := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, types.DayEpochID)
+				epoch.StartInitialEpoch()
+				suite.app.EpochsKeeper.SetEpochInfo(suite.ctx, epoch)
+
+				req = &types.QueryNextEpochStartRequest{Identifier: types.DayEpochID}
+				expRes = &types.QueryNextEpochStartResponse{
+					NextEpoch: 2,
+					StartTime: epoch.StartTime.Add(time.Hour * 24),
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.NextEpochStart(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.NextEpoch, res.NextEpoch)
+				suite.Require().True(expRes.StartTime.Equal(res.StartTime))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -17,13 +17,27 @@
 package keeper
 
 import (
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
 	"github.com/hetu-project/hetu/v1/x/epochs/types"
 )
 
+const (
+	hookAfterEpochEnd    = "after_epoch_end"
+	hookBeforeEpochStart = "before_epoch_start"
+)
+
 var _ types.EpochHooks = MultiEpochHooks{}
 
-// combine multiple epoch hooks, all hook functions are run in array sequence
+// combine multiple epoch hooks, all hook functions are run in array sequence.
+// Each hook is run in isolation, so a failing hook neither halts the chain nor
+// prevents the following hooks from running.
 type MultiEpochHooks []types.EpochHooks
 
 func NewMultiEpochHooks(hooks ...types.EpochHooks) MultiEpochHooks {
@@ -32,26 +46,103 @@ func NewMultiEpochHooks(hooks ...types.EpochHooks) MultiEpochHooks {
 
 // AfterEpochEnd is called when epoch is going to be ended, epochNumber is the
 // number of epoch that is ending
-func (mh MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+func (mh MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	for i := range mh {
-		mh[i].AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+		hook := mh[i]
+		applyHook(ctx, hookAfterEpochEnd, hook, epochIdentifier, epochNumber, func(ctx sdk.Context) error {
+			return hook.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+		})
 	}
+	return nil
 }
 
 // BeforeEpochStart is called when epoch is going to be started, epochNumber is
 // the number of epoch that is starting
-func (mh MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+func (mh MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	for i := range mh {
-		mh[i].BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+		hook := mh[i]
+		applyHook(ctx, hookBeforeEpochStart, hook, epochIdentifier, epochNumber, func(ctx sdk.Context) error {
+			return hook.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+		})
+	}
+	return nil
+}
+
+// applyHook runs fn and, if it fails, logs the error and emits an
+// EventTypeEpochHookFailed event instead of propagating it.
+func applyHook(
+	ctx sdk.Context,
+	hookType string,
+	hook types.EpochHooks,
+	epochIdentifier string,
+	epochNumber int64,
+	fn func(ctx sdk.Context) error,
+) {
+	err := runHook(ctx, fn)
+	if err == nil {
+		return
+	}
+
+	hookName := fmt.Sprintf("%T", hook)
+
+	ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName)).Error(
+		"epoch hook failed",
+		"hook", hookType,
+		"hook-name", hookName,
+		"identifier", epochIdentifier,
+		"epoch-number", epochNumber,
+		"error", err.Error(),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochHookFailed,
+			sdk.NewAttribute(types.AttributeHook, hookType),
+			sdk.NewAttribute(types.AttributeHookName, hookName),
+			sdk.NewAttribute(types.AttributeEpochIdentifier, epochIdentifier),
+			sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochNumber, 10)),
+			sdk.NewAttribute(types.AttributeHookError, err.Error()),
+		),
+	)
+}
+
+// runHook runs fn in a cached context limited to types.HookGasLimit gas. The
+// state changes and events of fn are only committed if it succeeds. Panics,
+// including running out of gas, are recovered and returned as errors.
+func runHook(ctx sdk.Context, fn func(ctx sdk.Context) error) (err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(types.HookGasLimit))
+
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = errorsmod.Wrapf(errortypes.ErrOutOfGas, "out of gas in location: %s; gas limit: %d", oog.Descriptor, types.HookGasLimit)
+				return
+			}
+			err = errorsmod.Wrapf(errortypes.ErrPanic, "%v", r)
+		}
+	}()
+
+	if err := fn(cacheCtx); err != nil {
+		return err
 	}
+
+	writeCache()
+	return nil
 }
 
 // AfterEpochEnd executes the indicated hook after epochs ends
-func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) {
-	k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
 }
 
 // BeforeEpochStart executes the indicated hook before the epochs
-func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) {
-	k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
+func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
 }
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/x/epochs/keeper"
+	"github.com/hetu-project/hetu/v1/x/epochs/types"
+)
+
+var _ types.EpochHooks = mockHook{}
+
+// mockHook writes its key to the store and then behaves as configured
+type mockHook struct {
+	storeKey storetypes.StoreKey
+	key      string
+	err      error
+	panics   bool
+	gas      uint64
+}
+
+func (h mockHook) run(ctx sdk.Context) error {
+	ctx.KVStore(h.storeKey).Set([]byte(h.key), []byte{1})
+	ctx.EventManager().EmitEvent(sdk.NewEvent(h.key))
+	ctx.GasMeter().ConsumeGas(h.gas, "mock hook")
+	if h.panics {
+		panic("mock hook panic")
+	}
+	return h.err
+}
+
+func (h mockHook) AfterEpochEnd(ctx sdk.Context, _ string, _ int64) error {
+	return h.run(ctx)
+}
+
+func (h mockHook) BeforeEpochStart(ctx sdk.Context, _ string, _ int64) error {
+	return h.run(ctx)
+}
+
+func TestMultiEpochHooks(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+
+	testCases := []struct {
+		name   string
+		hook   mockHook
+		expErr string
+	}{
+		{
+			"hook succeeds",
+			mockHook{key: "ok"},
+			"",
+		},
+		{
+			"hook returns an error",
+			mockHook{key: "error", err: errors.New("mint failed")},
+			"mint failed",
+		},
+		{
+			"hook panics",
+			mockHook{key: "panic", panics: true},
+			"mock hook panic",
+		},
+		{
+			"hook runs out of gas",
+			mockHook{key: "gas", gas: types.HookGasLimit + 1},
+			"out of gas",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := testutil.DefaultContext(storeKey, tKey)
+			tc.hook.storeKey = storeKey
+			// the following hook must run regardless of the result of the
+			// tested one
+			next := mockHook{storeKey: storeKey, key: "next"}
+
+			hooks := keeper.NewMultiEpochHooks(tc.hook, next)
+			require.NoError(t, hooks.AfterEpochEnd(ctx, types.DayEpochID, 1))
+
+			store := ctx.KVStore(storeKey)
+			require.True(t, store.Has([]byte(next.key)))
+
+			var failed []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeEpochHookFailed {
+					failed = append(failed, event)
+				}
+			}
+
+			if tc.expErr == "" {
+				require.True(t, store.Has([]byte(tc.hook.key)))
+				require.Empty(t, failed)
+				return
+			}
+
+			// the state changes of the failed hook are discarded
+			require.False(t, store.Has([]byte(tc.hook.key)))
+			require.Len(t, failed, 1)
+
+			attrs := make(map[string]string)
+			for _, attr := range failed[0].Attributes {
+				attrs[attr.Key] = attr.Value
+			}
+			require.Equal(t, types.DayEpochID, attrs[types.AttributeEpochIdentifier])
+			require.Equal(t, "1", attrs[types.AttributeEpochNumber])
+			require.Contains(t, attrs[types.AttributeHookError], tc.expErr)
+		})
+	}
+}
@@ -31,14 +31,30 @@ import (
 type Keeper struct {
 	cdc      codec.Codec
 	storeKey storetypes.StoreKey
-	hooks    types.EpochHooks
+	// the address capable of executing a MsgAddEpoch or MsgRemoveEpoch
+	// message. Typically, this should be the x/gov module account.
+	authority       sdk.AccAddress
+	inflationKeeper types.InflationKeeper
+	hooks           types.EpochHooks
 }
 
 // NewKeeper returns a new instance of epochs Keeper
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey) *Keeper {
+func NewKeeper(
+	cdc codec.Codec,
+	storeKey storetypes.StoreKey,
+	authority sdk.AccAddress,
+	ik types.InflationKeeper,
+) *Keeper {
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
 	return &Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
+		cdc:             cdc,
+		storeKey:        storeKey,
+		authority:       authority,
+		inflationKeeper: ik,
 	}
 }
 
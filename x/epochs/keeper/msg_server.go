@@ -0,0 +1,108 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/hetu-project/hetu/v1/x/epochs/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// AddEpoch defines a method for registering a new epoch identifier. The epoch
+// counting starts on the first block after the epoch start time.
+func (k Keeper) AddEpoch(goCtx context.Context, req *types.MsgAddEpoch) (*types.MsgAddEpochResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); found {
+		return nil, errorsmod.Wrapf(types.ErrEpochExists, "identifier: %s", req.Identifier)
+	}
+
+	startTime := req.StartTime
+	if startTime.IsZero() {
+		startTime = ctx.BlockTime()
+	}
+
+	epoch := types.EpochInfo{
+		Identifier:              req.Identifier,
+		StartTime:               startTime,
+		Duration:                req.Duration,
+		CurrentEpoch:            0,
+		CurrentEpochStartHeight: ctx.BlockHeight(),
+		CurrentEpochStartTime:   startTime,
+		EpochCountingStarted:    false,
+	}
+
+	if err := epoch.Validate(); err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid epoch")
+	}
+
+	k.SetEpochInfo(ctx, epoch)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, epoch.Identifier),
+			sdk.NewAttribute(types.AttributeEpochStartTime, strconv.FormatInt(epoch.StartTime.Unix(), 10)),
+			sdk.NewAttribute(types.AttributeEpochDuration, epoch.Duration.String()),
+		),
+	)
+
+	return &types.MsgAddEpochResponse{}, nil
+}
+
+// RemoveEpoch defines a method for removing an existing epoch identifier.
+// Modules whose hooks expect the identifier stop receiving its epoch events,
+// the identifiers used by the inflation cannot be removed.
+func (k Keeper) RemoveEpoch(goCtx context.Context, req *types.MsgRemoveEpoch) (*types.MsgRemoveEpochResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier: %s", req.Identifier)
+	}
+
+	// the inflation mints on its epoch and counts the skipped epochs on the day
+	// epoch, removing either of them would silently stop the inflation
+	if req.Identifier == k.inflationKeeper.GetEpochIdentifier(ctx) || req.Identifier == types.DayEpochID {
+		return nil, errorsmod.Wrapf(types.ErrEpochInUse, "identifier %s is used by the inflation", req.Identifier)
+	}
+
+	k.DeleteEpochInfo(ctx, req.Identifier)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, req.Identifier),
+		),
+	)
+
+	return &types.MsgRemoveEpochResponse{}, nil
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/hetu-project/hetu/v1/x/epochs/types"
+)
+
+func (suite *KeeperTestSuite) TestAddEpoch() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgAddEpoch
+		expPass bool
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgAddEpoch{Authority: "foobar", Identifier: "month", Duration: time.Hour * 24 * 30},
+			false,
+		},
+		{
+			"fail - existing identifier",
+			types.NewMsgAddEpoch(authority, types.WeekEpochID, time.Time{}, time.Hour),
+			false,
+		},
+		{
+			"pass - new identifier",
+			types.NewMsgAddEpoch(authority, "month", time.Time{}, time.Hour*24*30),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			_, err := suite.app.EpochsKeeper.AddEpoch(suite.ctx, tc.msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				epoch, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, tc.msg.Identifier)
+				suite.Require().True(found)
+				suite.Require().Equal(suite.ctx.BlockTime(), epoch.StartTime)
+				suite.Require().Equal(tc.msg.Duration, epoch.Duration)
+				suite.Require().False(epoch.EpochCountingStarted)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRemoveEpoch() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRemoveEpoch
+		expPass bool
+	}{
+		{
+			"fail - invalid authority",
+			&types.MsgRemoveEpoch{Authority: "foobar", Identifier: types.WeekEpochID},
+			false,
+		},
+		{
+			"fail - unknown identifier",
+			types.NewMsgRemoveEpoch(authority, "month"),
+			false,
+		},
+		{
+			"fail - identifier used by the inflation",
+			types.NewMsgRemoveEpoch(authority, types.DayEpochID),
+			false,
+		},
+		{
+			"pass - existing identifier",
+			types.NewMsgRemoveEpoch(authority, types.WeekEpochID),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			_, err := suite.app.EpochsKeeper.RemoveEpoch(suite.ctx, tc.msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				_, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, tc.msg.Identifier)
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -18,9 +18,17 @@ package types
 
 // epochs events
 const (
-	EventTypeEpochEnd   = "epoch_end"
-	EventTypeEpochStart = "epoch_start"
+	EventTypeEpochEnd        = "epoch_end"
+	EventTypeEpochStart      = "epoch_start"
+	EventTypeEpochHookFailed = "epoch_hook_failed"
+	EventTypeAddEpoch        = "add_epoch"
+	EventTypeRemoveEpoch     = "remove_epoch"
 
-	AttributeEpochNumber    = "epoch_number"
-	AttributeEpochStartTime = "start_time"
+	AttributeEpochIdentifier = "identifier"
+	AttributeEpochNumber     = "epoch_number"
+	AttributeEpochStartTime  = "start_time"
+	AttributeEpochDuration   = "duration"
+	AttributeHook            = "hook"
+	AttributeHookName        = "hook_name"
+	AttributeHookError       = "error"
 )
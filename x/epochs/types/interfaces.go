@@ -18,10 +18,20 @@ package types
 
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
+// HookGasLimit defines the maximum amount of gas each epoch hook can consume
+// when it is run through MultiEpochHooks.
+const HookGasLimit uint64 = 30_000_000
+
+// InflationKeeper defines the expected inflation keeper interface used on
+// epochs
+type InflationKeeper interface {
+	GetEpochIdentifier(ctx sdk.Context) string
+}
+
 // EpochHooks event hooks for epoch processing
 type EpochHooks interface {
 	// the first block whose timestamp is after the duration is counted as the end of the epoch
-	AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64)
+	AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error
 	// new epoch is next block of epoch end block
-	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64)
+	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error
 }
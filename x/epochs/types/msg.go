@@ -0,0 +1,100 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgAddEpoch{}
+	_ sdk.Msg = &MsgRemoveEpoch{}
+)
+
+// NewMsgAddEpoch creates a new instance of MsgAddEpoch
+func NewMsgAddEpoch(authority sdk.AccAddress, identifier string, startTime time.Time, duration time.Duration) *MsgAddEpoch {
+	return &MsgAddEpoch{
+		Authority:  authority.String(),
+		Identifier: identifier,
+		StartTime:  startTime,
+		Duration:   duration,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgAddEpoch message.
+func (m *MsgAddEpoch) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgAddEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if err := ValidateEpochIdentifierString(m.Identifier); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	if m.Duration <= 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "epoch duration must be positive: %s", m.Duration)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAddEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgRemoveEpoch creates a new instance of MsgRemoveEpoch
+func NewMsgRemoveEpoch(authority sdk.AccAddress, identifier string) *MsgRemoveEpoch {
+	return &MsgRemoveEpoch{
+		Authority:  authority.String(),
+		Identifier: identifier,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgRemoveEpoch message.
+func (m *MsgRemoveEpoch) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRemoveEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	if err := ValidateEpochIdentifierString(m.Identifier); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRemoveEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
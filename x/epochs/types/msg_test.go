@@ -0,0 +1,110 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgAddEpochValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	testCases := []struct {
+		name    string
+		msg     *MsgAddEpoch
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgAddEpoch{
+				Authority:  "invalid",
+				Identifier: "month",
+				Duration:   time.Hour * 24 * 30,
+			},
+			false,
+		},
+		{
+			"fail - blank identifier",
+			NewMsgAddEpoch(authority, " ", time.Time{}, time.Hour),
+			false,
+		},
+		{
+			"fail - zero duration",
+			NewMsgAddEpoch(authority, "month", time.Time{}, 0),
+			false,
+		},
+		{
+			"fail - negative duration",
+			NewMsgAddEpoch(authority, "month", time.Time{}, -time.Hour),
+			false,
+		},
+		{
+			"pass - valid msg",
+			NewMsgAddEpoch(authority, "month", time.Now(), time.Hour*24*30),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgRemoveEpochValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	testCases := []struct {
+		name    string
+		msg     *MsgRemoveEpoch
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgRemoveEpoch{
+				Authority:  "invalid",
+				Identifier: WeekEpochID,
+			},
+			false,
+		},
+		{
+			"fail - blank identifier",
+			NewMsgRemoveEpoch(authority, ""),
+			false,
+		},
+		{
+			"pass - valid msg",
+			NewMsgRemoveEpoch(authority, WeekEpochID),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
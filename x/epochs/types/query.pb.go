@@ -10,19 +10,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -227,46 +231,159 @@ func (m *QueryCurrentEpochResponse) GetCurrentEpoch() int64 {
 	return 0
 }
 
+// QueryNextEpochStartRequest is the request type for the Query/NextEpochStart
+// RPC method.
+type QueryNextEpochStartRequest struct {
+	// identifier of the epoch
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *QueryNextEpochStartRequest) Reset()         { *m = QueryNextEpochStartRequest{} }
+func (m *QueryNextEpochStartRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextEpochStartRequest) ProtoMessage()    {}
+func (*QueryNextEpochStartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d13f5778acd937ff, []int{4}
+}
+func (m *QueryNextEpochStartRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextEpochStartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextEpochStartRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextEpochStartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextEpochStartRequest.Merge(m, src)
+}
+func (m *QueryNextEpochStartRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextEpochStartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextEpochStartRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextEpochStartRequest proto.InternalMessageInfo
+
+func (m *QueryNextEpochStartRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+// QueryNextEpochStartResponse is the response type for the Query/NextEpochStart
+// RPC method.
+type QueryNextEpochStartResponse struct {
+	// next_epoch is the number of the next epoch
+	NextEpoch int64 `protobuf:"varint,1,opt,name=next_epoch,json=nextEpoch,proto3" json:"next_epoch,omitempty"`
+	// start_time is the earliest time at which the next epoch can start. The
+	// epoch starts on the first block whose timestamp is after start_time.
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+}
+
+func (m *QueryNextEpochStartResponse) Reset()         { *m = QueryNextEpochStartResponse{} }
+func (m *QueryNextEpochStartResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextEpochStartResponse) ProtoMessage()    {}
+func (*QueryNextEpochStartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d13f5778acd937ff, []int{5}
+}
+func (m *QueryNextEpochStartResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextEpochStartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextEpochStartResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextEpochStartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextEpochStartResponse.Merge(m, src)
+}
+func (m *QueryNextEpochStartResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextEpochStartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextEpochStartResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextEpochStartResponse proto.InternalMessageInfo
+
+func (m *QueryNextEpochStartResponse) GetNextEpoch() int64 {
+	if m != nil {
+		return m.NextEpoch
+	}
+	return 0
+}
+
+func (m *QueryNextEpochStartResponse) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "evmos.epochs.v1.QueryEpochsInfoRequest")
 	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "evmos.epochs.v1.QueryEpochsInfoResponse")
 	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "evmos.epochs.v1.QueryCurrentEpochRequest")
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "evmos.epochs.v1.QueryCurrentEpochResponse")
+	proto.RegisterType((*QueryNextEpochStartRequest)(nil), "evmos.epochs.v1.QueryNextEpochStartRequest")
+	proto.RegisterType((*QueryNextEpochStartResponse)(nil), "evmos.epochs.v1.QueryNextEpochStartResponse")
 }
 
 func init() { proto.RegisterFile("evmos/epochs/v1/query.proto", fileDescriptor_d13f5778acd937ff) }
 
 var fileDescriptor_d13f5778acd937ff = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6b, 0x14, 0x31,
-	0x14, 0xc6, 0x37, 0x5b, 0x2d, 0x98, 0x56, 0x84, 0x20, 0x76, 0x3b, 0x6a, 0xba, 0xac, 0xd0, 0xae,
-	0x05, 0x13, 0x76, 0xbd, 0x88, 0x27, 0xa9, 0xa8, 0x08, 0x1e, 0x74, 0x8e, 0x5e, 0x74, 0x66, 0x7c,
-	0xcd, 0x46, 0x6c, 0x32, 0x9d, 0x64, 0x06, 0x7b, 0x13, 0xef, 0x82, 0xe0, 0x4d, 0xfc, 0x83, 0x7a,
-	0x2c, 0x78, 0xf1, 0x24, 0xb2, 0xeb, 0x1f, 0x22, 0x93, 0xa4, 0x3a, 0xbb, 0x3b, 0xb0, 0xde, 0x32,
-	0x79, 0xef, 0xfb, 0xde, 0xef, 0x7d, 0x13, 0x7c, 0x1d, 0xaa, 0x23, 0x6d, 0x38, 0xe4, 0x3a, 0x9b,
-	0x18, 0x5e, 0x8d, 0xf8, 0x71, 0x09, 0xc5, 0x09, 0xcb, 0x0b, 0x6d, 0x35, 0xb9, 0xe2, 0x8a, 0xcc,
-	0x17, 0x59, 0x35, 0x8a, 0xf6, 0x33, 0x6d, 0xea, 0xf6, 0x34, 0x31, 0xe0, 0x3b, 0x79, 0x35, 0x4a,
-	0xc1, 0x26, 0x23, 0x9e, 0x27, 0x42, 0xaa, 0xc4, 0x4a, 0xad, 0xbc, 0x38, 0xba, 0xb9, 0xe8, 0x2c,
-	0x40, 0x81, 0x91, 0x26, 0x94, 0xaf, 0x0a, 0x2d, 0xb4, 0x3b, 0xf2, 0xfa, 0x14, 0x6e, 0x6f, 0x08,
-	0xad, 0xc5, 0x3b, 0xe0, 0x49, 0x2e, 0x79, 0xa2, 0x94, 0xb6, 0xce, 0x31, 0x68, 0x06, 0xaf, 0xf1,
-	0xb5, 0x17, 0xf5, 0xd0, 0x47, 0xce, 0xf3, 0xa9, 0x3a, 0xd4, 0x31, 0x1c, 0x97, 0x60, 0x2c, 0x79,
-	0x8c, 0xf1, 0x3f, 0x80, 0x1e, 0xea, 0xa3, 0xe1, 0xc6, 0x78, 0x97, 0x79, 0x5a, 0x56, 0xd3, 0x32,
-	0xbf, 0x57, 0xa0, 0x65, 0xcf, 0x13, 0x01, 0x41, 0x1b, 0x37, 0x94, 0x83, 0x6f, 0x08, 0x6f, 0x2d,
-	0x8d, 0x30, 0xb9, 0x56, 0x06, 0xc8, 0x3d, 0xbc, 0xee, 0x97, 0xe9, 0xa1, 0xfe, 0xda, 0x70, 0x63,
-	0x1c, 0xb1, 0x85, 0x78, 0x98, 0x13, 0xd5, 0x9a, 0x83, 0x0b, 0xa7, 0x3f, 0x77, 0x3a, 0x71, 0xe8,
-	0x27, 0x4f, 0xe6, 0xe8, 0xba, 0x8e, 0x6e, 0x6f, 0x25, 0x9d, 0x1f, 0x3b, 0x87, 0x77, 0x1f, 0xf7,
-	0x1c, 0xdd, 0xc3, 0xb2, 0x28, 0x40, 0x59, 0x37, 0xef, 0x3c, 0x02, 0x8a, 0xb1, 0x7c, 0x03, 0xca,
-	0xca, 0x43, 0x09, 0x85, 0x8b, 0xe0, 0x52, 0xdc, 0xb8, 0x19, 0x3c, 0xc0, 0xdb, 0x2d, 0xda, 0xb0,
-	0xdb, 0x2d, 0x7c, 0x39, 0xf3, 0xf7, 0xaf, 0x1c, 0xb3, 0xd3, 0xaf, 0xc5, 0x9b, 0x59, 0xa3, 0x79,
-	0xfc, 0xb5, 0x8b, 0x2f, 0x3a, 0x0b, 0xf2, 0x01, 0x61, 0xfc, 0x77, 0x59, 0x43, 0xf6, 0x96, 0x92,
-	0x68, 0xff, 0x4d, 0xd1, 0x70, 0x75, 0xa3, 0x07, 0x1a, 0xec, 0x7c, 0xfc, 0xfe, 0xfb, 0x4b, 0x77,
-	0x9b, 0x6c, 0xf1, 0xc5, 0x67, 0x14, 0x32, 0xfd, 0x84, 0xf0, 0x66, 0x73, 0x15, 0x72, 0xbb, 0xdd,
-	0xbb, 0x25, 0xaa, 0x68, 0xff, 0x7f, 0x5a, 0x03, 0xc8, 0xae, 0x03, 0xe9, 0x13, 0xba, 0x04, 0x32,
-	0x17, 0xd8, 0xc1, 0xb3, 0xd3, 0x29, 0x45, 0x67, 0x53, 0x8a, 0x7e, 0x4d, 0x29, 0xfa, 0x3c, 0xa3,
-	0x9d, 0xb3, 0x19, 0xed, 0xfc, 0x98, 0xd1, 0xce, 0xcb, 0xb1, 0x90, 0x76, 0x52, 0xa6, 0x2c, 0xd3,
-	0x47, 0x7c, 0x02, 0xb6, 0xbc, 0x93, 0x17, 0xfa, 0x2d, 0x64, 0xd6, 0x7f, 0x4c, 0xca, 0xb4, 0x36,
-	0x7b, 0x7f, 0x6e, 0x6c, 0x4f, 0x72, 0x30, 0xe9, 0xba, 0x7b, 0xf0, 0x77, 0xff, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0x33, 0x23, 0xef, 0xf0, 0x9f, 0x03, 0x00, 0x00,
+	// 574 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xb3, 0x0d, 0x54, 0x64, 0x5a, 0x40, 0x5a, 0x21, 0x9a, 0xba, 0xd4, 0x09, 0xa9, 0xd4,
+	0xa6, 0x05, 0xd6, 0x4a, 0xb8, 0x20, 0xc4, 0x01, 0xa5, 0xe2, 0xeb, 0x82, 0xc0, 0x70, 0xe2, 0x12,
+	0x1c, 0xb3, 0x71, 0x8c, 0xc8, 0xae, 0xeb, 0x5d, 0x47, 0xe9, 0x0d, 0xb8, 0x23, 0x55, 0x42, 0xe2,
+	0xc4, 0x5b, 0xf0, 0x12, 0x3d, 0x56, 0xe2, 0xc2, 0x09, 0x50, 0xc2, 0x83, 0x20, 0xef, 0xae, 0xdb,
+	0x7c, 0x58, 0x6a, 0x6e, 0xeb, 0x9d, 0xff, 0x7f, 0xe6, 0x37, 0x3b, 0x63, 0xd8, 0xa0, 0x83, 0x3e,
+	0x17, 0x0e, 0x8d, 0xb8, 0xdf, 0x13, 0xce, 0xa0, 0xe1, 0x1c, 0x24, 0x34, 0x3e, 0x24, 0x51, 0xcc,
+	0x25, 0xc7, 0x57, 0x55, 0x90, 0xe8, 0x20, 0x19, 0x34, 0xac, 0x3d, 0x9f, 0x8b, 0x54, 0xde, 0xf1,
+	0x04, 0xd5, 0x4a, 0x67, 0xd0, 0xe8, 0x50, 0xe9, 0x35, 0x9c, 0xc8, 0x0b, 0x42, 0xe6, 0xc9, 0x90,
+	0x33, 0x6d, 0xb6, 0x36, 0x67, 0x33, 0x07, 0x94, 0x51, 0x11, 0x0a, 0x13, 0xbe, 0x16, 0xf0, 0x80,
+	0xab, 0xa3, 0x93, 0x9e, 0xcc, 0xed, 0x8d, 0x80, 0xf3, 0xe0, 0x03, 0x75, 0xbc, 0x28, 0x74, 0x3c,
+	0xc6, 0xb8, 0x54, 0x19, 0x33, 0x4f, 0xc5, 0x44, 0xd5, 0x57, 0x27, 0xe9, 0x3a, 0x32, 0xec, 0x53,
+	0x21, 0xbd, 0x7e, 0xa4, 0x05, 0xb5, 0xb7, 0x70, 0xfd, 0x65, 0x4a, 0xf5, 0x48, 0x15, 0x7d, 0xc6,
+	0xba, 0xdc, 0xa5, 0x07, 0x09, 0x15, 0x12, 0x3f, 0x06, 0x38, 0x23, 0x2c, 0xa3, 0x2a, 0xaa, 0xaf,
+	0x34, 0xb7, 0x89, 0x6e, 0x87, 0xa4, 0xed, 0x10, 0xdd, 0xb8, 0x69, 0x87, 0xbc, 0xf0, 0x02, 0x6a,
+	0xbc, 0xee, 0x84, 0xb3, 0xf6, 0x1d, 0xc1, 0xda, 0x5c, 0x09, 0x11, 0x71, 0x26, 0x28, 0xbe, 0x07,
+	0xcb, 0xba, 0xdb, 0x32, 0xaa, 0x16, 0xeb, 0x2b, 0x4d, 0x8b, 0xcc, 0xbc, 0x1f, 0x51, 0xa6, 0xd4,
+	0xd3, 0xba, 0x70, 0xfc, 0xbb, 0x52, 0x70, 0x8d, 0x1e, 0x3f, 0x99, 0xa2, 0x5b, 0x52, 0x74, 0x3b,
+	0xe7, 0xd2, 0xe9, 0xb2, 0x53, 0x78, 0xf7, 0xa1, 0xac, 0xe8, 0xf6, 0x93, 0x38, 0xa6, 0x4c, 0xaa,
+	0x7a, 0xd9, 0x13, 0xd8, 0x00, 0xe1, 0x3b, 0xca, 0x64, 0xd8, 0x0d, 0x69, 0xac, 0x9e, 0xa0, 0xe4,
+	0x4e, 0xdc, 0xd4, 0x1e, 0xc2, 0x7a, 0x8e, 0xd7, 0xf4, 0xb6, 0x05, 0x97, 0x7d, 0x7d, 0xdf, 0x56,
+	0xcc, 0xca, 0x5f, 0x74, 0x57, 0xfd, 0x09, 0x71, 0xed, 0x01, 0x58, 0x2a, 0xc3, 0x73, 0x3a, 0xd4,
+	0x37, 0xaf, 0xa4, 0x17, 0xcb, 0x45, 0xeb, 0x7f, 0x42, 0xb0, 0x91, 0x6b, 0x37, 0x08, 0x9b, 0x00,
+	0x8c, 0x0e, 0xa7, 0xeb, 0x97, 0x58, 0xa6, 0xc5, 0xfb, 0x00, 0x22, 0xd5, 0xb7, 0xd3, 0xa5, 0x30,
+	0x6f, 0x68, 0x11, 0xbd, 0x31, 0x24, 0xdb, 0x18, 0xf2, 0x3a, 0xdb, 0x98, 0xd6, 0xa5, 0x74, 0x02,
+	0x47, 0x7f, 0x2a, 0xc8, 0x2d, 0x29, 0x5f, 0x1a, 0x69, 0xfe, 0x28, 0xc2, 0x45, 0xc5, 0x80, 0x3f,
+	0x22, 0x80, 0xd3, 0x71, 0x09, 0xbc, 0x33, 0x37, 0xcb, 0xfc, 0x45, 0xb3, 0xea, 0xe7, 0x0b, 0x75,
+	0x3f, 0xb5, 0xca, 0xe7, 0x9f, 0xff, 0xbe, 0x2e, 0xad, 0xe3, 0x35, 0x67, 0xf6, 0x4f, 0xd1, 0x27,
+	0xfc, 0x05, 0xc1, 0xea, 0xe4, 0x30, 0xf0, 0x6e, 0x7e, 0xee, 0x9c, 0x61, 0x5b, 0x7b, 0x8b, 0x48,
+	0x0d, 0xc8, 0xb6, 0x02, 0xa9, 0x62, 0x7b, 0x0e, 0x64, 0x6a, 0xe4, 0xf8, 0x1b, 0x82, 0x2b, 0xd3,
+	0xb3, 0xc1, 0xb7, 0xf2, 0xcb, 0xe4, 0x2e, 0x80, 0x75, 0x7b, 0x31, 0xb1, 0xa1, 0xda, 0x55, 0x54,
+	0x5b, 0xf8, 0xe6, 0x1c, 0xd5, 0xd9, 0x16, 0xb4, 0xd5, 0xe4, 0x5a, 0x4f, 0x8f, 0x47, 0x36, 0x3a,
+	0x19, 0xd9, 0xe8, 0xef, 0xc8, 0x46, 0x47, 0x63, 0xbb, 0x70, 0x32, 0xb6, 0x0b, 0xbf, 0xc6, 0x76,
+	0xe1, 0x0d, 0x09, 0x42, 0xd9, 0x4b, 0x3a, 0xc4, 0xe7, 0x7d, 0xa7, 0x47, 0x65, 0x72, 0x27, 0x8a,
+	0xf9, 0x7b, 0xea, 0x4b, 0xf5, 0x91, 0xe6, 0x1a, 0x66, 0x79, 0xe5, 0x61, 0x44, 0x45, 0x67, 0x59,
+	0x2d, 0xca, 0xdd, 0xff, 0x03, 0x00, 0x5b, 0x35, 0x22, 0xb4, 0x17, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -285,6 +402,9 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// NextEpochStart provide the earliest start time of the next epoch of
+	// specified identifier
+	NextEpochStart(ctx context.Context, in *QueryNextEpochStartRequest, opts ...grpc.CallOption) (*QueryNextEpochStartResponse, error)
 }
 
 type queryClient struct {
@@ -313,12 +433,24 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) NextEpochStart(ctx context.Context, in *QueryNextEpochStartRequest, opts ...grpc.CallOption) (*QueryNextEpochStartResponse, error) {
+	out := new(QueryNextEpochStartResponse)
+	err := c.cc.Invoke(ctx, "/evmos.epochs.v1.Query/NextEpochStart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EpochInfos provide running epochInfos
 	EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// NextEpochStart provide the earliest start time of the next epoch of
+	// specified identifier
+	NextEpochStart(context.Context, *QueryNextEpochStartRequest) (*QueryNextEpochStartResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -331,6 +463,9 @@ func (*UnimplementedQueryServer) EpochInfos(ctx context.Context, req *QueryEpoch
 func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
+func (*UnimplementedQueryServer) NextEpochStart(ctx context.Context, req *QueryNextEpochStartRequest) (*QueryNextEpochStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextEpochStart not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -372,6 +507,24 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NextEpochStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextEpochStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextEpochStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.epochs.v1.Query/NextEpochStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextEpochStart(ctx, req.(*QueryNextEpochStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.epochs.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -384,6 +537,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
+		{
+			MethodName: "NextEpochStart",
+			Handler:    _Query_NextEpochStart_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/epochs/v1/query.proto",
@@ -531,6 +688,72 @@ func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryNextEpochStartRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextEpochStartRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextEpochStartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextEpochStartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextEpochStartResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextEpochStartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.NextEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -599,6 +822,33 @@ func (m *QueryCurrentEpochResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryNextEpochStartRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextEpochStartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.NextEpoch))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -962,6 +1212,190 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryNextEpochStartRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextEpochStartRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextEpochStartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextEpochStartResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextEpochStartResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextEpochStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpoch", wireType)
+			}
+			m.NextEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
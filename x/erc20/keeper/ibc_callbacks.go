@@ -17,7 +17,11 @@
 package keeper
 
 import (
+	"encoding/json"
+	"strconv"
+
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -29,12 +33,15 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/hetu-project/hetu/v1/contracts"
 	"github.com/hetu-project/hetu/v1/ibc"
 	"github.com/hetu-project/hetu/v1/x/erc20/types"
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
 )
 
 // OnRecvPacket performs the ICS20 middleware receive callback for automatically
-// converting an IBC Coin to their ERC20 representation.
+// converting the received IBC Coin to their ERC20 representation. Only the
+// amount received in the packet is converted.
 // For the conversion to succeed, the IBC denomination must have previously been
 // registered via governance. Note that the native staking denomination (e.g. "ahetu"),
 // is excluded from the conversion.
@@ -45,6 +52,12 @@ import (
 // - ERC20s are disabled
 // - Denomination is native staking token
 // - The base denomination is not registered as ERC20
+// - The auto-conversion is disabled for the token pair or the destination channel
+//
+// The IBC rate limit quota of the denomination on the destination channel is
+// enforced by the ratelimit IBC middleware, which wraps the ICS20 transfer
+// module below this middleware in the transfer stack (see app/app.go), so a
+// packet exceeding the quota is rejected before the conversion takes place.
 func (k Keeper) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -116,21 +129,53 @@ func (k Keeper) OnRecvPacket(
 		return ack
 	}
 
-	// Instead of converting just the received coins, convert the whole user balance
-	// which includes the received coins.
-	balance := k.bankKeeper.GetBalance(ctx, recipient, coin.Denom)
+	if !k.IsIBCAutoConvertEnabled(ctx, pair, packet.DestinationChannel) {
+		// no-op: the token pair or the channel opted out of the conversion
+		return ack
+	}
 
 	// Build MsgConvertCoin, from recipient to recipient since IBC transfer already occurred
-	msg := types.NewMsgConvertCoin(balance, common.BytesToAddress(recipient.Bytes()), recipient)
+	receiver := common.BytesToAddress(recipient.Bytes())
+	msg := types.NewMsgConvertCoin(coin, receiver, recipient)
 
 	// NOTE: we don't use ValidateBasic the msg since we've already validated
 	// the ICS20 packet data
 
 	// Use MsgConvertCoin to convert the Cosmos Coin to an ERC20
-	if _, err = k.ConvertCoin(sdk.WrapSDKContext(ctx), msg); err != nil {
+	res, err := k.convertCoin(ctx, msg)
+	if err != nil {
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
+	if res == nil {
+		// no-op: the token pair was removed because its contract self-destructed,
+		// nothing was converted
+		return ack
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(channeltypes.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+		sdk.NewAttribute(channeltypes.AttributeKeySrcPort, packet.SourcePort),
+		sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, packet.SourceChannel),
+		sdk.NewAttribute(channeltypes.AttributeKeyDstPort, packet.DestinationPort),
+		sdk.NewAttribute(channeltypes.AttributeKeyDstChannel, packet.DestinationChannel),
+		sdk.NewAttribute(types.AttributeKeyReceiver, receiver.Hex()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, coin.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyCosmosCoin, coin.Denom),
+		sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+	}
+
+	// link the packet to the ERC20 Transfer log of the conversion
+	if transferLog := findTransferLog(res, pair.GetERC20Contract()); transferLog != nil {
+		bz, err := json.Marshal(transferLog)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyTransferLog, string(bz)))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeIBCAutoConvert, attrs...))
+
 	defer func() {
 		telemetry.IncrCounterWithLabels(
 			[]string{types.ModuleName, "ibc", "on_recv", "total"},
@@ -146,34 +191,60 @@ func (k Keeper) OnRecvPacket(
 	return ack
 }
 
+// findTransferLog returns the ERC20 Transfer log emitted by the contract in
+// the EVM call response, or nil if there is none.
+func findTransferLog(res *evmtypes.MsgEthereumTxResponse, contract common.Address) *evmtypes.Log {
+	if res == nil {
+		return nil
+	}
+
+	transferEvent := contracts.ERC20MinterBurnerDecimalsContract.ABI.Events[types.ERC20EventTransfer]
+	for _, log := range res.Logs {
+		if len(log.Topics) == 0 || common.HexToAddress(log.Address) != contract {
+			continue
+		}
+		if common.HexToHash(log.Topics[0]) == transferEvent.ID {
+			return log
+		}
+	}
+
+	return nil
+}
+
 // OnAcknowledgementPacket responds to the the success or failure of a packet
 // acknowledgement written on the receiving chain. If the acknowledgement was a
 // success then nothing occurs. If the acknowledgement failed, then the sender
-// is refunded and then the IBC Coins are converted to ERC20.
+// is refunded and then the IBC Coins that were converted from ERC20 when the
+// packet was sent are converted back to ERC20.
 func (k Keeper) OnAcknowledgementPacket(
-	ctx sdk.Context, _ channeltypes.Packet,
+	ctx sdk.Context, packet channeltypes.Packet,
 	data transfertypes.FungibleTokenPacketData,
 	ack channeltypes.Acknowledgement,
 ) error {
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
 		// convert the token from Cosmos Coin to its ERC20 representation
-		return k.ConvertCoinToERC20FromPacket(ctx, data)
+		return k.ConvertCoinToERC20FromPacket(ctx, packet, data)
 	default:
 		// the acknowledgement succeeded on the receiving chain so nothing needs to
 		// be executed and no error needs to be returned
+		k.DeletePacketERC20Amount(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
 		return nil
 	}
 }
 
 // OnTimeoutPacket converts the IBC coin to ERC20 after refunding the sender
 // since the original packet sent was never received and has been timed out.
-func (k Keeper) OnTimeoutPacket(ctx sdk.Context, _ channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
-	return k.ConvertCoinToERC20FromPacket(ctx, data)
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
+	return k.ConvertCoinToERC20FromPacket(ctx, packet, data)
 }
 
-// ConvertCoinToERC20FromPacket converts the IBC coin to ERC20 after refunding the sender
-func (k Keeper) ConvertCoinToERC20FromPacket(ctx sdk.Context, data transfertypes.FungibleTokenPacketData) error {
+// ConvertCoinToERC20FromPacket converts the IBC coin to ERC20 after refunding the sender.
+// If the coin and ERC20 split of the transfer was recorded when the packet was
+// sent, only the amount that was converted from ERC20 is converted back, so the
+// refund restores the form in which the sender held the tokens. Otherwise the
+// whole refunded amount is converted.
+func (k Keeper) ConvertCoinToERC20FromPacket(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
 	sender, err := sdk.AccAddressFromBech32(data.Sender)
 	if err != nil {
 		return err
@@ -184,6 +255,11 @@ func (k Keeper) ConvertCoinToERC20FromPacket(ctx sdk.Context, data transfertypes
 		WithKVGasConfig(storetypes.GasConfig{}).
 		WithTransientKVGasConfig(storetypes.GasConfig{})
 
+	erc20Amount, recorded := k.GetPacketERC20Amount(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if recorded {
+		k.DeletePacketERC20Amount(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	}
+
 	// assume that all module accounts on Evmos need to have their tokens in the
 	// IBC representation as opposed to ERC20
 	senderAcc := k.accountKeeper.GetAccount(ctx, sender)
@@ -192,6 +268,13 @@ func (k Keeper) ConvertCoinToERC20FromPacket(ctx sdk.Context, data transfertypes
 	}
 
 	coin := ibc.GetSentCoin(data.Denom, data.Amount)
+	if recorded {
+		if !erc20Amount.IsPositive() {
+			// no-op, the transfer was sent entirely from the Cosmos coin balance
+			return nil
+		}
+		coin.Amount = sdkmath.MinInt(coin.Amount, erc20Amount)
+	}
 
 	// check if the coin is a native staking token
 	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
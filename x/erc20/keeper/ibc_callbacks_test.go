@@ -49,7 +49,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 	// Setup Cosmos <=> Evmos IBC relayer
 	sourceChannel := "channel-292"
-	evmosChannel := "test"
+	evmosChannel := "channel-3"
 	path := fmt.Sprintf("%s/%s", transfertypes.PortID, evmosChannel)
 
 	timeoutHeight := clienttypes.NewHeight(0, 100)
@@ -66,15 +66,17 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	)
 
 	testCases := []struct {
-		name             string
-		malleate         func()
-		ackSuccess       bool
-		receiver         sdk.AccAddress
-		expErc20s        *big.Int
-		expCoins         sdk.Coins
-		checkBalances    bool
-		disableERC20     bool
-		disableTokenPair bool
+		name                      string
+		malleate                  func()
+		ackSuccess                bool
+		receiver                  sdk.AccAddress
+		expErc20s                 *big.Int
+		expCoins                  sdk.Coins
+		checkBalances             bool
+		disableERC20              bool
+		disableTokenPair          bool
+		disableAutoConvertChannel bool
+		disableAutoConvertPair    bool
 	}{
 		{
 			name: "error - non ics-20 packet",
@@ -258,10 +260,10 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			receiver:      secpAddr,
 			ackSuccess:    true,
 			checkBalances: true,
-			expErc20s:     big.NewInt(1000),
+			expErc20s:     big.NewInt(100),
 			expCoins: sdk.NewCoins(
 				sdk.NewCoin(utils.BaseDenom, math.NewInt(1000)),
-				sdk.NewCoin(registeredDenom, math.NewInt(0)),
+				sdk.NewCoin(registeredDenom, math.NewInt(900)),
 				sdk.NewCoin(ibcBase, math.NewInt(1000)),
 			),
 		},
@@ -278,11 +280,11 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			receiver:      ethsecpAddr,
 			ackSuccess:    true,
-			expErc20s:     big.NewInt(1000),
+			expErc20s:     big.NewInt(500),
 			checkBalances: true,
 			expCoins: sdk.NewCoins(
 				sdk.NewCoin(utils.BaseDenom, math.NewInt(1000)),
-				sdk.NewCoin(registeredDenom, math.NewInt(0)),
+				sdk.NewCoin(registeredDenom, math.NewInt(500)),
 				sdk.NewCoin(ibcBase, math.NewInt(1000)),
 			),
 		},
@@ -311,6 +313,38 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 				sdk.NewCoin(registeredDenom, math.NewInt(0)),
 			),
 		},
+		{
+			name: "no-op - auto conversion disabled for channel",
+			malleate: func() {
+				sourcePrefix := transfertypes.GetDenomPrefix(transfertypes.PortID, sourceChannel)
+				prefixedDenom := sourcePrefix + registeredDenom
+				transfer := transfertypes.NewFungibleTokenPacketData(prefixedDenom, "500", secpAddrCosmos, ethsecpAddrEvmos, "")
+				bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+				packet = channeltypes.NewPacket(bz, 100, transfertypes.PortID, sourceChannel, transfertypes.PortID, evmosChannel, timeoutHeight, 0)
+			},
+			receiver:                  ethsecpAddr,
+			ackSuccess:                true,
+			checkBalances:             true,
+			expErc20s:                 big.NewInt(0),
+			expCoins:                  coins,
+			disableAutoConvertChannel: true,
+		},
+		{
+			name: "no-op - auto conversion disabled for pair",
+			malleate: func() {
+				sourcePrefix := transfertypes.GetDenomPrefix(transfertypes.PortID, sourceChannel)
+				prefixedDenom := sourcePrefix + registeredDenom
+				transfer := transfertypes.NewFungibleTokenPacketData(prefixedDenom, "500", secpAddrCosmos, ethsecpAddrEvmos, "")
+				bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+				packet = channeltypes.NewPacket(bz, 100, transfertypes.PortID, sourceChannel, transfertypes.PortID, evmosChannel, timeoutHeight, 0)
+			},
+			receiver:               ethsecpAddr,
+			ackSuccess:             true,
+			checkBalances:          true,
+			expErc20s:              big.NewInt(0),
+			expCoins:               coins,
+			disableAutoConvertPair: true,
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -368,9 +402,30 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 				suite.Require().NoError(err)
 			}
 
+			if tc.disableAutoConvertChannel || tc.disableAutoConvertPair {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				if tc.disableAutoConvertChannel {
+					params.IBCAutoConvertDisabledChannels = []string{evmosChannel}
+				}
+				if tc.disableAutoConvertPair {
+					params.IBCAutoConvertDisabledPairs = []string{pair.Denom}
+				}
+				suite.Require().NoError(suite.app.Erc20Keeper.SetParams(suite.ctx, params))
+			}
+
 			// Perform IBC callback
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
 			ack := suite.app.Erc20Keeper.OnRecvPacket(suite.ctx, packet, expAck)
 
+			// the auto-convert event is only emitted on a conversion
+			converted := false
+			for _, event := range suite.ctx.EventManager().Events() {
+				if event.Type == types.EventTypeIBCAutoConvert {
+					converted = true
+				}
+			}
+			suite.Require().Equal(tc.ackSuccess && tc.expErc20s.Sign() > 0, converted)
+
 			// Check acknowledgement
 			if tc.ackSuccess {
 				suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
@@ -472,7 +527,7 @@ func (suite *KeeperTestSuite) TestConvertCoinToERC20FromPacket() {
 
 			transfer := tc.malleate()
 
-			err := suite.app.Erc20Keeper.ConvertCoinToERC20FromPacket(suite.ctx, transfer)
+			err := suite.app.Erc20Keeper.ConvertCoinToERC20FromPacket(suite.ctx, channeltypes.Packet{}, transfer)
 			if tc.expPass {
 				suite.Require().NoError(err)
 			} else {
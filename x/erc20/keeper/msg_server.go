@@ -30,6 +30,7 @@ import (
 
 	"github.com/hetu-project/hetu/v1/contracts"
 	"github.com/hetu-project/hetu/v1/x/erc20/types"
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
 )
 
 var _ types.MsgServer = &Keeper{}
@@ -42,6 +43,22 @@ func (k Keeper) ConvertCoin(
 ) (*types.MsgConvertCoinResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	res, err := k.convertCoin(ctx, msg)
+	if err != nil || res == nil {
+		return nil, err
+	}
+
+	return &types.MsgConvertCoinResponse{}, nil
+}
+
+// convertCoin performs the conversion of a MsgConvertCoin and returns the
+// response of the EVM call that credited the ERC20 tokens to the receiver. The
+// response is nil if the token pair was deleted because its contract
+// self-destructed.
+func (k Keeper) convertCoin(
+	ctx sdk.Context,
+	msg *types.MsgConvertCoin,
+) (*evmtypes.MsgEthereumTxResponse, error) {
 	// Error checked during msg validation
 	receiver := common.HexToAddress(msg.Receiver)
 	sender := sdk.MustAccAddressFromBech32(msg.Sender)
@@ -129,7 +146,7 @@ func (k Keeper) convertCoinNativeCoin(
 	msg *types.MsgConvertCoin,
 	receiver common.Address,
 	sender sdk.AccAddress,
-) (*types.MsgConvertCoinResponse, error) {
+) (*evmtypes.MsgEthereumTxResponse, error) {
 	// NOTE: ignore validation from NewCoin constructor
 	coins := sdk.Coins{msg.Coin}
 	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
@@ -146,7 +163,7 @@ func (k Keeper) convertCoinNativeCoin(
 	}
 
 	// Mint tokens and send to receiver
-	_, err = k.CallEVM(ctx, erc20, types.ModuleAddress, contract, true, "mint", receiver, msg.Coin.Amount.BigInt())
+	res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, true, "mint", receiver, msg.Coin.Amount.BigInt())
 	if err != nil {
 		return nil, err
 	}
@@ -199,7 +216,7 @@ func (k Keeper) convertCoinNativeCoin(
 		},
 	)
 
-	return &types.MsgConvertCoinResponse{}, nil
+	return res, nil
 }
 
 // convertERC20NativeCoin handles the erc20 conversion for a native Cosmos coin
@@ -439,7 +456,7 @@ func (k Keeper) convertCoinNativeERC20(
 	msg *types.MsgConvertCoin,
 	receiver common.Address,
 	sender sdk.AccAddress,
-) (*types.MsgConvertCoinResponse, error) {
+) (*evmtypes.MsgEthereumTxResponse, error) {
 	// NOTE: ignore validation from NewCoin constructor
 	coins := sdk.Coins{msg.Coin}
 
@@ -530,7 +547,7 @@ func (k Keeper) convertCoinNativeERC20(
 			),
 		},
 	)
-	return &types.MsgConvertCoinResponse{}, nil
+	return res, nil
 }
 
 // UpdateParams implements the gRPC MsgServer interface. After a successful governance vote
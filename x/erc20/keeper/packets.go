@@ -0,0 +1,60 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hetu-project/hetu/v1/x/erc20/types"
+)
+
+// GetPacketERC20Amount returns the amount of an outgoing IBC transfer that was
+// converted from its ERC20 representation before the packet was sent.
+func (k Keeper) GetPacketERC20Amount(ctx sdk.Context, portID, channelID string, sequence uint64) (sdkmath.Int, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPacketERC20Amount)
+	bz := store.Get(types.PacketKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return sdkmath.Int{}, false
+	}
+
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount, true
+}
+
+// SetPacketERC20Amount records the amount of an outgoing IBC transfer that was
+// converted from its ERC20 representation, so that a refund restores the coin
+// and ERC20 split the sender had before the transfer.
+func (k Keeper) SetPacketERC20Amount(ctx sdk.Context, portID, channelID string, sequence uint64, amount sdkmath.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPacketERC20Amount)
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.PacketKey(portID, channelID, sequence), bz)
+}
+
+// DeletePacketERC20Amount removes the record of an outgoing IBC transfer once
+// the packet has been acknowledged or has timed out.
+func (k Keeper) DeletePacketERC20Amount(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPacketERC20Amount)
+	store.Delete(types.PacketKey(portID, channelID, sequence))
+}
@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+func (suite *KeeperTestSuite) TestPacketERC20Amount() {
+	suite.SetupTest()
+
+	_, found := suite.app.Erc20Keeper.GetPacketERC20Amount(suite.ctx, transfertypes.PortID, "channel-0", 1)
+	suite.Require().False(found)
+
+	suite.app.Erc20Keeper.SetPacketERC20Amount(suite.ctx, transfertypes.PortID, "channel-0", 1, sdkmath.NewInt(100))
+	suite.app.Erc20Keeper.SetPacketERC20Amount(suite.ctx, transfertypes.PortID, "channel-0", 2, sdkmath.ZeroInt())
+
+	amount, found := suite.app.Erc20Keeper.GetPacketERC20Amount(suite.ctx, transfertypes.PortID, "channel-0", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(100), amount)
+
+	amount, found = suite.app.Erc20Keeper.GetPacketERC20Amount(suite.ctx, transfertypes.PortID, "channel-0", 2)
+	suite.Require().True(found)
+	suite.Require().True(amount.IsZero())
+
+	_, found = suite.app.Erc20Keeper.GetPacketERC20Amount(suite.ctx, transfertypes.PortID, "channel-1", 1)
+	suite.Require().False(found)
+
+	suite.app.Erc20Keeper.DeletePacketERC20Amount(suite.ctx, transfertypes.PortID, "channel-0", 1)
+	_, found = suite.app.Erc20Keeper.GetPacketERC20Amount(suite.ctx, transfertypes.PortID, "channel-0", 1)
+	suite.Require().False(found)
+}
@@ -17,7 +17,10 @@
 package keeper
 
 import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/hetu-project/hetu/v1/x/erc20/types"
 )
 
@@ -27,8 +30,10 @@ var isTrue = []byte("0x01")
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	enableErc20 := k.IsERC20Enabled(ctx)
 	enableEvmHook := k.GetEnableEVMHook(ctx)
+	disabledPairs := k.getIdentifiers(ctx, types.KeyPrefixIBCAutoConvertDisabledPair)
+	disabledChannels := k.getIdentifiers(ctx, types.KeyPrefixIBCAutoConvertDisabledChannel)
 
-	return types.NewParams(enableErc20, enableEvmHook)
+	return types.NewParams(enableErc20, enableEvmHook, disabledPairs, disabledChannels)
 }
 
 // SetParams sets the erc20 parameters to the param space.
@@ -40,6 +45,13 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	k.setERC20Enabled(ctx, params.EnableErc20)
 	k.setEnableEVMHook(ctx, params.EnableEVMHook)
 
+	disabledPairs := make([]string, len(params.IBCAutoConvertDisabledPairs))
+	for i, token := range params.IBCAutoConvertDisabledPairs {
+		disabledPairs[i] = normalizeTokenIdentifier(token)
+	}
+	k.setIdentifiers(ctx, types.KeyPrefixIBCAutoConvertDisabledPair, disabledPairs)
+	k.setIdentifiers(ctx, types.KeyPrefixIBCAutoConvertDisabledChannel, params.IBCAutoConvertDisabledChannels)
+
 	return nil
 }
 
@@ -74,3 +86,60 @@ func (k Keeper) setEnableEVMHook(ctx sdk.Context, enable bool) {
 	}
 	store.Delete(types.ParamStoreKeyEnableEVMHook)
 }
+
+// IsIBCAutoConvertEnabled returns true if the coins of the token pair received
+// through IBC on the given channel are automatically converted to ERC20 tokens
+func (k Keeper) IsIBCAutoConvertEnabled(ctx sdk.Context, pair types.TokenPair, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	disabledPairs := prefix.NewStore(store, types.KeyPrefixIBCAutoConvertDisabledPair)
+	if disabledPairs.Has([]byte(pair.Denom)) || disabledPairs.Has([]byte(normalizeTokenIdentifier(pair.Erc20Address))) {
+		return false
+	}
+
+	disabledChannels := prefix.NewStore(store, types.KeyPrefixIBCAutoConvertDisabledChannel)
+	return !disabledChannels.Has([]byte(channelID))
+}
+
+// getIdentifiers returns the identifiers stored under the given prefix
+func (k Keeper) getIdentifiers(ctx sdk.Context, keyPrefix []byte) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	var identifiers []string
+	for ; iterator.Valid(); iterator.Next() {
+		identifiers = append(identifiers, string(iterator.Key()))
+	}
+
+	return identifiers
+}
+
+// setIdentifiers replaces the identifiers stored under the given prefix
+func (k Keeper) setIdentifiers(ctx sdk.Context, keyPrefix []byte, identifiers []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, identifier := range identifiers {
+		store.Set([]byte(identifier), isTrue)
+	}
+}
+
+// normalizeTokenIdentifier returns the checksummed hex address if the token
+// identifier is an ERC20 contract address, or the denomination otherwise
+func normalizeTokenIdentifier(token string) string {
+	if common.IsHexAddress(token) {
+		return common.HexToAddress(token).Hex()
+	}
+	return token
+}
@@ -33,10 +33,12 @@ const (
 	EventTypeRegisterCoin          = "register_coin"
 	EventTypeRegisterERC20         = "register_erc20"
 	EventTypeToggleTokenConversion = "toggle_token_conversion" // #nosec
+	EventTypeIBCAutoConvert        = "ibc_auto_convert"
 
-	AttributeKeyCosmosCoin = "cosmos_coin"
-	AttributeKeyERC20Token = "erc20_token" // #nosec
-	AttributeKeyReceiver   = "receiver"
+	AttributeKeyCosmosCoin  = "cosmos_coin"
+	AttributeKeyERC20Token  = "erc20_token" // #nosec
+	AttributeKeyReceiver    = "receiver"
+	AttributeKeyTransferLog = "transfer_log"
 
 	// ERC20EventTransfer defines the transfer event for ERC20
 	ERC20EventTransfer = "Transfer"
@@ -85,6 +85,12 @@ type Params struct {
 	// enable_evm_hook is the parameter to enable the EVM hook that converts an ERC20 token to a Cosmos
 	// Coin by transferring the Tokens through a MsgEthereumTx to the ModuleAddress Ethereum address.
 	EnableEVMHook bool `protobuf:"varint,2,opt,name=enable_evm_hook,json=enableEvmHook,proto3" json:"enable_evm_hook,omitempty"`
+	// ibc_auto_convert_disabled_pairs defines the token pairs, identified by their Cosmos coin denomination or ERC20
+	// contract address, whose coins received through IBC are not automatically converted to ERC20 tokens.
+	IBCAutoConvertDisabledPairs []string `protobuf:"bytes,3,rep,name=ibc_auto_convert_disabled_pairs,json=ibcAutoConvertDisabledPairs,proto3" json:"ibc_auto_convert_disabled_pairs,omitempty"`
+	// ibc_auto_convert_disabled_channels defines the channel identifiers on which the coins received through IBC are
+	// not automatically converted to ERC20 tokens.
+	IBCAutoConvertDisabledChannels []string `protobuf:"bytes,4,rep,name=ibc_auto_convert_disabled_channels,json=ibcAutoConvertDisabledChannels,proto3" json:"ibc_auto_convert_disabled_channels,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -134,6 +140,20 @@ func (m *Params) GetEnableEVMHook() bool {
 	return false
 }
 
+func (m *Params) GetIBCAutoConvertDisabledPairs() []string {
+	if m != nil {
+		return m.IBCAutoConvertDisabledPairs
+	}
+	return nil
+}
+
+func (m *Params) GetIBCAutoConvertDisabledChannels() []string {
+	if m != nil {
+		return m.IBCAutoConvertDisabledChannels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.erc20.v1.Params")
@@ -142,27 +162,32 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/genesis.proto", fileDescriptor_2f4674601b0d6987) }
 
 var fileDescriptor_2f4674601b0d6987 = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4b, 0xc3, 0x30,
-	0x14, 0xc6, 0x9b, 0x29, 0x43, 0xd2, 0x4d, 0xb1, 0x88, 0xcc, 0x21, 0xd9, 0xdc, 0x69, 0x17, 0x53,
-	0x37, 0xbd, 0x78, 0x93, 0xc1, 0x50, 0x10, 0x61, 0x4c, 0xf1, 0xe0, 0x65, 0xa4, 0x23, 0xb6, 0x75,
-	0x76, 0xaf, 0x24, 0x59, 0xd1, 0x7f, 0xc0, 0xb3, 0x7f, 0xd6, 0x8e, 0x3b, 0x7a, 0x1a, 0xd2, 0xfe,
-	0x23, 0x92, 0xa4, 0x3b, 0xb8, 0xdb, 0x7b, 0xdf, 0xf7, 0xfb, 0x5e, 0xc2, 0x87, 0x4f, 0x79, 0x96,
-	0x80, 0xf4, 0xb9, 0x98, 0xf6, 0x2f, 0xfc, 0xac, 0xe7, 0x87, 0x7c, 0xce, 0x65, 0x2c, 0x69, 0x2a,
-	0x40, 0x81, 0xb7, 0x6f, 0x5c, 0x6a, 0x5c, 0x9a, 0xf5, 0x9a, 0xcd, 0x2d, 0xda, 0x1a, 0x86, 0x6d,
-	0x1e, 0x85, 0x10, 0x82, 0x19, 0x7d, 0x3d, 0x59, 0xb5, 0xf3, 0x85, 0x70, 0xed, 0xd6, 0xde, 0x7c,
-	0x54, 0x4c, 0x71, 0xef, 0x0a, 0x57, 0x53, 0x26, 0x58, 0x22, 0x1b, 0xa8, 0x8d, 0xba, 0x6e, 0xff,
-	0x98, 0xfe, 0x7f, 0x83, 0x8e, 0x8c, 0x3b, 0xd8, 0x5d, 0xae, 0x5b, 0xce, 0xb8, 0x64, 0xbd, 0x1b,
-	0xec, 0x2a, 0x98, 0xf1, 0xf9, 0x24, 0x65, 0xb1, 0x90, 0x8d, 0x4a, 0x7b, 0xa7, 0xeb, 0xf6, 0x4f,
-	0xb6, 0xa3, 0x4f, 0x1a, 0x19, 0xb1, 0x58, 0x94, 0x69, 0xac, 0x36, 0x82, 0xec, 0xbc, 0xe2, 0xaa,
-	0xbd, 0xec, 0x9d, 0xe1, 0x1a, 0x9f, 0xb3, 0xe0, 0x9d, 0x4f, 0x4c, 0xd0, 0xfc, 0x63, 0x6f, 0xec,
-	0x5a, 0x6d, 0xa8, 0x25, 0xef, 0x1a, 0x1f, 0x6c, 0x90, 0x2c, 0x99, 0x44, 0x00, 0xb3, 0x46, 0x45,
-	0x53, 0x83, 0xc3, 0x7c, 0xdd, 0xaa, 0x0f, 0x2d, 0xf9, 0xfc, 0x70, 0x07, 0x30, 0x1b, 0xd7, 0xcb,
-	0x60, 0x96, 0xe8, 0x75, 0x70, 0xbf, 0xcc, 0x09, 0x5a, 0xe5, 0x04, 0xfd, 0xe6, 0x04, 0x7d, 0x17,
-	0xc4, 0x59, 0x15, 0xc4, 0xf9, 0x29, 0x88, 0xf3, 0xd2, 0x0b, 0x63, 0x15, 0x2d, 0x02, 0x3a, 0x85,
-	0xc4, 0x8f, 0xb8, 0x5a, 0x9c, 0xa7, 0x02, 0xde, 0xf8, 0x54, 0xd9, 0x25, 0x5a, 0x04, 0xba, 0xd1,
-	0x8f, 0xb2, 0x5c, 0xf5, 0x99, 0x72, 0x19, 0x54, 0x4d, 0x89, 0x97, 0x7f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x74, 0xfd, 0xa2, 0x98, 0xa6, 0x01, 0x00, 0x00,
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x31, 0x6f, 0x9b, 0x40,
+	0x1c, 0xc5, 0xc1, 0xb6, 0xac, 0xf6, 0xb0, 0x5b, 0x15, 0x55, 0x15, 0xb5, 0xab, 0xc3, 0x65, 0xf2,
+	0x62, 0xa8, 0xdd, 0x2e, 0xdd, 0x5a, 0x1c, 0xcb, 0xc9, 0x10, 0xc9, 0x22, 0x51, 0x86, 0x2c, 0xe8,
+	0xc0, 0x27, 0x20, 0x36, 0x1c, 0xe2, 0x0e, 0x94, 0x7c, 0x81, 0xcc, 0xf9, 0x42, 0xd9, 0x3d, 0x7a,
+	0xcc, 0x84, 0x22, 0xfc, 0x45, 0x22, 0x0e, 0x3c, 0xc4, 0xb2, 0xb7, 0xbb, 0xf7, 0x7e, 0xef, 0xff,
+	0xfe, 0xc3, 0x1f, 0xfc, 0xc0, 0x59, 0x48, 0xa8, 0x81, 0x13, 0x77, 0xf2, 0xcb, 0xc8, 0xc6, 0x86,
+	0x87, 0x23, 0x4c, 0x03, 0xaa, 0xc7, 0x09, 0x61, 0x44, 0xfe, 0xc4, 0x5d, 0x9d, 0xbb, 0x7a, 0x36,
+	0xee, 0xf5, 0x0e, 0xe8, 0xca, 0xe0, 0x6c, 0xef, 0xab, 0x47, 0x3c, 0xc2, 0x9f, 0x46, 0xf9, 0xaa,
+	0x54, 0xed, 0x51, 0x04, 0x9d, 0x79, 0x35, 0xf3, 0x8a, 0x21, 0x86, 0xe5, 0x3f, 0xa0, 0x1d, 0xa3,
+	0x04, 0x85, 0x54, 0x11, 0x07, 0xe2, 0x50, 0x9a, 0x7c, 0xd3, 0xdf, 0x77, 0xe8, 0x0b, 0xee, 0x9a,
+	0xad, 0x4d, 0xae, 0x0a, 0x56, 0xcd, 0xca, 0xff, 0x80, 0xc4, 0xc8, 0x0a, 0x47, 0x76, 0x8c, 0x82,
+	0x84, 0x2a, 0x8d, 0x41, 0x73, 0x28, 0x4d, 0xbe, 0x1f, 0x46, 0xaf, 0x4b, 0x64, 0x81, 0x82, 0xa4,
+	0x4e, 0x03, 0xb6, 0x17, 0xa8, 0xf6, 0xdc, 0x00, 0xed, 0x6a, 0xb4, 0xfc, 0x13, 0x74, 0x70, 0x84,
+	0x9c, 0x35, 0xb6, 0x79, 0x92, 0x2f, 0xf2, 0xc1, 0x92, 0x2a, 0x6d, 0x56, 0x4a, 0xf2, 0x5f, 0xf0,
+	0x79, 0x8f, 0x64, 0xa1, 0xed, 0x13, 0xb2, 0x52, 0x1a, 0x25, 0x65, 0x7e, 0x29, 0x72, 0xb5, 0x3b,
+	0xab, 0xc8, 0x9b, 0xcb, 0x73, 0x42, 0x56, 0x56, 0xb7, 0x0e, 0x66, 0x61, 0xf9, 0x95, 0x31, 0x50,
+	0x03, 0xc7, 0xb5, 0x51, 0xca, 0x88, 0xed, 0x92, 0x28, 0xc3, 0x09, 0xb3, 0x97, 0x01, 0x2d, 0x91,
+	0x65, 0xbd, 0x7e, 0x73, 0xd0, 0x1c, 0x7e, 0x34, 0xd5, 0x22, 0x57, 0xfb, 0x17, 0xe6, 0xf4, 0x7f,
+	0xca, 0xc8, 0xb4, 0x02, 0xcf, 0x6a, 0x8e, 0xaf, 0x6c, 0xf5, 0x03, 0xc7, 0x3d, 0x65, 0xca, 0x11,
+	0xd0, 0x4e, 0xd7, 0xb8, 0x3e, 0x8a, 0x22, 0xbc, 0xa6, 0x4a, 0x8b, 0x37, 0x69, 0x45, 0xae, 0xc2,
+	0xe3, 0x4d, 0xd3, 0x9a, 0xb4, 0xe0, 0xf1, 0xb2, 0xbd, 0x6f, 0xce, 0x37, 0x05, 0x14, 0xb7, 0x05,
+	0x14, 0x5f, 0x0b, 0x28, 0x3e, 0xed, 0xa0, 0xb0, 0xdd, 0x41, 0xe1, 0x65, 0x07, 0x85, 0xdb, 0x91,
+	0x17, 0x30, 0x3f, 0x75, 0x74, 0x97, 0x84, 0x86, 0x8f, 0x59, 0x3a, 0x8a, 0x13, 0x72, 0x87, 0x5d,
+	0xc6, 0x3f, 0xe5, 0x95, 0xdc, 0xd7, 0x07, 0xc3, 0x1e, 0x62, 0x4c, 0x9d, 0x36, 0x3f, 0x8c, 0xdf,
+	0x6f, 0x03, 0x00, 0x5e, 0x85, 0xab, 0x51, 0x7a, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -232,6 +257,24 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IBCAutoConvertDisabledChannels) > 0 {
+		for iNdEx := len(m.IBCAutoConvertDisabledChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IBCAutoConvertDisabledChannels[iNdEx])
+			copy(dAtA[i:], m.IBCAutoConvertDisabledChannels[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.IBCAutoConvertDisabledChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.IBCAutoConvertDisabledPairs) > 0 {
+		for iNdEx := len(m.IBCAutoConvertDisabledPairs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IBCAutoConvertDisabledPairs[iNdEx])
+			copy(dAtA[i:], m.IBCAutoConvertDisabledPairs[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.IBCAutoConvertDisabledPairs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.EnableEVMHook {
 		i--
 		if m.EnableEVMHook {
@@ -295,6 +338,18 @@ func (m *Params) Size() (n int) {
 	if m.EnableEVMHook {
 		n += 2
 	}
+	if len(m.IBCAutoConvertDisabledPairs) > 0 {
+		for _, s := range m.IBCAutoConvertDisabledPairs {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IBCAutoConvertDisabledChannels) > 0 {
+		for _, s := range m.IBCAutoConvertDisabledChannels {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -490,6 +545,70 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableEVMHook = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCAutoConvertDisabledPairs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCAutoConvertDisabledPairs = append(m.IBCAutoConvertDisabledPairs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCAutoConvertDisabledChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCAutoConvertDisabledChannels = append(m.IBCAutoConvertDisabledChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -17,6 +17,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -45,6 +46,9 @@ const (
 	prefixTokenPair = iota + 1
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
+	prefixIBCAutoConvertDisabledPair
+	prefixIBCAutoConvertDisabledChannel
+	prefixPacketERC20Amount
 )
 
 // KVStore key prefixes
@@ -52,4 +56,15 @@ var (
 	KeyPrefixTokenPair        = []byte{prefixTokenPair}
 	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
 	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+
+	KeyPrefixIBCAutoConvertDisabledPair    = []byte{prefixIBCAutoConvertDisabledPair}
+	KeyPrefixIBCAutoConvertDisabledChannel = []byte{prefixIBCAutoConvertDisabledChannel}
+
+	KeyPrefixPacketERC20Amount = []byte{prefixPacketERC20Amount}
 )
+
+// PacketKey returns the store key of an outgoing IBC packet identified by its
+// source port, source channel and sequence.
+func PacketKey(portID, channelID string, sequence uint64) []byte {
+	return append([]byte(portID+"/"+channelID+"/"), sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -18,6 +18,10 @@ package types
 
 import (
 	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // Parameter store key
@@ -30,10 +34,14 @@ var (
 func NewParams(
 	enableErc20 bool,
 	enableEVMHook bool,
+	ibcAutoConvertDisabledPairs []string,
+	ibcAutoConvertDisabledChannels []string,
 ) Params {
 	return Params{
-		EnableErc20:   enableErc20,
-		EnableEVMHook: enableEVMHook,
+		EnableErc20:                    enableErc20,
+		EnableEVMHook:                  enableEVMHook,
+		IBCAutoConvertDisabledPairs:    ibcAutoConvertDisabledPairs,
+		IBCAutoConvertDisabledChannels: ibcAutoConvertDisabledChannels,
 	}
 }
 
@@ -53,10 +61,57 @@ func ValidateBool(i interface{}) error {
 	return nil
 }
 
+// ValidateTokenIdentifiers checks that the token identifiers are valid hex
+// contract addresses or Cosmos coin denominations, without duplicates.
+func ValidateTokenIdentifiers(tokens []string) error {
+	seen := make(map[string]bool, len(tokens))
+	for _, token := range tokens {
+		if common.IsHexAddress(token) {
+			// normalize the address to detect duplicates with a different casing
+			token = common.HexToAddress(token).Hex()
+		} else if err := sdk.ValidateDenom(token); err != nil {
+			return fmt.Errorf("invalid token identifier %q: %w", token, err)
+		}
+
+		if seen[token] {
+			return fmt.Errorf("duplicated token identifier %s", token)
+		}
+		seen[token] = true
+	}
+
+	return nil
+}
+
+// ValidateChannelIdentifiers checks that the channel identifiers are valid,
+// without duplicates.
+func ValidateChannelIdentifiers(channels []string) error {
+	seen := make(map[string]bool, len(channels))
+	for _, channel := range channels {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return fmt.Errorf("invalid channel identifier %q: %w", channel, err)
+		}
+
+		if seen[channel] {
+			return fmt.Errorf("duplicated channel identifier %s", channel)
+		}
+		seen[channel] = true
+	}
+
+	return nil
+}
+
 func (p Params) Validate() error {
 	if err := ValidateBool(p.EnableEVMHook); err != nil {
 		return err
 	}
 
-	return ValidateBool(p.EnableErc20)
+	if err := ValidateBool(p.EnableErc20); err != nil {
+		return err
+	}
+
+	if err := ValidateTokenIdentifiers(p.IBCAutoConvertDisabledPairs); err != nil {
+		return err
+	}
+
+	return ValidateChannelIdentifiers(p.IBCAutoConvertDisabledChannels)
 }
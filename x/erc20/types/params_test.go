@@ -24,7 +24,7 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{"default", types.DefaultParams(), false},
 		{
 			"valid",
-			types.NewParams(true, true),
+			types.NewParams(true, true, nil, nil),
 			false,
 		},
 		{
@@ -32,6 +32,31 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			types.Params{},
 			false,
 		},
+		{
+			"valid auto convert opt-outs",
+			types.NewParams(true, true, []string{"uatom", "0xdAC17F958D2ee523a2206206994597C13D831ec7"}, []string{"channel-0"}),
+			false,
+		},
+		{
+			"invalid pair identifier",
+			types.NewParams(true, true, []string{"1atom"}, nil),
+			true,
+		},
+		{
+			"duplicated pair identifier",
+			types.NewParams(true, true, []string{"uatom", "uatom"}, nil),
+			true,
+		},
+		{
+			"invalid channel identifier",
+			types.NewParams(true, true, nil, []string{"chan"}),
+			true,
+		},
+		{
+			"duplicated channel identifier",
+			types.NewParams(true, true, nil, []string{"channel-0", "channel-0"}),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
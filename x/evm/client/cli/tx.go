@@ -20,9 +20,13 @@ import (
 	"fmt"
 	"os"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -31,6 +35,11 @@ import (
 	"github.com/hetu-project/hetu/v1/x/evm/types"
 )
 
+const (
+	flagAmount = "amount"
+	flagEVMGas = "evm-gas"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -40,7 +49,10 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand(NewRawTxCmd())
+	cmd.AddCommand(
+		NewRawTxCmd(),
+		NewCallContractCmd(),
+	)
 	return cmd
 }
 
@@ -124,3 +136,56 @@ func NewRawTxCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewCallContractCmd command calls a contract on behalf of the signer of a cosmos transaction
+func NewCallContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call-contract CONTRACT_ADDRESS INPUT_HEX",
+		Short: "Call a contract with the address of the cosmos signer as sender",
+		Long: `Call a contract with the address of the cosmos signer as sender, e.g. from a
+multisig with --generate-only, or through an authz grant wrapped in a MsgExec.`,
+		Example: "call-contract 0x... 0xa9059cbb... --amount 0 --evm-gas 200000 --from mykey",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address %s", args[0])
+			}
+
+			data, err := hexutil.Decode(args[1])
+			if err != nil {
+				return errors.Wrap(err, "failed to decode input hex bytes")
+			}
+
+			amountStr, err := cmd.Flags().GetString(flagAmount)
+			if err != nil {
+				return err
+			}
+			amount, ok := sdkmath.NewIntFromString(amountStr)
+			if !ok {
+				return fmt.Errorf("invalid amount %s", amountStr)
+			}
+
+			gasLimit, err := cmd.Flags().GetUint64(flagEVMGas)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCallContract(clientCtx.GetFromAddress(), common.HexToAddress(args[0]), data, amount, gasLimit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAmount, "0", "Value in the EVM denomination transferred to the contract")
+	cmd.Flags().Uint64(flagEVMGas, 300000, "Maximum EVM gas consumed by the call")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,163 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package fork
+
+import (
+	"math/big"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/hetu-project/hetu/v1/x/evm/keeper"
+	"github.com/hetu-project/hetu/v1/x/evm/statedb"
+	"github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+// override is a change of an account state requested through the JSON-RPC,
+// applied at the beginning of the next block.
+type override struct {
+	address common.Address
+	balance *big.Int
+	code    []byte
+}
+
+// Controller holds the development controls of a forked chain: the
+// impersonated accounts and the account overrides waiting for the next block.
+//
+// NOTE: the overrides are applied outside of any transaction, so the blocks of
+// a forked chain can't be replayed by another node.
+type Controller struct {
+	source types.ForkSource
+
+	mu           sync.RWMutex
+	impersonated map[common.Address]struct{}
+	overrides    []override
+	miner        func() (int64, error)
+}
+
+// NewController creates the controller of a chain forked from the given source.
+func NewController(source types.ForkSource) *Controller {
+	return &Controller{
+		source:       source,
+		impersonated: make(map[common.Address]struct{}),
+	}
+}
+
+// Source returns the source of the forked state.
+func (c *Controller) Source() types.ForkSource {
+	return c.source
+}
+
+// ImpersonateAccount accepts the transactions sent by the address without
+// verifying their signature.
+func (c *Controller) ImpersonateAccount(addr common.Address) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.impersonated[addr] = struct{}{}
+}
+
+// StopImpersonatingAccount verifies again the signature of the transactions
+// sent by the address.
+func (c *Controller) StopImpersonatingAccount(addr common.Address) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.impersonated, addr)
+}
+
+// IsImpersonated returns true if the address is impersonated. It is the
+// impersonation hook installed with Keeper.SetImpersonationHook.
+func (c *Controller) IsImpersonated(addr common.Address) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	_, ok := c.impersonated[addr]
+	return ok
+}
+
+// SetBalance sets the balance of the account at the next block.
+func (c *Controller) SetBalance(addr common.Address, balance *big.Int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.overrides = append(c.overrides, override{address: addr, balance: new(big.Int).Set(balance)})
+}
+
+// SetCode sets the code of the account at the next block.
+func (c *Controller) SetCode(addr common.Address, code []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.overrides = append(c.overrides, override{address: addr, code: common.CopyBytes(code)})
+}
+
+// PendingOverrides returns the number of overrides waiting for the next block.
+func (c *Controller) PendingOverrides() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.overrides)
+}
+
+// SetMiner sets the function sealing a block on demand and returning its
+// height, when the chain doesn't produce blocks on its own.
+func (c *Controller) SetMiner(miner func() (int64, error)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.miner = miner
+}
+
+// Miner returns the function sealing a block on demand, nil if not set.
+func (c *Controller) Miner() func() (int64, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.miner
+}
+
+// ApplyOverrides applies the pending overrides in the requested order. It is
+// called at the beginning of every block.
+func (c *Controller) ApplyOverrides(ctx sdk.Context, k *keeper.Keeper) error {
+	c.mu.Lock()
+	overrides := c.overrides
+	c.overrides = nil
+	c.mu.Unlock()
+
+	for _, o := range overrides {
+		// the account is loaded from the fork source before being overridden
+		account := k.GetAccount(ctx, o.address)
+		if account == nil {
+			account = statedb.NewEmptyAccount()
+		}
+
+		if o.balance != nil {
+			account.Balance = o.balance
+		}
+		if o.code != nil {
+			codeHash := crypto.Keccak256Hash(o.code)
+			if len(o.code) == 0 {
+				codeHash = common.BytesToHash(types.EmptyCodeHash)
+			} else {
+				k.SetCode(ctx, codeHash.Bytes(), o.code)
+			}
+			account.CodeHash = codeHash.Bytes()
+		}
+
+		if err := k.SetAccount(ctx, o.address, *account); err != nil {
+			return err
+		}
+
+		k.Logger(ctx).Info("applied fork override", "address", o.address.Hex(), "balance", o.balance, "code-size", len(o.code))
+	}
+
+	return nil
+}
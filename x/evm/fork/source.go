@@ -0,0 +1,207 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package fork
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+// requestTimeout is the timeout of a single query to the remote node.
+const requestTimeout = 30 * time.Second
+
+var _ types.ForkSource = (*GRPCSource)(nil)
+
+type storageKey struct {
+	address common.Address
+	key     common.Hash
+}
+
+// GRPCSource serves the state of a remote node at a fixed height through the
+// evm gRPC queries. The results are cached so that each account, code and
+// storage slot is only queried once.
+type GRPCSource struct {
+	conn        *grpc.ClientConn
+	queryClient types.QueryClient
+	height      int64
+
+	mu       sync.Mutex
+	accounts map[common.Address]*types.ForkAccount
+	codes    map[common.Address][]byte
+	storages map[storageKey]common.Hash
+}
+
+// NewGRPCSource connects to the gRPC endpoint of the remote node. The state is
+// served at the given height, or at the latest height of the remote node if
+// it is zero.
+func NewGRPCSource(endpoint string, height int64, interfaceRegistry codectypes.InterfaceRegistry) (*GRPCSource, error) {
+	if height < 0 {
+		return nil, fmt.Errorf("invalid fork height %d", height)
+	}
+
+	conn, err := grpc.NewClient(
+		endpoint,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(interfaceRegistry).GRPCCodec())),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if height == 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		defer cancel()
+
+		res, err := cmtservice.NewServiceClient(conn).GetLatestBlock(ctx, &cmtservice.GetLatestBlockRequest{})
+		if err != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("failed to query the latest height of %s: %w", endpoint, err)
+		}
+		if res.SdkBlock != nil {
+			height = res.SdkBlock.Header.Height
+		} else {
+			height = res.Block.Header.Height //nolint:staticcheck // deprecated field still set by older nodes
+		}
+	}
+
+	return &GRPCSource{
+		conn:        conn,
+		queryClient: types.NewQueryClient(conn),
+		height:      height,
+		accounts:    make(map[common.Address]*types.ForkAccount),
+		codes:       make(map[common.Address][]byte),
+		storages:    make(map[storageKey]common.Hash),
+	}, nil
+}
+
+// Height returns the height of the remote node the state is served at.
+func (s *GRPCSource) Height() int64 {
+	return s.height
+}
+
+// Close closes the connection to the remote node.
+func (s *GRPCSource) Close() error {
+	return s.conn.Close()
+}
+
+// context returns the context of a query at the fork height.
+func (s *GRPCSource) context() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(s.height, 10))
+	return ctx, cancel
+}
+
+// GetAccount implements types.ForkSource. Empty remote accounts are returned as nil.
+func (s *GRPCSource) GetAccount(addr common.Address) (*types.ForkAccount, error) {
+	s.mu.Lock()
+	account, found := s.accounts[addr]
+	s.mu.Unlock()
+	if found {
+		return account, nil
+	}
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	res, err := s.queryClient.Account(ctx, &types.QueryAccountRequest{Address: addr.Hex()})
+	if err != nil {
+		return nil, err
+	}
+
+	balance, ok := new(big.Int).SetString(res.Balance, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid balance %s of account %s", res.Balance, addr.Hex())
+	}
+
+	codeHash := common.HexToHash(res.CodeHash)
+	if balance.Sign() != 0 || res.Nonce != 0 || (codeHash != common.BytesToHash(types.EmptyCodeHash) && codeHash != common.Hash{}) {
+		account = &types.ForkAccount{
+			Nonce:    res.Nonce,
+			Balance:  balance,
+			CodeHash: codeHash,
+		}
+	}
+
+	s.mu.Lock()
+	s.accounts[addr] = account
+	s.mu.Unlock()
+	return account, nil
+}
+
+// GetCode implements types.ForkSource.
+func (s *GRPCSource) GetCode(addr common.Address) ([]byte, error) {
+	s.mu.Lock()
+	code, found := s.codes[addr]
+	s.mu.Unlock()
+	if found {
+		return code, nil
+	}
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	res, err := s.queryClient.Code(ctx, &types.QueryCodeRequest{Address: addr.Hex()})
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.codes[addr] = res.Code
+	s.mu.Unlock()
+	return res.Code, nil
+}
+
+// GetStorage implements types.ForkSource.
+func (s *GRPCSource) GetStorage(addr common.Address, key common.Hash) (common.Hash, error) {
+	sk := storageKey{address: addr, key: key}
+
+	s.mu.Lock()
+	value, found := s.storages[sk]
+	s.mu.Unlock()
+	if found {
+		return value, nil
+	}
+
+	ctx, cancel := s.context()
+	defer cancel()
+
+	res, err := s.queryClient.Storage(ctx, &types.QueryStorageRequest{Address: addr.Hex(), Key: key.Hex()})
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	value = common.HexToHash(res.Value)
+
+	s.mu.Lock()
+	s.storages[sk] = value
+	s.mu.Unlock()
+	return value, nil
+}
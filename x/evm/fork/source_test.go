@@ -0,0 +1,102 @@
+package fork_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/hetu-project/hetu/v1/encoding"
+	"github.com/hetu-project/hetu/v1/x/evm/fork"
+	"github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+var (
+	contract = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	code     = []byte{0x60, 0x00}
+	slot     = common.HexToHash("0x01")
+)
+
+// queryServer serves a single contract and counts the queries at each height.
+type queryServer struct {
+	types.UnimplementedQueryServer
+	queries map[string]int
+}
+
+func (s *queryServer) count(ctx context.Context) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, height := range md.Get(grpctypes.GRPCBlockHeightHeader) {
+		s.queries[height]++
+	}
+}
+
+func (s *queryServer) Account(ctx context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
+	s.count(ctx)
+	if common.HexToAddress(req.Address) != contract {
+		return &types.QueryAccountResponse{Balance: "0", CodeHash: common.BytesToHash(types.EmptyCodeHash).Hex()}, nil
+	}
+	return &types.QueryAccountResponse{Balance: "100", CodeHash: common.BytesToHash(code).Hex(), Nonce: 1}, nil
+}
+
+func (s *queryServer) Code(ctx context.Context, _ *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
+	s.count(ctx)
+	return &types.QueryCodeResponse{Code: code}, nil
+}
+
+func (s *queryServer) Storage(ctx context.Context, req *types.QueryStorageRequest) (*types.QueryStorageResponse, error) {
+	s.count(ctx)
+	value := common.Hash{}
+	if common.HexToHash(req.Key) == slot {
+		value = common.HexToHash("0x2a")
+	}
+	return &types.QueryStorageResponse{Value: value.Hex()}, nil
+}
+
+func TestGRPCSource(t *testing.T) {
+	encodingConfig := encoding.MakeConfig()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &queryServer{queries: make(map[string]int)}
+	grpcSrv := grpc.NewServer(grpc.ForceServerCodec(codec.NewProtoCodec(encodingConfig.InterfaceRegistry).GRPCCodec()))
+	types.RegisterQueryServer(grpcSrv, server)
+	go func() { _ = grpcSrv.Serve(listener) }()
+	t.Cleanup(grpcSrv.Stop)
+
+	_, err = fork.NewGRPCSource(listener.Addr().String(), -1, encodingConfig.InterfaceRegistry)
+	require.Error(t, err)
+
+	source, err := fork.NewGRPCSource(listener.Addr().String(), 10, encodingConfig.InterfaceRegistry)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = source.Close() })
+	require.Equal(t, int64(10), source.Height())
+
+	for i := 0; i < 2; i++ {
+		account, err := source.GetAccount(contract)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), account.Nonce)
+		require.Equal(t, int64(100), account.Balance.Int64())
+
+		empty, err := source.GetAccount(common.HexToAddress("0x02"))
+		require.NoError(t, err)
+		require.Nil(t, empty)
+
+		remoteCode, err := source.GetCode(contract)
+		require.NoError(t, err)
+		require.Equal(t, code, remoteCode)
+
+		value, err := source.GetStorage(contract, slot)
+		require.NoError(t, err)
+		require.Equal(t, common.HexToHash("0x2a"), value)
+	}
+
+	// every query is made once at the fork height
+	require.Equal(t, map[string]int{"10": 4}, server.queries)
+}
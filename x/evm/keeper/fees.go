@@ -103,6 +103,10 @@ func VerifyFee(
 		)
 	}
 
+	if setCodeTx, ok := txData.(*types.SetCodeTx); ok {
+		intrinsicGas += uint64(len(setCodeTx.Authorizations)) * types.PerEmptyAccountCost
+	}
+
 	// intrinsic gas verification during CheckTx
 	if isCheckTx && gasLimit < intrinsicGas {
 		return nil, errorsmod.Wrapf(
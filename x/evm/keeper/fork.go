@@ -0,0 +1,208 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/hetu-project/hetu/v1/x/evm/statedb"
+	"github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+// SetForkSource configures the keeper to load accounts, code and storage from
+// the given source the first time they are read. It is meant for local
+// development chains forking a remote network and must not be used on a
+// network that requires consensus between several nodes.
+func (k *Keeper) SetForkSource(source types.ForkSource) {
+	k.forkSource = source
+}
+
+// ForkSource returns the source the keeper forks from, nil if it isn't forked.
+func (k *Keeper) ForkSource() types.ForkSource {
+	return k.forkSource
+}
+
+// SetImpersonationHook installs the function deciding whether an address is
+// impersonated. Transactions from impersonated addresses carry the signature
+// returned by types.ImpersonationSignature instead of a valid ECDSA signature.
+//
+// NOTE: this must never be set on a public network since it disables the
+// signature verification of the impersonated accounts.
+func (k *Keeper) SetImpersonationHook(fn func(common.Address) bool) {
+	k.isImpersonated = fn
+}
+
+// IsImpersonated returns true if the impersonation hook is set and accepts
+// the given address.
+func (k Keeper) IsImpersonated(addr common.Address) bool {
+	return k.isImpersonated != nil && k.isImpersonated(addr)
+}
+
+// ImpersonatedSender returns the sender of a transaction signed with
+// types.ImpersonationSignature, if the sender is impersonated.
+func (k Keeper) ImpersonatedSender(tx *ethtypes.Transaction) (common.Address, bool) {
+	from, ok := types.ImpersonatedSender(tx)
+	if !ok || !k.IsImpersonated(from) {
+		return common.Address{}, false
+	}
+	return from, true
+}
+
+// AsMessage creates the core message of the Ethereum tx msg, accepting the
+// transactions of the impersonated accounts.
+func (k Keeper) AsMessage(msg *types.MsgEthereumTx, signer ethtypes.Signer, baseFee *big.Int) (core.Message, error) {
+	tx := msg.AsTransaction()
+	if from, ok := k.ImpersonatedSender(tx); ok {
+		return types.NewImpersonatedMessage(tx, from, baseFee), nil
+	}
+	return msg.AsMessage(signer, baseFee)
+}
+
+// forkErrorsKey is the context key of the forkErrors recording the failed
+// loads of the forked state.
+type forkErrorsKey struct{}
+
+// forkErrors holds the first failed load of the forked state.
+type forkErrors struct {
+	err error
+}
+
+// trackForkErrors returns a context recording the failed loads of the forked
+// state, and a function returning the first of them. The txs and queries
+// check it so that they fail instead of running on the local state when the
+// remote node is unreachable.
+func (k *Keeper) trackForkErrors(ctx sdk.Context) (sdk.Context, func() error) {
+	if k.forkSource == nil {
+		return ctx, func() error { return nil }
+	}
+
+	errs, ok := ctx.Value(forkErrorsKey{}).(*forkErrors)
+	if !ok {
+		errs = &forkErrors{}
+		ctx = ctx.WithValue(forkErrorsKey{}, errs)
+	}
+	return ctx, func() error { return errs.err }
+}
+
+// forkError logs the failed load of the forked state and records it on the
+// context, if it is tracked.
+func (k *Keeper) forkError(ctx sdk.Context, err error, msg string, keyvals ...interface{}) {
+	k.Logger(ctx).Error(msg, append(keyvals, "error", err.Error())...)
+
+	if errs, ok := ctx.Value(forkErrorsKey{}).(*forkErrors); ok && errs.err == nil {
+		errs.err = errorsmod.Wrap(types.ErrForkState, err.Error())
+	}
+}
+
+// forkContext returns a context that neither consumes gas nor emits events,
+// so that loading the forked state doesn't alter the execution of the tx
+// that first reads it.
+func forkContext(ctx sdk.Context) sdk.Context {
+	return ctx.
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithKVGasConfig(storetypes.GasConfig{}).
+		WithTransientKVGasConfig(storetypes.GasConfig{}).
+		WithEventManager(sdk.NewEventManager())
+}
+
+// loadForkAccount loads the account of the given address from the fork
+// source, if it hasn't been loaded yet. The remote balance is added to the
+// local one and the remote nonce and code replace the local ones when greater
+// or unset.
+func (k *Keeper) loadForkAccount(ctx sdk.Context, addr common.Address) {
+	if k.forkSource == nil {
+		return
+	}
+
+	ctx = forkContext(ctx)
+	key := types.ForkAccountKey(addr)
+	if ctx.KVStore(k.storeKey).Has(key) {
+		return
+	}
+
+	remote, err := k.forkSource.GetAccount(addr)
+	if err != nil {
+		// don't mark the account as loaded so that the next read retries
+		k.forkError(ctx, err, "failed to load forked account", "address", addr.Hex())
+		return
+	}
+
+	var code []byte
+	if remote != nil && remote.CodeHash != common.BytesToHash(types.EmptyCodeHash) && remote.CodeHash != (common.Hash{}) {
+		if code, err = k.forkSource.GetCode(addr); err != nil {
+			k.forkError(ctx, err, "failed to load forked code", "address", addr.Hex())
+			return
+		}
+	}
+
+	ctx.KVStore(k.storeKey).Set(key, []byte{1})
+	if remote == nil {
+		return
+	}
+
+	account := statedb.NewEmptyAccount()
+	if local := k.GetAccountWithoutBalance(ctx, addr); local != nil {
+		account = local
+	}
+	account.Balance = new(big.Int).Add(k.GetBalance(ctx, addr), remote.Balance)
+	if remote.Nonce > account.Nonce {
+		account.Nonce = remote.Nonce
+	}
+	if len(code) > 0 && !account.IsContract() {
+		codeHash := crypto.Keccak256Hash(code)
+		k.SetCode(ctx, codeHash.Bytes(), code)
+		account.CodeHash = codeHash.Bytes()
+	}
+
+	if err := k.SetAccount(ctx, addr, *account); err != nil {
+		k.forkError(ctx, err, "failed to store forked account", "address", addr.Hex())
+	}
+}
+
+// loadForkState loads the storage slot of the given address from the fork
+// source, if it hasn't been loaded yet. Slots written locally before being
+// read keep their local value.
+func (k *Keeper) loadForkState(ctx sdk.Context, addr common.Address, key common.Hash) {
+	if k.forkSource == nil {
+		return
+	}
+
+	ctx = forkContext(ctx)
+	store := ctx.KVStore(k.storeKey)
+	forkKey := types.ForkStateKey(addr, key)
+	if store.Has(forkKey) {
+		return
+	}
+
+	value, err := k.forkSource.GetStorage(addr, key)
+	if err != nil {
+		k.forkError(ctx, err, "failed to load forked state", "address", addr.Hex(), "key", key.Hex())
+		return
+	}
+
+	store.Set(forkKey, []byte{1})
+	if value != (common.Hash{}) && !store.Has(types.StateKey(addr, key.Bytes())) {
+		k.SetState(ctx, addr, key, value.Bytes())
+	}
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	utiltx "github.com/hetu-project/hetu/v1/testutil/tx"
+	"github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+// forkSource serves a single remote contract and counts the remote queries.
+type forkSource struct {
+	contract common.Address
+	code     []byte
+	queries  int
+	err      error
+}
+
+func (s *forkSource) GetAccount(addr common.Address) (*types.ForkAccount, error) {
+	s.queries++
+	if s.err != nil {
+		return nil, s.err
+	}
+	if addr != s.contract {
+		return nil, nil
+	}
+	return &types.ForkAccount{Nonce: 5, Balance: big.NewInt(100), CodeHash: crypto.Keccak256Hash(s.code)}, nil
+}
+
+func (s *forkSource) GetCode(common.Address) ([]byte, error) {
+	s.queries++
+	return s.code, nil
+}
+
+func (s *forkSource) GetStorage(_ common.Address, key common.Hash) (common.Hash, error) {
+	s.queries++
+	if s.err != nil {
+		return common.Hash{}, s.err
+	}
+	return common.BigToHash(new(big.Int).Add(key.Big(), big.NewInt(1))), nil
+}
+
+func (suite *KeeperTestSuite) TestForkSource() {
+	suite.SetupTest()
+	source := &forkSource{contract: utiltx.GenerateAddress(), code: []byte{0x60, 0x00}}
+	suite.app.EvmKeeper.SetForkSource(source)
+	defer suite.app.EvmKeeper.SetForkSource(nil)
+
+	// the remote account is loaded on first read without consuming gas
+	gasConsumed := suite.ctx.GasMeter().GasConsumed()
+	account := suite.app.EvmKeeper.GetAccount(suite.ctx, source.contract)
+	loadGas := suite.ctx.GasMeter().GasConsumed() - gasConsumed
+	suite.Require().NotNil(account)
+	suite.Require().Equal(uint64(5), account.Nonce)
+	suite.Require().Equal(int64(100), account.Balance.Int64())
+	suite.Require().Equal(source.code, suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(account.CodeHash)))
+	suite.Require().Equal(2, source.queries)
+
+	gasConsumed = suite.ctx.GasMeter().GasConsumed()
+	suite.app.EvmKeeper.GetAccount(suite.ctx, source.contract)
+	suite.Require().Equal(loadGas, suite.ctx.GasMeter().GasConsumed()-gasConsumed)
+	suite.Require().Equal(2, source.queries)
+
+	// the remote storage is loaded once and the local changes are kept
+	key := common.BigToHash(big.NewInt(7))
+	suite.Require().Equal(common.BigToHash(big.NewInt(8)), suite.app.EvmKeeper.GetState(suite.ctx, source.contract, key))
+	suite.app.EvmKeeper.SetState(suite.ctx, source.contract, key, nil)
+	suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, source.contract, key))
+	suite.Require().Equal(3, source.queries)
+
+	suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, source.contract, big.NewInt(1)))
+	suite.Require().Equal(int64(1), suite.app.EvmKeeper.GetBalance(suite.ctx, source.contract).Int64())
+
+	// accounts missing on the remote node are only queried once
+	missing := utiltx.GenerateAddress()
+	suite.Require().Nil(suite.app.EvmKeeper.GetAccount(suite.ctx, missing))
+	suite.Require().Nil(suite.app.EvmKeeper.GetAccount(suite.ctx, missing))
+	suite.Require().Equal(4, source.queries)
+}
+
+func (suite *KeeperTestSuite) TestForkSourceError() {
+	suite.SetupTest()
+	source := &forkSource{contract: utiltx.GenerateAddress(), code: []byte{0x60, 0x00}, err: errors.New("connection refused")}
+	suite.app.EvmKeeper.SetForkSource(source)
+	defer suite.app.EvmKeeper.SetForkSource(nil)
+
+	// the queries fail instead of returning the local state
+	_, err := suite.app.EvmKeeper.Balance(suite.ctx, &types.QueryBalanceRequest{Address: source.contract.Hex()})
+	suite.Require().Equal(codes.Unavailable, status.Code(err))
+	suite.Require().ErrorContains(err, "connection refused")
+
+	_, err = suite.app.EvmKeeper.Storage(suite.ctx, &types.QueryStorageRequest{Address: source.contract.Hex(), Key: common.Hash{}.Hex()})
+	suite.Require().Equal(codes.Unavailable, status.Code(err))
+
+	// the messages fail too
+	msg := ethtypes.NewMessage(suite.address, &source.contract, 0, big.NewInt(0), 100000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, false)
+	_, err = suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, false)
+	suite.Require().ErrorIs(err, types.ErrForkState)
+
+	// the failed loads are retried once the remote node is reachable
+	source.err = nil
+	res, err := suite.app.EvmKeeper.Balance(suite.ctx, &types.QueryBalanceRequest{Address: source.contract.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal("100", res.Balance)
+}
+
+func (suite *KeeperTestSuite) TestImpersonation() {
+	suite.SetupTest()
+	from := utiltx.GenerateAddress()
+	to := utiltx.GenerateAddress()
+
+	tx, err := ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(10), Gas: 21000, To: &to, Value: big.NewInt(1)}).
+		WithSignature(suite.ethSigner, types.ImpersonationSignature(from))
+	suite.Require().NoError(err)
+	msg := &types.MsgEthereumTx{}
+	suite.Require().NoError(msg.FromEthereumTx(tx))
+
+	// the impersonation signature is rejected unless the keeper impersonates the sender
+	_, ok := suite.app.EvmKeeper.ImpersonatedSender(tx)
+	suite.Require().False(ok)
+	_, err = suite.app.EvmKeeper.AsMessage(msg, suite.ethSigner, nil)
+	suite.Require().Error(err)
+
+	suite.app.EvmKeeper.SetImpersonationHook(func(addr common.Address) bool { return addr == from })
+	defer suite.app.EvmKeeper.SetImpersonationHook(nil)
+	suite.Require().True(suite.app.EvmKeeper.IsImpersonated(from))
+	suite.Require().False(suite.app.EvmKeeper.IsImpersonated(to))
+
+	sender, ok := suite.app.EvmKeeper.ImpersonatedSender(tx)
+	suite.Require().True(ok)
+	suite.Require().Equal(from, sender)
+
+	coreMsg, err := suite.app.EvmKeeper.AsMessage(msg, suite.ethSigner, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(from, coreMsg.From())
+	suite.Require().Equal(&to, coreMsg.To())
+}
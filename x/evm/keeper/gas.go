@@ -37,7 +37,17 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 	istanbul := cfg.IsIstanbul(height)
 	// shanghai := cfg.IsShanghai(height.Uint64())
 
-	return core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	intrinsicGas, err := core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
+	if err != nil {
+		return 0, err
+	}
+
+	if setCodeMsg, ok := msg.(interface {
+		AuthorizationList() []types.SetCodeAuthorization
+	}); ok {
+		intrinsicGas += uint64(len(setCodeMsg.AuthorizationList())) * types.PerEmptyAccountCost
+	}
+	return intrinsicGas, nil
 }
 
 // RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
@@ -64,8 +64,11 @@ func (k Keeper) Account(c context.Context, req *types.QueryAccountRequest) (*typ
 
 	addr := common.HexToAddress(req.Address)
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx, forkErr := k.trackForkErrors(sdk.UnwrapSDKContext(c))
 	acct := k.GetAccountOrEmpty(ctx, addr)
+	if err := forkErr(); err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
 
 	return &types.QueryAccountResponse{
 		Balance:  acct.Balance.String(),
@@ -151,9 +154,12 @@ func (k Keeper) Balance(c context.Context, req *types.QueryBalanceRequest) (*typ
 		)
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx, forkErr := k.trackForkErrors(sdk.UnwrapSDKContext(c))
 
 	balanceInt := k.GetBalance(ctx, common.HexToAddress(req.Address))
+	if err := forkErr(); err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
 
 	return &types.QueryBalanceResponse{
 		Balance: balanceInt.String(),
@@ -173,12 +179,15 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 		)
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx, forkErr := k.trackForkErrors(sdk.UnwrapSDKContext(c))
 
 	address := common.HexToAddress(req.Address)
 	key := common.HexToHash(req.Key)
 
 	state := k.GetState(ctx, address, key)
+	if err := forkErr(); err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
 	stateHex := state.Hex()
 
 	return &types.QueryStorageResponse{
@@ -199,10 +208,13 @@ func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.Que
 		)
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx, forkErr := k.trackForkErrors(sdk.UnwrapSDKContext(c))
 
 	address := common.HexToAddress(req.Address)
 	acct := k.GetAccountWithoutBalance(ctx, address)
+	if err := forkErr(); err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
 
 	var code []byte
 	if acct != nil && acct.IsContract() {
@@ -426,12 +438,11 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
 	for i, tx := range req.Predecessors {
-		ethTx := tx.AsTransaction()
-		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+		msg, err := k.AsMessage(tx, signer, cfg.BaseFee)
 		if err != nil {
 			continue
 		}
-		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxHash = tx.TxHash()
 		txConfig.TxIndex = uint(i)
 		rsp, err := k.ApplyMessageWithConfig(ctx, msg, types.NewNoOpTracer(), true, cfg, txConfig)
 		if err != nil {
@@ -440,8 +451,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		txConfig.LogIndex += uint(len(rsp.Logs))
 	}
 
-	tx := req.Msg.AsTransaction()
-	txConfig.TxHash = tx.Hash()
+	txConfig.TxHash = req.Msg.TxHash()
 	if len(req.Predecessors) > 0 {
 		txConfig.TxIndex++
 	}
@@ -452,7 +462,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
 	}
 
-	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, false, tracerConfig)
+	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, req.Msg, req.TraceConfig, false, tracerConfig)
 	if err != nil {
 		// error will be returned with detail status from traceTx
 		return nil, err
@@ -507,10 +517,9 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
 	for i, tx := range req.Txs {
 		result := types.TxTraceResult{}
-		ethTx := tx.AsTransaction()
-		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxHash = tx.TxHash()
 		txConfig.TxIndex = uint(i)
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
+		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, true, nil)
 		if err != nil {
 			result.Error = err.Error()
 		} else {
@@ -536,7 +545,7 @@ func (k *Keeper) traceTx(
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
 	signer ethtypes.Signer,
-	tx *ethtypes.Transaction,
+	tx *types.MsgEthereumTx,
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
@@ -548,7 +557,7 @@ func (k *Keeper) traceTx(
 		err       error
 		timeout   = defaultTraceTimeout
 	)
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	msg, err := k.AsMessage(tx, signer, cfg.BaseFee)
 	if err != nil {
 		return nil, 0, status.Error(codes.Internal, err.Error())
 	}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"strconv"
 
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -28,10 +29,15 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/hashicorp/go-metrics"
 
+	"github.com/hetu-project/hetu/v1/x/evm/statedb"
 	"github.com/hetu-project/hetu/v1/x/evm/types"
 )
 
@@ -48,8 +54,17 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 	tx := msg.AsTransaction()
 	txIndex := k.GetTxIndexTransient(ctx)
 
+	txType := tx.Type()
+	txData, err := types.UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to unpack tx data")
+	}
+	if txData.TxType() == types.SetCodeTxType {
+		txType = types.SetCodeTxType
+	}
+
 	labels := []metrics.Label{
-		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", tx.Type())),
+		telemetry.NewLabel("tx_type", fmt.Sprintf("%d", txType)),
 	}
 	if tx.To() == nil {
 		labels = append(labels, telemetry.NewLabel("execution", "create"))
@@ -57,11 +72,23 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		labels = append(labels, telemetry.NewLabel("execution", "call"))
 	}
 
-	response, err := k.ApplyTransaction(ctx, tx)
+	var response *types.MsgEthereumTxResponse
+	if txType == types.SetCodeTxType {
+		response, err = k.ApplySetCodeTransaction(ctx, msg)
+	} else {
+		response, err = k.ApplyTransaction(ctx, tx)
+	}
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply transaction")
 	}
 
+	// the messages of a batch share the state cache of the cosmos tx, returning an
+	// error discards the changes of the messages executed before this one.
+	batchID, isBatch := types.BatchIDFromContext(ctx)
+	if isBatch && response.Failed() {
+		return nil, errorsmod.Wrapf(types.ErrBatchReverted, "batch %s, tx %s: %s", batchID.Hex(), response.Hash, response.VmError)
+	}
+
 	defer func() {
 		telemetry.IncrCounterWithLabels(
 			[]string{"tx", "msg", "ethereum_tx", "total"},
@@ -114,6 +141,10 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEthereumTxFailed, response.VmError))
 	}
 
+	if isBatch {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEthereumBatchID, batchID.Hex()))
+	}
+
 	txLogAttrs := make([]sdk.Attribute, len(response.Logs))
 	for i, log := range response.Logs {
 		value, err := json.Marshal(log)
@@ -137,13 +168,100 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, sender),
-			sdk.NewAttribute(types.AttributeKeyTxType, fmt.Sprintf("%d", tx.Type())),
+			sdk.NewAttribute(types.AttributeKeyTxType, fmt.Sprintf("%d", txType)),
 		),
 	})
 
 	return response, nil
 }
 
+// CallContract implements the gRPC MsgServer interface. It calls a contract with the address mapped
+// from the Cosmos signer as EVM sender, so that accounts which can't sign Ethereum transactions (e.g.
+// multisigs, interchain accounts or authz granters) can interact with contracts. The gas limit can't
+// exceed the gas left in the Cosmos tx, the EVM gas used is consumed from the Cosmos gas meter and
+// the call is reverted if the execution fails.
+func (k *Keeper) CallContract(goCtx context.Context, msg *types.MsgCallContract) (*types.MsgCallContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+	from := common.BytesToAddress(sender)
+	contract := common.HexToAddress(msg.Contract)
+
+	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load evm config")
+	}
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	nonce := k.GetNonce(ctx, from)
+
+	// the EVM runs on an infinite gas meter, its gas limit is bounded by the gas left in the
+	// Cosmos tx so that the gas used can always be consumed afterwards
+	if gasLeft := ctx.GasMeter().GasRemaining(); msg.GasLimit > gasLeft {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidGasLimit,
+			"gas limit %d exceeds the gas left in the transaction %d", msg.GasLimit, gasLeft,
+		)
+	}
+
+	coreMsg := ethtypes.NewMessage(
+		from,
+		&contract,
+		nonce,
+		msg.Amount.BigInt(),
+		msg.GasLimit,
+		big.NewInt(0), // gasPrice
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		msg.Data,
+		ethtypes.AccessList{},
+		false, // isFake
+	)
+
+	// the EVM gas is consumed instead of the store reads and writes, as for ethereum txs
+	evmCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithKVGasConfig(storetypes.GasConfig{}).
+		WithTransientKVGasConfig(storetypes.GasConfig{})
+
+	res, err := k.ApplyMessageWithConfig(evmCtx, coreMsg, nil, true, cfg, txConfig)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to apply contract call")
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "evm contract call")
+
+	if res.Failed() {
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			return nil, errorsmod.Wrap(types.ErrVMExecution, types.NewExecErrorWithReason(res.Ret).Error())
+		}
+		return nil, errorsmod.Wrap(types.ErrVMExecution, res.VmError)
+	}
+
+	// the logs are only returned in the response: they don't belong to an ethereum tx, so
+	// they are not emitted as tx log events which are indexed for the JSON-RPC filters
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCallContract,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, contract.Hex()),
+			sdk.NewAttribute(types.AttributeKeyTxGasUsed, strconv.FormatUint(res.GasUsed, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgCallContractResponse{
+		Ret:     res.Ret,
+		Logs:    res.Logs,
+		GasUsed: res.GasUsed,
+	}, nil
+}
+
 // UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
 // proposal passes, it updates the module parameters. The update can only be
 // performed if the requested authority is the Cosmos SDK governance module
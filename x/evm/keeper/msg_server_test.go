@@ -2,14 +2,21 @@ package keeper_test
 
 import (
 	"math/big"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
+	utiltx "github.com/hetu-project/hetu/v1/testutil/tx"
 	"github.com/hetu-project/hetu/v1/x/evm/statedb"
 	"github.com/hetu-project/hetu/v1/x/evm/types"
+	vestingtypes "github.com/hetu-project/hetu/v1/x/vesting/types"
 )
 
 func (suite *KeeperTestSuite) TestEthereumTx() {
@@ -76,6 +83,184 @@ func (suite *KeeperTestSuite) TestEthereumTx() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthereumTxBatch() {
+	signer := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+
+	// init code reverting the contract creation: PUSH1 0 PUSH1 0 REVERT
+	// the gas is free since no fees are deducted without the ante handler
+	revertingTx := func(nonce uint64) *types.MsgEthereumTx {
+		ethTx := ethtypes.NewTx(&ethtypes.AccessListTx{
+			GasPrice: big.NewInt(0),
+			Gas:      100000,
+			Data:     common.FromHex("0x60006000fd"),
+			Nonce:    nonce,
+		})
+		msg := &types.MsgEthereumTx{}
+		suite.Require().NoError(msg.FromEthereumTx(ethTx))
+		msg.From = suite.address.Hex()
+		suite.Require().NoError(msg.Sign(signer, suite.signer))
+		return msg
+	}
+
+	testCases := []struct {
+		name    string
+		batch   bool
+		expErr  bool
+		expFail bool
+	}{
+		{"failed tx out of batch is committed", false, false, true},
+		{"failed tx in batch reverts the batch", true, true, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			msg := revertingTx(suite.StateDB().GetNonce(suite.address))
+
+			ctx := suite.ctx
+			batchID := msg.AsTransaction().Hash()
+			if tc.batch {
+				ctx = types.WithBatchID(ctx, batchID)
+			}
+
+			res, err := suite.app.EvmKeeper.EthereumTx(ctx, msg)
+			if tc.expErr {
+				suite.Require().ErrorIs(err, types.ErrBatchReverted)
+				suite.Require().Contains(err.Error(), batchID.Hex())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expFail, res.Failed())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEthereumTxSetCode() {
+	chainID := suite.app.EvmKeeper.ChainID()
+	signer := ethtypes.LatestSignerForChainID(chainID)
+	delegate := utiltx.GenerateAddress()
+
+	// the called contract reverts: PUSH1 0 PUSH1 0 REVERT
+	reverting := utiltx.GenerateAddress()
+
+	newAuthorization := func() (common.Address, types.SetCodeAuthorization) {
+		authority, priv := utiltx.NewAddrKey()
+		key, err := priv.ToECDSA()
+		suite.Require().NoError(err)
+		auth := types.NewSetCodeAuthorization(chainID, delegate, 0)
+		suite.Require().NoError(auth.Sign(key))
+		return authority, auth
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func(authority common.Address)
+		expDelegate bool
+	}{
+		{
+			"authorization kept on failed execution",
+			func(common.Address) {},
+			true,
+		},
+		{
+			"vesting account cannot delegate",
+			func(authority common.Address) {
+				addr := sdk.AccAddress(authority.Bytes())
+				baseAcc := authtypes.NewBaseAccountWithAddress(addr)
+				baseAcc.AccountNumber = suite.app.AccountKeeper.NextAccountNumber(suite.ctx)
+				acc := vestingtypes.NewClawbackVestingAccount(baseAcc, sdk.AccAddress(suite.address.Bytes()), nil, time.Now(), nil, nil)
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			vmdb := suite.StateDB()
+			vmdb.SetCode(reverting, common.FromHex("0x60006000fd"))
+			suite.Require().NoError(vmdb.Commit())
+
+			authority, auth := newAuthorization()
+			tc.malleate(authority)
+
+			// the gas is free since no fees are deducted without the ante handler
+			msg := types.NewTx(&types.EvmTxArgs{
+				ChainID:           chainID,
+				Nonce:             suite.StateDB().GetNonce(suite.address),
+				To:                &reverting,
+				GasLimit:          100000,
+				GasFeeCap:         big.NewInt(0),
+				GasTipCap:         big.NewInt(0),
+				Accesses:          &ethtypes.AccessList{},
+				AuthorizationList: []types.SetCodeAuthorization{auth},
+			})
+			msg.From = suite.address.Hex()
+			suite.Require().NoError(msg.Sign(signer, suite.signer))
+
+			res, err := suite.app.EvmKeeper.EthereumTx(suite.ctx, msg)
+			suite.Require().NoError(err)
+			suite.Require().True(res.Failed())
+
+			vmdb = suite.StateDB()
+			code := vmdb.GetRawCode(authority)
+			if tc.expDelegate {
+				suite.Require().Equal(types.AddressToDelegation(delegate), code)
+				suite.Require().Equal(uint64(1), vmdb.GetNonce(authority))
+			} else {
+				suite.Require().Empty(code)
+				suite.Require().Equal(uint64(0), vmdb.GetNonce(authority))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCallContract() {
+	contract := utiltx.GenerateAddress()
+	sender := sdk.AccAddress(suite.address.Bytes())
+
+	testCases := []struct {
+		name     string
+		code     string
+		gasLimit uint64
+		gasLeft  uint64
+		expErr   error
+	}{
+		// PUSH1 0 PUSH1 0 LOG0 STOP
+		{"pass - log emitted", "0x60006000a000", 100000, 200000, nil},
+		{"fail - gas limit above the gas left", "0x60006000a000", 100000, 50000, types.ErrInvalidGasLimit},
+		// PUSH1 0 PUSH1 0 REVERT
+		{"fail - reverted", "0x60006000fd", 100000, 200000, types.ErrVMExecution},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			vmdb := suite.StateDB()
+			vmdb.SetCode(contract, common.FromHex(tc.code))
+			suite.Require().NoError(vmdb.Commit())
+
+			ctx := suite.ctx.WithGasMeter(storetypes.NewGasMeter(tc.gasLeft)).WithEventManager(sdk.NewEventManager())
+			msg := types.NewMsgCallContract(sender, contract, nil, sdkmath.ZeroInt(), tc.gasLimit)
+
+			res, err := suite.app.EvmKeeper.CallContract(ctx, msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Logs, 1)
+			suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), res.GasUsed)
+
+			// the logs are not emitted as tx log events indexed by the JSON-RPC
+			for _, event := range ctx.EventManager().Events() {
+				suite.Require().NotEqual(types.EventTypeTxLog, event.Type)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestUpdateParams() {
 	testCases := []struct {
 		name      string
@@ -0,0 +1,202 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package keeper
+
+import (
+	"bytes"
+	"slices"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmostypes "github.com/hetu-project/hetu/v1/types"
+	"github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+// CodeUsage is a contract code of the EVM store and the number of accounts referencing it.
+type CodeUsage struct {
+	CodeHash   common.Hash `json:"code_hash"`
+	Size       int         `json:"size"`
+	References int         `json:"references"`
+}
+
+// StorageUsage is the storage of an address in the EVM store. The storage is orphaned if
+// no account exists at the address.
+type StorageUsage struct {
+	Address  common.Address `json:"address"`
+	Slots    int            `json:"slots"`
+	Size     int            `json:"size"`
+	Orphaned bool           `json:"orphaned"`
+}
+
+// StateReport is the analysis of the EVM store: the codes with their reference counts and
+// the storage size of each address.
+type StateReport struct {
+	Codes    []CodeUsage    `json:"codes"`
+	Storages []StorageUsage `json:"storages"`
+	// MissingCodes are the code hashes referenced by accounts but not in the store.
+	MissingCodes []common.Hash `json:"missing_codes"`
+}
+
+// PruneResult is the amount of state removed, or removable, by a prune.
+type PruneResult struct {
+	Codes       int `json:"codes"`
+	CodeSize    int `json:"code_size"`
+	Slots       int `json:"slots"`
+	StorageSize int `json:"storage_size"`
+	Contracts   int `json:"contracts"`
+}
+
+// UnreferencedCodes returns the codes not referenced by any account.
+func (r StateReport) UnreferencedCodes() []CodeUsage {
+	var codes []CodeUsage
+	for _, code := range r.Codes {
+		if code.References == 0 {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+// OrphanedStorages returns the storages of the addresses without account.
+func (r StateReport) OrphanedStorages() []StorageUsage {
+	var storages []StorageUsage
+	for _, storage := range r.Storages {
+		if storage.Orphaned {
+			storages = append(storages, storage)
+		}
+	}
+	return storages
+}
+
+// Prunable returns the amount of state removed by a prune.
+func (r StateReport) Prunable() PruneResult {
+	var result PruneResult
+	for _, code := range r.UnreferencedCodes() {
+		result.Codes++
+		result.CodeSize += code.Size
+	}
+	for _, storage := range r.OrphanedStorages() {
+		result.Contracts++
+		result.Slots += storage.Slots
+		result.StorageSize += storage.Size
+	}
+	return result
+}
+
+// AnalyzeState counts the references to each code of the EVM store and measures the storage
+// of each address.
+func (k *Keeper) AnalyzeState(ctx sdk.Context) StateReport {
+	references := make(map[common.Hash]int)
+	k.accountKeeper.IterateAccounts(ctx, func(account sdk.AccountI) bool {
+		ethAccount, ok := account.(evmostypes.EthAccountI)
+		if !ok {
+			return false
+		}
+
+		codeHash := ethAccount.GetCodeHash()
+		if !bytes.Equal(codeHash.Bytes(), types.EmptyCodeHash) {
+			references[codeHash]++
+		}
+		return false
+	})
+
+	var report StateReport
+	store := ctx.KVStore(k.storeKey)
+
+	codeIterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixCode)
+	defer codeIterator.Close()
+
+	for ; codeIterator.Valid(); codeIterator.Next() {
+		codeHash := common.BytesToHash(codeIterator.Key()[len(types.KeyPrefixCode):])
+		report.Codes = append(report.Codes, CodeUsage{
+			CodeHash:   codeHash,
+			Size:       len(codeIterator.Value()),
+			References: references[codeHash],
+		})
+		delete(references, codeHash)
+	}
+
+	for codeHash := range references {
+		report.MissingCodes = append(report.MissingCodes, codeHash)
+	}
+	slices.SortFunc(report.MissingCodes, func(a, b common.Hash) int {
+		return bytes.Compare(a.Bytes(), b.Bytes())
+	})
+
+	storageIterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixStorage)
+	defer storageIterator.Close()
+
+	// the slots of an address are contiguous in the store
+	for ; storageIterator.Valid(); storageIterator.Next() {
+		key := storageIterator.Key()[len(types.KeyPrefixStorage):]
+		if len(key) < common.AddressLength {
+			continue
+		}
+
+		address := common.BytesToAddress(key[:common.AddressLength])
+		n := len(report.Storages)
+		if n == 0 || report.Storages[n-1].Address != address {
+			report.Storages = append(report.Storages, StorageUsage{
+				Address:  address,
+				Orphaned: k.accountKeeper.GetAccount(ctx, sdk.AccAddress(address.Bytes())) == nil,
+			})
+			n++
+		}
+
+		report.Storages[n-1].Slots++
+		report.Storages[n-1].Size += len(key) - common.AddressLength + len(storageIterator.Value())
+	}
+
+	return report
+}
+
+// PruneState removes the codes not referenced by any account and the storage of the
+// addresses without account. The prune changes the application state, so it must only run
+// in a state transition agreed by the network, like an upgrade handler.
+func (k *Keeper) PruneState(ctx sdk.Context) PruneResult {
+	report := k.AnalyzeState(ctx)
+
+	for _, code := range report.UnreferencedCodes() {
+		k.SetCode(ctx, code.CodeHash.Bytes(), nil)
+	}
+
+	for _, storage := range report.OrphanedStorages() {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(storage.Address))
+
+		// collect the keys first, the store can't be written while iterating
+		var keys [][]byte
+		iterator := store.Iterator(nil, nil)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	result := report.Prunable()
+	k.Logger(ctx).Info(
+		"pruned evm state",
+		"codes", result.Codes,
+		"contracts", result.Contracts,
+		"slots", result.Slots,
+	)
+	return result
+}
@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	utiltx "github.com/hetu-project/hetu/v1/testutil/tx"
+	"github.com/hetu-project/hetu/v1/x/evm/keeper"
+)
+
+func (suite *KeeperTestSuite) TestAnalyzeAndPruneState() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(100))
+	contractCodeHash := suite.app.EvmKeeper.GetAccount(suite.ctx, contractAddr).CodeHash
+
+	// code without account and storage left under an address without account
+	orphanCode := []byte{0x60, 0x00}
+	orphanCodeHash := crypto.Keccak256Hash(orphanCode)
+	suite.app.EvmKeeper.SetCode(suite.ctx, orphanCodeHash.Bytes(), orphanCode)
+
+	orphanAddr := utiltx.GenerateAddress()
+	suite.app.EvmKeeper.SetState(suite.ctx, orphanAddr, common.BytesToHash([]byte{1}), common.BytesToHash([]byte{2}).Bytes())
+
+	report := suite.app.EvmKeeper.AnalyzeState(suite.ctx)
+	suite.Require().Equal([]keeper.CodeUsage{{CodeHash: orphanCodeHash, Size: len(orphanCode)}}, report.UnreferencedCodes())
+	suite.Require().Len(report.OrphanedStorages(), 1)
+	suite.Require().Equal(orphanAddr, report.OrphanedStorages()[0].Address)
+	suite.Require().Empty(report.MissingCodes)
+
+	for _, code := range report.Codes {
+		if common.BytesToHash(contractCodeHash) == code.CodeHash {
+			suite.Require().Equal(1, code.References)
+		}
+	}
+
+	result := suite.app.EvmKeeper.PruneState(suite.ctx)
+	suite.Require().Equal(report.Prunable(), result)
+	suite.Require().Equal(1, result.Codes)
+	suite.Require().Equal(1, result.Slots)
+
+	report = suite.app.EvmKeeper.AnalyzeState(suite.ctx)
+	suite.Require().Equal(keeper.PruneResult{}, report.Prunable())
+	suite.Require().NotEmpty(suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(contractCodeHash)))
+}
@@ -16,6 +16,7 @@
 package keeper
 
 import (
+	gomath "math"
 	"math/big"
 
 	tmtypes "github.com/cometbft/cometbft/types"
@@ -70,7 +71,36 @@ func (k *Keeper) NewEVM(
 		tracer = k.Tracer(ctx, msg, cfg.ChainConfig)
 	}
 	vmConfig := k.VMConfig(ctx, msg, cfg, tracer)
-	return vm.NewEVM(blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+	evm := vm.NewEVMWithHooks(statedb.CodeHooks{}, blockCtx, txCtx, stateDB, cfg.ChainConfig, vmConfig)
+	if len(k.customContractFns) > 0 {
+		rules := cfg.ChainConfig.Rules(blockCtx.BlockNumber, blockCtx.Random != nil)
+		evm.WithPrecompiles(k.Precompiles(ctx, rules))
+	}
+	return evm
+}
+
+// Precompiles returns the default precompiled contracts for the given chain
+// rules extended with the custom precompiled contracts of the keeper, together
+// with the addresses of all of them.
+func (k *Keeper) Precompiles(ctx sdk.Context, rules params.Rules) (map[common.Address]vm.PrecompiledContract, []common.Address) {
+	defaults := vm.DefaultPrecompiles(rules)
+	precompiles := make(map[common.Address]vm.PrecompiledContract, len(defaults)+len(k.customContractFns))
+	for addr, contract := range defaults {
+		precompiles[addr] = contract
+	}
+
+	active := append([]common.Address{}, vm.DefaultActivePrecompiles(rules)...)
+	for _, fn := range k.customContractFns {
+		contract := fn(ctx, rules)
+		precompiles[contract.Address()] = contract
+		active = append(active, contract.Address())
+	}
+
+	if err := vm.ValidatePrecompiles(precompiles, active); err != nil {
+		panic(err)
+	}
+
+	return precompiles, active
 }
 
 // GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
@@ -147,6 +177,29 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 //
 // For relevant discussion see: https://github.com/cosmos/cosmos-sdk/discussions/9072
 func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*types.MsgEthereumTxResponse, error) {
+	asMessage := func(signer ethtypes.Signer, baseFee *big.Int) (core.Message, error) {
+		if from, ok := k.ImpersonatedSender(tx); ok {
+			return types.NewImpersonatedMessage(tx, from, baseFee), nil
+		}
+		return tx.AsMessage(signer, baseFee)
+	}
+	return k.applyTransaction(ctx, tx.Hash(), tx.Type(), asMessage)
+}
+
+// ApplySetCodeTransaction runs the set code transaction of the given msg like ApplyTransaction.
+// The authorizations of the transaction are applied before the execution of the call.
+func (k *Keeper) ApplySetCodeTransaction(ctx sdk.Context, msgEthTx *types.MsgEthereumTx) (*types.MsgEthereumTxResponse, error) {
+	return k.applyTransaction(ctx, msgEthTx.TxHash(), types.SetCodeTxType, msgEthTx.AsMessage)
+}
+
+// applyTransaction runs the transaction with the given hash and type, asMessage returns its
+// core message according to the chain rules.
+func (k *Keeper) applyTransaction(
+	ctx sdk.Context,
+	txHash common.Hash,
+	txType uint8,
+	asMessage func(signer ethtypes.Signer, baseFee *big.Int) (core.Message, error),
+) (*types.MsgEthereumTxResponse, error) {
 	var (
 		bloom        *big.Int
 		bloomReceipt ethtypes.Bloom
@@ -156,15 +209,23 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to load evm config")
 	}
-	txConfig := k.TxConfig(ctx, tx.Hash())
+	txConfig := k.TxConfig(ctx, txHash)
 
 	// get the signer according to the chain rules from the config and block height
 	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	msg, err := asMessage(signer, cfg.BaseFee)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
 	}
 
+	// the authorizations are applied before the execution and outside of its cache
+	// context, so that they are kept even if the execution fails
+	if setCodeMsg, ok := msg.(types.SetCodeMessage); ok {
+		if msg, err = k.applySetCodeAuthorizations(ctx, setCodeMsg, cfg, txConfig); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to apply set code authorizations")
+		}
+	}
+
 	// snapshot to contain the tx processing and post processing in same scope
 	var commit func()
 	tmpCtx := ctx
@@ -206,7 +267,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	}
 
 	receipt := &ethtypes.Receipt{
-		Type:              tx.Type(),
+		Type:              txType,
 		PostState:         nil, // TODO: intermediate state root
 		CumulativeGasUsed: cumulativeGasUsed,
 		Bloom:             bloomReceipt,
@@ -329,6 +390,9 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
+	// fail instead of running on the local state if the forked state can't be loaded
+	ctx, forkErr := k.trackForkErrors(ctx)
+
 	stateDB := statedb.New(ctx, k, txConfig)
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
@@ -366,6 +430,20 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		stateDB.PrepareAccessList(msg.From(), msg.To(), []common.Address{}, msg.AccessList())
 	}
 
+	switch setCodeMsg := msg.(type) {
+	case authorizedMessage:
+		stateDB.AddRefund(setCodeMsg.refund)
+		for _, authority := range setCodeMsg.authorities {
+			stateDB.AddAddressToAccessList(authority)
+		}
+	case types.SetCodeMessage:
+		authorities, refund := k.applyAuthorizations(ctx, stateDB, setCodeMsg.AuthorizationList(), cfg.ChainConfig.ChainID)
+		stateDB.AddRefund(refund)
+		for _, authority := range authorities {
+			stateDB.AddAddressToAccessList(authority)
+		}
+	}
+
 	if contractCreation {
 		// take over the nonce management from evm:
 		// - reset sender's nonce to msg.Nonce before calling evm.
@@ -377,6 +455,10 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		ret, leftoverGas, vmErr = evm.Call(sender, *msg.To(), msg.Data(), leftoverGas, msg.Value())
 	}
 
+	if err := forkErr(); err != nil {
+		return nil, err
+	}
+
 	refundQuotient := params.RefundQuotient
 
 	// After EIP-3529: refunds are capped to gasUsed / 5
@@ -432,3 +514,83 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		Hash:    txConfig.TxHash.Hex(),
 	}, nil
 }
+
+// authorizedMessage is a set code message whose authorizations are already applied
+// to the state, carrying the authorities to add to the access list and the refund
+// of the authorizations of existing accounts.
+type authorizedMessage struct {
+	types.SetCodeMessage
+
+	authorities []common.Address
+	refund      uint64
+}
+
+// applySetCodeAuthorizations applies and commits the authorizations of the message
+// to the state of the given context, and returns the message to execute.
+func (k *Keeper) applySetCodeAuthorizations(
+	ctx sdk.Context,
+	msg types.SetCodeMessage,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+) (core.Message, error) {
+	stateDB := statedb.New(ctx, k, txConfig)
+	authorities, refund := k.applyAuthorizations(ctx, stateDB, msg.AuthorizationList(), cfg.ChainConfig.ChainID)
+	if err := stateDB.Commit(); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to commit stateDB")
+	}
+	return authorizedMessage{SetCodeMessage: msg, authorities: authorities, refund: refund}, nil
+}
+
+// applyAuthorizations sets the code of the authorities of the valid authorizations to the
+// delegation designator of their address, the invalid ones and the ones of accounts
+// which can't hold code are skipped.
+// It returns the authorities of the valid authorizations and the gas to refund for
+// the ones of existing accounts.
+func (k *Keeper) applyAuthorizations(
+	ctx sdk.Context,
+	stateDB *statedb.StateDB,
+	authorizations []types.SetCodeAuthorization,
+	chainID *big.Int,
+) (authorities []common.Address, refund uint64) {
+	for _, auth := range authorizations {
+		if !auth.ChainID.IsZero() && auth.ChainID.BigInt().Cmp(chainID) != 0 {
+			continue
+		}
+		if auth.Nonce == gomath.MaxUint64 {
+			continue
+		}
+
+		authority, err := auth.Authority()
+		if err != nil {
+			continue
+		}
+		authorities = append(authorities, authority)
+
+		// only accounts without code or already delegated can delegate their code
+		if code := stateDB.GetRawCode(authority); len(code) > 0 {
+			if _, ok := types.ParseDelegation(code); !ok {
+				continue
+			}
+		}
+		if stateDB.GetNonce(authority) != auth.Nonce {
+			continue
+		}
+		// accounts which can't hold a code hash, e.g. vesting accounts, can't delegate
+		if !k.canSetCode(ctx, authority) {
+			continue
+		}
+
+		if stateDB.Exist(authority) {
+			refund += types.PerEmptyAccountCost - types.PerAuthBaseCost
+		}
+
+		// the zero address clears the delegation
+		if address := auth.GetAddress(); address == (common.Address{}) {
+			stateDB.SetCode(authority, nil)
+		} else {
+			stateDB.SetCode(authority, types.AddressToDelegation(address))
+		}
+		stateDB.SetNonce(authority, auth.Nonce+1)
+	}
+	return authorities, refund
+}
@@ -25,6 +25,7 @@ import (
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	evmostypes "github.com/hetu-project/hetu/v1/types"
 	"github.com/hetu-project/hetu/v1/x/evm/statedb"
@@ -50,6 +51,8 @@ func (k *Keeper) GetAccount(ctx sdk.Context, addr common.Address) *statedb.Accou
 
 // GetState loads contract state from database, implements `statedb.Keeper` interface.
 func (k *Keeper) GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash {
+	k.loadForkState(ctx, addr, key)
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
 
 	value := store.Get(key.Bytes())
@@ -119,6 +122,7 @@ func (k *Keeper) SetBalance(ctx sdk.Context, addr common.Address, amount *big.In
 }
 
 // SetAccount updates nonce/balance/codeHash together.
+// Only plain and ethereum accounts can hold a code hash.
 func (k *Keeper) SetAccount(ctx sdk.Context, addr common.Address, account statedb.Account) error {
 	// update account
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
@@ -133,10 +137,19 @@ func (k *Keeper) SetAccount(ctx sdk.Context, addr common.Address, account stated
 
 	codeHash := common.BytesToHash(account.CodeHash)
 
+	// a plain account delegating its code through a set code transaction is
+	// converted to an EthAccount to store the code hash of the delegation designator
+	if baseAcct, ok := acct.(*authtypes.BaseAccount); ok && account.IsContract() {
+		acct = &evmostypes.EthAccount{BaseAccount: baseAcct}
+	}
+
 	if ethAcct, ok := acct.(evmostypes.EthAccountI); ok {
 		if err := ethAcct.SetCodeHash(codeHash); err != nil {
 			return err
 		}
+	} else if account.IsContract() {
+		// the code hash can't be stored in other account types, e.g. vesting accounts
+		return errorsmod.Wrapf(types.ErrInvalidAccount, "cannot set the code of account %s of type %T", addr, acct)
 	}
 
 	k.accountKeeper.SetAccount(ctx, acct)
@@ -230,3 +243,14 @@ func (k *Keeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
 
 	return nil
 }
+
+// canSetCode returns true if the code hash of the account can be stored, i.e. the
+// account doesn't exist yet, is a plain account or an ethereum account.
+func (k *Keeper) canSetCode(ctx sdk.Context, addr common.Address) bool {
+	switch k.accountKeeper.GetAccount(ctx, addr.Bytes()).(type) {
+	case nil, *authtypes.BaseAccount, evmostypes.EthAccountI:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of Evmos' Evmos packages.
+//
+// The Evmos packages is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package statedb
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+var _ vm.OpCodeHooks = CodeHooks{}
+
+// CodeHooks are the EVM opcode hooks telling the StateDB the code it loads for the
+// calls, so that the delegation designators are resolved on CALL, CALLCODE,
+// DELEGATECALL and STATICCALL only.
+type CodeHooks struct{}
+
+// CallHook marks the code of the recipient as loaded for the call.
+func (CodeHooks) CallHook(evm *vm.EVM, _, recipient common.Address) error {
+	if stateDB, ok := evm.StateDB.(*StateDB); ok {
+		stateDB.prepareCall(recipient)
+	}
+	return nil
+}
+
+// CreateHook clears the call marked by a call aborted before loading its code.
+func (CodeHooks) CreateHook(evm *vm.EVM, _ common.Address) error {
+	if stateDB, ok := evm.StateDB.(*StateDB); ok {
+		stateDB.pendingCall = nil
+	}
+	return nil
+}
@@ -25,10 +25,13 @@ import (
 // codebase to support additional state transition functionalities. In particular
 // it supports appending a new entry to the state journal through
 // AppendJournalEntry so that the state can be reverted after running
-// stateful precompiled contracts.
+// stateful precompiled contracts, and executing Cosmos state transitions
+// through ExecuteNativeAction.
 type ExtStateDB interface {
 	vm.StateDB
 	AppendJournalEntry(JournalEntry)
+	NativeContext() sdk.Context
+	ExecuteNativeAction(action func(ctx sdk.Context) error) error
 }
 
 // Keeper provide underlying storage of StateDB
@@ -128,6 +128,9 @@ type (
 		prev uint64
 	}
 	addLogChange struct{}
+	nativeChange struct {
+		index int
+	}
 
 	// Changes to the access list
 	accessListAddAccountChange struct {
@@ -227,6 +230,14 @@ func (ch addLogChange) Dirtied() *common.Address {
 	return nil
 }
 
+func (ch nativeChange) Revert(s *StateDB) {
+	s.nativeBranches = s.nativeBranches[:ch.index]
+}
+
+func (ch nativeChange) Dirtied() *common.Address {
+	return nil
+}
+
 func (ch accessListAddAccountChange) Revert(s *StateDB) {
 	/*
 		One important invariant here, is that whenever a (addr, slot) is added, if the
@@ -24,9 +24,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/hetu-project/hetu/v1/x/evm/types"
 )
 
 // revision is the identifier of a version of state.
@@ -37,7 +38,7 @@ type revision struct {
 	journalIndex int
 }
 
-var _ vm.StateDB = &StateDB{}
+var _ ExtStateDB = &StateDB{}
 
 // StateDB structs within the ethereum protocol are used to store anything
 // within the merkle trie. StateDBs take care of caching and storing
@@ -69,6 +70,27 @@ type StateDB struct {
 
 	// Transient storage
 	transientStorage transientStorage
+
+	// Branches of the context holding the Cosmos state changes of native
+	// actions, each one branched from the previous one.
+	nativeBranches []nativeBranch
+
+	// The call being entered, whose code is loaded by the next GetCode.
+	pendingCall *pendingCall
+}
+
+// pendingCall is the recipient of a call being entered, with the revision of
+// the snapshot taken by the call once past its depth and balance checks.
+type pendingCall struct {
+	addr     common.Address
+	revision int
+}
+
+// nativeBranch is a cached context together with the function that writes
+// its changes to the parent context.
+type nativeBranch struct {
+	ctx        sdk.Context
+	writeCache func()
 }
 
 // New creates a new state from a given trie.
@@ -159,7 +181,23 @@ func (s *StateDB) GetNonce(addr common.Address) uint64 {
 }
 
 // GetCode returns the code of account, nil if not exists.
+// The delegation designator of an account delegated by a set code transaction is
+// resolved only when the code is loaded for a call, so that the code of the delegate
+// is executed on CALL while EXTCODECOPY copies the designator.
 func (s *StateDB) GetCode(addr common.Address) []byte {
+	call := s.pendingCall
+	s.pendingCall = nil
+
+	// a call aborted before its snapshot doesn't load the code
+	if call != nil && call.addr == addr && call.revision == s.nextRevisionID-1 {
+		addr = s.resolveDelegation(addr)
+	}
+	return s.GetRawCode(addr)
+}
+
+// GetRawCode returns the code stored in the account, without resolving the
+// delegation designator, nil if not exists.
+func (s *StateDB) GetRawCode(addr common.Address) []byte {
 	stateObject := s.getStateObject(addr)
 	if stateObject != nil {
 		return stateObject.Code()
@@ -168,6 +206,7 @@ func (s *StateDB) GetCode(addr common.Address) []byte {
 }
 
 // GetCodeSize returns the code size of account.
+// The delegation designator is not resolved, so EXTCODESIZE returns its size.
 func (s *StateDB) GetCodeSize(addr common.Address) int {
 	stateObject := s.getStateObject(addr)
 	if stateObject != nil {
@@ -177,6 +216,7 @@ func (s *StateDB) GetCodeSize(addr common.Address) int {
 }
 
 // GetCodeHash returns the code hash of account.
+// The delegation designator is not resolved, so EXTCODEHASH returns its hash.
 func (s *StateDB) GetCodeHash(addr common.Address) common.Hash {
 	stateObject := s.getStateObject(addr)
 	if stateObject == nil {
@@ -185,6 +225,27 @@ func (s *StateDB) GetCodeHash(addr common.Address) common.Hash {
 	return common.BytesToHash(stateObject.CodeHash())
 }
 
+// GetDelegation returns the address the code of the account is delegated to, if
+// its code is a delegation designator.
+func (s *StateDB) GetDelegation(addr common.Address) (common.Address, bool) {
+	return types.ParseDelegation(s.GetRawCode(addr))
+}
+
+// resolveDelegation returns the address holding the code executed for the account.
+// The delegations are not followed recursively.
+func (s *StateDB) resolveDelegation(addr common.Address) common.Address {
+	if delegate, ok := s.GetDelegation(addr); ok {
+		return delegate
+	}
+	return addr
+}
+
+// prepareCall marks the code of the recipient as loaded for a call by the next
+// GetCode, once the call has taken its snapshot.
+func (s *StateDB) prepareCall(addr common.Address) {
+	s.pendingCall = &pendingCall{addr: addr, revision: s.nextRevisionID}
+}
+
 // GetState retrieves a value from the given account's storage trie.
 func (s *StateDB) GetState(addr common.Address, hash common.Hash) common.Hash {
 	stateObject := s.getStateObject(addr)
@@ -534,9 +595,48 @@ func (s *StateDB) RevertToSnapshot(revid int) {
 	s.validRevisions = s.validRevisions[:idx]
 }
 
+// AppendJournalEntry appends a modification entry to the state journal so that
+// it is reverted together with the EVM state changes.
+func (s *StateDB) AppendJournalEntry(entry JournalEntry) {
+	s.journal.append(entry)
+}
+
+// NativeContext returns the context that reflects the Cosmos state changes
+// performed by the native actions executed so far.
+func (s *StateDB) NativeContext() sdk.Context {
+	if n := len(s.nativeBranches); n > 0 {
+		return s.nativeBranches[n-1].ctx
+	}
+	return s.ctx
+}
+
+// ExecuteNativeAction executes a Cosmos state transition on a branch of the
+// native context. The changes are discarded if the action fails or if the state
+// is reverted to a snapshot taken before the action, and are only written to
+// the underlying context on Commit.
+//
+// NOTE: the action must not modify state that is cached by the StateDB, such
+// as the EVM denom balances, the nonces, the code or the storage of accounts.
+func (s *StateDB) ExecuteNativeAction(action func(ctx sdk.Context) error) error {
+	ctx, writeCache := s.NativeContext().CacheContext()
+	if err := action(ctx); err != nil {
+		return err
+	}
+
+	s.journal.append(nativeChange{index: len(s.nativeBranches)})
+	s.nativeBranches = append(s.nativeBranches, nativeBranch{ctx: ctx, writeCache: writeCache})
+	return nil
+}
+
 // Commit writes the dirty states to keeper
 // the StateDB object should be discarded after committed.
 func (s *StateDB) Commit() error {
+	// write the native branches from the innermost one down to the StateDB context
+	for i := len(s.nativeBranches) - 1; i >= 0; i-- {
+		s.nativeBranches[i].writeCache()
+	}
+	s.nativeBranches = nil
+
 	for _, addr := range s.journal.sortedDirties() {
 		obj := s.stateObjects[addr]
 		if obj.suicided {
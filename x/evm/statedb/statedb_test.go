@@ -1,15 +1,21 @@
 package statedb_test
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/hetu-project/hetu/v1/x/evm/statedb"
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -285,6 +291,77 @@ func (suite *StateDBTestSuite) TestCode() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestDelegation() {
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+
+	_, ok := db.GetDelegation(address)
+	suite.Require().False(ok)
+
+	designator := evmtypes.AddressToDelegation(address2)
+	db.SetCode(address, designator)
+	suite.Require().NoError(db.Commit())
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	delegate, ok := db.GetDelegation(address)
+	suite.Require().True(ok)
+	suite.Require().Equal(address2, delegate)
+	suite.Require().Equal(designator, db.GetRawCode(address))
+
+	// the designator is only resolved when the code is loaded for a call
+	db.SetCode(address2, []byte("hello world"))
+	suite.Require().Equal(designator, db.GetCode(address))
+	suite.Require().Equal(len(designator), db.GetCodeSize(address))
+	suite.Require().Equal(crypto.Keccak256Hash(designator), db.GetCodeHash(address))
+}
+
+func (suite *StateDBTestSuite) TestDelegationOpcodes() {
+	db := statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
+
+	// the delegate returns 0x2a
+	delegate, prober := address2, address3
+	db.SetCode(delegate, common.FromHex("602a60005260206000f3"))
+	designator := evmtypes.AddressToDelegation(delegate)
+	db.SetCode(address, designator)
+
+	// the prober returns EXTCODESIZE, EXTCODEHASH, EXTCODECOPY and the result of a CALL
+	// of the delegated account, after a CALL aborted on its balance check
+	push := "73" + common.Bytes2Hex(address.Bytes())
+	db.SetCode(prober, common.FromHex(
+		"60006000600060006001"+push+"61fffff150"+ // CALL(0xffff, address, 1, 0, 0, 0, 0) fails, POP
+			"601760006040"+push+"3c"+ // EXTCODECOPY(address, 0x40, 0, 23)
+			push+"3b600052"+ // MSTORE(0, EXTCODESIZE(address))
+			push+"3f602052"+ // MSTORE(0x20, EXTCODEHASH(address))
+			"60206060600060006000"+push+"5af150"+ // CALL(gas, address, 0, 0, 0, 0x60, 32), POP
+			"60806000f3", // RETURN(0, 0x80)
+	))
+
+	blockCtx := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		GetHash:     func(uint64) common.Hash { return common.Hash{} },
+		BlockNumber: big.NewInt(1),
+		Time:        big.NewInt(0),
+		Difficulty:  big.NewInt(0),
+		BaseFee:     big.NewInt(0),
+	}
+	evm := vm.NewEVMWithHooks(statedb.CodeHooks{}, blockCtx, vm.TxContext{GasPrice: big.NewInt(0)}, db, params.TestChainConfig, vm.Config{})
+	ret, _, err := evm.Call(vm.AccountRef(address), prober, nil, 1_000_000, big.NewInt(0))
+	suite.Require().NoError(err)
+	suite.Require().Len(ret, 0x80)
+
+	suite.Require().Equal(big.NewInt(int64(len(designator))), new(big.Int).SetBytes(ret[:32]), "EXTCODESIZE")
+	suite.Require().Equal(crypto.Keccak256(designator), ret[32:64], "EXTCODEHASH")
+	suite.Require().Equal(designator, ret[64:64+len(designator)], "EXTCODECOPY")
+	suite.Require().Equal(big.NewInt(0x2a), new(big.Int).SetBytes(ret[96:]), "CALL")
+
+	// the delegation is not followed recursively
+	db.SetCode(delegate, evmtypes.AddressToDelegation(prober))
+	ret, _, err = evm.Call(vm.AccountRef(prober), address, nil, 1_000_000, big.NewInt(0))
+	suite.Require().ErrorContains(err, "invalid opcode")
+	suite.Require().Empty(ret)
+}
+
 func (suite *StateDBTestSuite) TestRevertSnapshot() {
 	v1 := common.BigToHash(big.NewInt(1))
 	v2 := common.BigToHash(big.NewInt(2))
@@ -566,6 +643,47 @@ func (suite *StateDBTestSuite) TestIterateStorage() {
 	suite.Require().Equal(1, len(storage))
 }
 
+func (suite *StateDBTestSuite) TestNativeAction() {
+	storeKey := storetypes.NewKVStoreKey("native")
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_native"))
+	keyA, keyB, keyC := []byte("a"), []byte("b"), []byte("c")
+	set := func(key []byte) func(sdk.Context) error {
+		return func(ctx sdk.Context) error {
+			ctx.KVStore(storeKey).Set(key, []byte{1})
+			ctx.EventManager().EmitEvent(sdk.NewEvent("native", sdk.NewAttribute("key", string(key))))
+			return nil
+		}
+	}
+
+	db := statedb.New(ctx, NewMockKeeper(), emptyTxConfig)
+	suite.Require().NoError(db.ExecuteNativeAction(set(keyA)))
+
+	// changes are visible to later actions but not to the StateDB context
+	suite.Require().True(db.NativeContext().KVStore(storeKey).Has(keyA))
+	suite.Require().False(ctx.KVStore(storeKey).Has(keyA))
+
+	// reverted actions are discarded
+	rev := db.Snapshot()
+	suite.Require().NoError(db.ExecuteNativeAction(set(keyB)))
+	suite.Require().True(db.NativeContext().KVStore(storeKey).Has(keyB))
+	db.RevertToSnapshot(rev)
+	suite.Require().False(db.NativeContext().KVStore(storeKey).Has(keyB))
+
+	// failed actions are discarded
+	err := db.ExecuteNativeAction(func(ctx sdk.Context) error {
+		ctx.KVStore(storeKey).Set(keyC, []byte{1})
+		return errors.New("failure")
+	})
+	suite.Require().Error(err)
+	suite.Require().False(db.NativeContext().KVStore(storeKey).Has(keyC))
+
+	suite.Require().NoError(db.Commit())
+	suite.Require().True(ctx.KVStore(storeKey).Has(keyA))
+	suite.Require().False(ctx.KVStore(storeKey).Has(keyB))
+	suite.Require().False(ctx.KVStore(storeKey).Has(keyC))
+	suite.Require().Len(ctx.EventManager().Events(), 1)
+}
+
 func CollectContractStorage(db *statedb.StateDB) statedb.Storage {
 	storage := make(statedb.Storage)
 	err := db.ForEachStorage(address, func(k, v common.Hash) bool {
@@ -0,0 +1,130 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/hetu-project/hetu/v1/types"
+)
+
+// selectorLength is the length in bytes of a contract method selector
+const selectorLength = 4
+
+var _ authz.Authorization = &ContractCallAuthorization{}
+
+// NewContractCallAuthorization creates a new ContractCallAuthorization allowing the given calls.
+func NewContractCallAuthorization(allowedCalls ...AllowedContractCall) *ContractCallAuthorization {
+	return &ContractCallAuthorization{
+		AllowedCalls: allowedCalls,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ContractCallAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgCallContract{})
+}
+
+// Accept implements Authorization.Accept. The call is accepted if its contract is
+// allowed and, when the contract is restricted to some methods, if the call
+// input starts with one of their selectors. Calls transferring value are not
+// authorized, as the grant doesn't set any spend limit.
+func (a ContractCallAuthorization) Accept(_ context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	callMsg, ok := msg.(*MsgCallContract)
+	if !ok {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid message type %T, expected %T", msg, (*MsgCallContract)(nil))
+	}
+
+	if callMsg.Amount.IsPositive() {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(errortypes.ErrUnauthorized, "value transfers are not authorized: %s", callMsg.Amount)
+	}
+
+	contract := common.HexToAddress(callMsg.Contract)
+	for _, allowed := range a.AllowedCalls {
+		if common.HexToAddress(allowed.Contract) != contract {
+			continue
+		}
+		if allowed.allowsInput(callMsg.Data) {
+			return authz.AcceptResponse{Accept: true}, nil
+		}
+		return authz.AcceptResponse{}, errorsmod.Wrapf(errortypes.ErrUnauthorized, "method of contract %s is not authorized", contract)
+	}
+
+	return authz.AcceptResponse{}, errorsmod.Wrapf(errortypes.ErrUnauthorized, "contract %s is not authorized", contract)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ContractCallAuthorization) ValidateBasic() error {
+	if len(a.AllowedCalls) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "allowed calls cannot be empty")
+	}
+
+	seen := make(map[common.Address]bool, len(a.AllowedCalls))
+	for _, allowed := range a.AllowedCalls {
+		if err := allowed.Validate(); err != nil {
+			return err
+		}
+
+		contract := common.HexToAddress(allowed.Contract)
+		if seen[contract] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicated contract %s", contract)
+		}
+		seen[contract] = true
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of the allowed call.
+func (c AllowedContractCall) Validate() error {
+	if err := types.ValidateNonZeroAddress(c.Contract); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	for _, selector := range c.Selectors {
+		bz, err := hexutil.Decode(selector)
+		if err != nil || len(bz) != selectorLength {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid method selector %s, expected %d hex encoded bytes", selector, selectorLength)
+		}
+	}
+	return nil
+}
+
+// allowsInput returns true if the call input starts with one of the selectors,
+// or if the call isn't restricted to any method.
+func (c AllowedContractCall) allowsInput(input []byte) bool {
+	if len(c.Selectors) == 0 {
+		return true
+	}
+	if len(input) < selectorLength {
+		return false
+	}
+
+	for _, selector := range c.Selectors {
+		if bytes.Equal(common.FromHex(selector), input[:selectorLength]) {
+			return true
+		}
+	}
+	return false
+}
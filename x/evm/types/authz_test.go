@@ -0,0 +1,141 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/hetu-project/hetu/v1/testutil/tx"
+	"github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+func TestContractCallAuthorizationValidateBasic(t *testing.T) {
+	contract := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name    string
+		auth    *types.ContractCallAuthorization
+		expPass bool
+	}{
+		{
+			"pass - any method",
+			types.NewContractCallAuthorization(types.AllowedContractCall{Contract: contract.Hex()}),
+			true,
+		},
+		{
+			"pass - restricted methods",
+			types.NewContractCallAuthorization(types.AllowedContractCall{Contract: contract.Hex(), Selectors: []string{"0xa9059cbb", "0x095ea7b3"}}),
+			true,
+		},
+		{
+			"fail - empty allowed calls",
+			types.NewContractCallAuthorization(),
+			false,
+		},
+		{
+			"fail - invalid contract",
+			types.NewContractCallAuthorization(types.AllowedContractCall{Contract: "0x0000"}),
+			false,
+		},
+		{
+			"fail - zero contract",
+			types.NewContractCallAuthorization(types.AllowedContractCall{Contract: common.Address{}.Hex()}),
+			false,
+		},
+		{
+			"fail - duplicated contract",
+			types.NewContractCallAuthorization(
+				types.AllowedContractCall{Contract: contract.Hex()},
+				types.AllowedContractCall{Contract: contract.Hex(), Selectors: []string{"0xa9059cbb"}},
+			),
+			false,
+		},
+		{
+			"fail - invalid selector",
+			types.NewContractCallAuthorization(types.AllowedContractCall{Contract: contract.Hex(), Selectors: []string{"0xa9059c"}}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestContractCallAuthorizationAccept(t *testing.T) {
+	granter := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	token := utiltx.GenerateAddress()
+	router := utiltx.GenerateAddress()
+	transfer := common.FromHex("0xa9059cbb")
+
+	auth := types.NewContractCallAuthorization(
+		types.AllowedContractCall{Contract: token.Hex(), Selectors: []string{"0xa9059cbb"}},
+		types.AllowedContractCall{Contract: router.Hex()},
+	)
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgCallContract{}), auth.MsgTypeURL())
+
+	testCases := []struct {
+		name    string
+		msg     sdk.Msg
+		expPass bool
+	}{
+		{
+			"pass - allowed method",
+			types.NewMsgCallContract(granter, token, append(transfer, 0x1), sdkmath.ZeroInt(), 100000),
+			true,
+		},
+		{
+			"pass - contract allowing any method",
+			types.NewMsgCallContract(granter, router, []byte{0x1, 0x2, 0x3, 0x4}, sdkmath.ZeroInt(), 100000),
+			true,
+		},
+		{
+			"fail - method not allowed",
+			types.NewMsgCallContract(granter, token, common.FromHex("0x095ea7b3"), sdkmath.ZeroInt(), 100000),
+			false,
+		},
+		{
+			"fail - input shorter than a selector",
+			types.NewMsgCallContract(granter, token, transfer[:3], sdkmath.ZeroInt(), 100000),
+			false,
+		},
+		{
+			"fail - contract not allowed",
+			types.NewMsgCallContract(granter, utiltx.GenerateAddress(), transfer, sdkmath.ZeroInt(), 100000),
+			false,
+		},
+		{
+			"fail - value transfer",
+			types.NewMsgCallContract(granter, router, nil, sdkmath.NewInt(1), 100000),
+			false,
+		},
+		{
+			"fail - invalid msg type",
+			&types.MsgEthereumTx{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := auth.Accept(sdk.Context{}, tc.msg)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.True(t, res.Accept)
+				require.False(t, res.Delete)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BatchTxExtensionOption is the type URL of the extension option that marks a
+// cosmos tx as an atomic batch of ethereum transactions.
+const BatchTxExtensionOption = "/ethermint.evm.v1.ExtensionOptionsEthereumBatchTx"
+
+// batchIDKey is the context key under which the ante handler stores the batch id
+// of the tx being executed.
+type batchIDKey struct{}
+
+// IsBatchTx returns true if the tx carries the ExtensionOptionsEthereumBatchTx option.
+func IsBatchTx(tx sdk.Tx) bool {
+	extTx, ok := tx.(interface {
+		GetExtensionOptions() []*codectypes.Any
+	})
+	if !ok {
+		return false
+	}
+	opts := extTx.GetExtensionOptions()
+	return len(opts) == 1 && opts[0].GetTypeUrl() == BatchTxExtensionOption
+}
+
+// BatchID returns the id shared by all the messages of a batch, which is the
+// ethereum tx hash of its first message.
+func BatchID(msgs []sdk.Msg) (common.Hash, bool) {
+	if len(msgs) == 0 {
+		return common.Hash{}, false
+	}
+	msg, ok := msgs[0].(*MsgEthereumTx)
+	if !ok {
+		return common.Hash{}, false
+	}
+	return msg.TxHash(), true
+}
+
+// WithBatchID returns a copy of the context carrying the batch id, the messages
+// executed with it are reverted together if any of them fails.
+func WithBatchID(ctx sdk.Context, id common.Hash) sdk.Context {
+	return ctx.WithValue(batchIDKey{}, id)
+}
+
+// BatchIDFromContext returns the batch id set by the ante handler, if any.
+func BatchIDFromContext(ctx sdk.Context) (common.Hash, bool) {
+	id, ok := ctx.Value(batchIDKey{}).(common.Hash)
+	return id, ok
+}
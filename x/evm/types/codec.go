@@ -23,6 +23,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	proto "github.com/gogo/protobuf/proto"
 )
 
@@ -38,7 +39,9 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/MsgUpdateParams"
+	updateParamsName              = "ethermint/MsgUpdateParams"
+	callContractName              = "ethermint/MsgCallContract"
+	contractCallAuthorizationName = "ethermint/ContractCallAuthorization"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -52,16 +55,23 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*tx.TxExtensionOptionI)(nil),
 		&ExtensionOptionsEthereumTx{},
+		&ExtensionOptionsEthereumBatchTx{},
 	)
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgCallContract{},
+	)
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&ContractCallAuthorization{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
 		(*TxData)(nil),
 		&DynamicFeeTx{},
+		&SetCodeTx{},
 		&AccessListTx{},
 		&LegacyTx{},
 	)
@@ -104,4 +114,6 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgCallContract{}, callContractName, nil)
+	cdc.RegisterConcrete(&ContractCallAuthorization{}, contractCallAuthorizationName, nil)
 }
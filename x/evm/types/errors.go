@@ -42,6 +42,8 @@ const (
 	codeErrGasOverflow
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
+	codeErrBatchReverted
+	codeErrForkState
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -91,6 +93,12 @@ var (
 
 	// ErrInvalidGasLimit returns an error if gas limit value is invalid
 	ErrInvalidGasLimit = errorsmod.Register(ModuleName, codeErrInvalidGasLimit, "invalid gas limit")
+
+	// ErrBatchReverted returns an error if a message of an atomic batch failed, reverting the whole batch
+	ErrBatchReverted = errorsmod.Register(ModuleName, codeErrBatchReverted, "ethereum tx batch reverted")
+
+	// ErrForkState returns an error if the state of a forked chain can't be loaded from the remote node
+	ErrForkState = errorsmod.Register(ModuleName, codeErrForkState, "failed to load the forked state")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
@@ -40,6 +40,10 @@ type Params struct {
 	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
 	// signed) transactions can be executed on the state machine.
 	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
+	// webauthn_rp_id defines the relying party ID, i.e the domain, of the passkeys
+	// that can sign Cosmos transactions with WebAuthn assertions. WebAuthn
+	// assertions are rejected if it is empty.
+	WebAuthnRPID string `protobuf:"bytes,7,opt,name=webauthn_rp_id,json=webauthnRpId,proto3" json:"webauthn_rp_id,omitempty" yaml:"webauthn_rp_id"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -117,6 +121,13 @@ func (m *Params) GetAllowUnprotectedTxs() bool {
 	return false
 }
 
+func (m *Params) GetWebAuthnRPID() string {
+	if m != nil {
+		return m.WebAuthnRPID
+	}
+	return ""
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -689,108 +700,110 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0x4d, 0x6f, 0xe3, 0xb8,
-	0x19, 0x4e, 0x62, 0x25, 0x91, 0x69, 0xc7, 0xd6, 0x30, 0x4e, 0xd6, 0x3b, 0x83, 0x46, 0xa9, 0x4e,
-	0x29, 0xb0, 0x9b, 0x4c, 0x32, 0x4d, 0x77, 0xb0, 0x8b, 0xb6, 0x18, 0xcf, 0xcc, 0xb6, 0x49, 0xa7,
-	0xdb, 0x01, 0x93, 0x45, 0xd1, 0xa2, 0x85, 0x40, 0x4b, 0x5c, 0x59, 0x13, 0x49, 0x34, 0x48, 0xca,
-	0x63, 0xf7, 0x17, 0xb4, 0xe8, 0xa5, 0x3f, 0x61, 0x7f, 0xce, 0xa2, 0xa7, 0x3d, 0x16, 0x3d, 0x08,
-	0x45, 0xe6, 0x96, 0xa3, 0xef, 0x05, 0x0a, 0x7e, 0x58, 0xfe, 0x48, 0xea, 0xf5, 0xc9, 0x7c, 0xde,
-	0x8f, 0xe7, 0x21, 0x5f, 0xbe, 0x32, 0x49, 0xf0, 0x98, 0x88, 0x1e, 0x61, 0x69, 0x9c, 0x89, 0x13,
-	0x32, 0x48, 0x4f, 0x06, 0xa7, 0xf2, 0xe7, 0xb8, 0xcf, 0xa8, 0xa0, 0xd0, 0x29, 0x7d, 0xc7, 0xd2,
-	0x38, 0x38, 0x7d, 0xdc, 0x8a, 0x68, 0x44, 0x95, 0xf3, 0x44, 0x8e, 0x74, 0x9c, 0xf7, 0xb7, 0x0a,
-	0xd8, 0x7a, 0x8b, 0x19, 0x4e, 0x39, 0x3c, 0x05, 0x55, 0x32, 0x48, 0xfd, 0x90, 0x64, 0x34, 0x6d,
-	0xaf, 0x1f, 0xae, 0x1f, 0x55, 0x3b, 0xad, 0x71, 0xe1, 0x3a, 0x23, 0x9c, 0x26, 0x9f, 0x7b, 0xa5,
-	0xcb, 0x43, 0x36, 0x19, 0xa4, 0xaf, 0xe4, 0x10, 0xfe, 0x1c, 0xec, 0x90, 0x0c, 0x77, 0x13, 0xe2,
-	0x07, 0x8c, 0x60, 0x41, 0xda, 0x1b, 0x87, 0xeb, 0x47, 0x76, 0xa7, 0x3d, 0x2e, 0xdc, 0x96, 0x49,
-	0x9b, 0x75, 0x7b, 0xa8, 0xae, 0xf1, 0x4b, 0x05, 0xe1, 0x67, 0xa0, 0x36, 0xf1, 0xe3, 0x24, 0x69,
-	0x57, 0x54, 0xf2, 0xfe, 0xb8, 0x70, 0xe1, 0x7c, 0x32, 0x4e, 0x12, 0x0f, 0x01, 0x93, 0x8a, 0x93,
-	0x04, 0xbe, 0x00, 0x80, 0x0c, 0x05, 0xc3, 0x3e, 0x89, 0xfb, 0xbc, 0x6d, 0x1d, 0x56, 0x8e, 0x2a,
-	0x1d, 0xef, 0xb6, 0x70, 0xab, 0xaf, 0xa5, 0xf5, 0xf5, 0xc5, 0x5b, 0x3e, 0x2e, 0xdc, 0x47, 0x86,
-	0xa4, 0x0c, 0xf4, 0x50, 0x55, 0x81, 0xd7, 0x71, 0x9f, 0xc3, 0x3f, 0x83, 0x7a, 0xd0, 0xc3, 0x71,
-	0xe6, 0x07, 0x34, 0xfb, 0x26, 0x8e, 0xda, 0x9b, 0x87, 0xeb, 0x47, 0xb5, 0xb3, 0x1f, 0x1d, 0x2f,
-	0xd6, 0xed, 0xf8, 0xa5, 0x8c, 0x7a, 0xa9, 0x82, 0x3a, 0x4f, 0xbe, 0x2b, 0xdc, 0xb5, 0x71, 0xe1,
-	0xee, 0x6a, 0xea, 0x59, 0x02, 0x0f, 0xd5, 0x82, 0x69, 0x24, 0x3c, 0x03, 0x7b, 0x38, 0x49, 0xe8,
-	0x7b, 0x3f, 0xcf, 0x64, 0xa1, 0x49, 0x20, 0x48, 0xe8, 0x8b, 0x21, 0x6f, 0x6f, 0xc9, 0x45, 0xa2,
-	0x5d, 0xe5, 0xfc, 0x7a, 0xea, 0xbb, 0x1e, 0x72, 0xef, 0xbf, 0x0d, 0x50, 0x9b, 0x51, 0x83, 0x7f,
-	0x02, 0xcd, 0x1e, 0x4d, 0x09, 0x17, 0x04, 0x87, 0x7e, 0x37, 0xa1, 0xc1, 0x8d, 0xd9, 0x96, 0x67,
-	0xff, 0x2e, 0xdc, 0xbd, 0x80, 0xf2, 0x94, 0x72, 0x1e, 0xde, 0x1c, 0xc7, 0xf4, 0x24, 0xc5, 0xa2,
-	0x77, 0x7c, 0x91, 0x89, 0x71, 0xe1, 0xee, 0xeb, 0xb9, 0x2d, 0x64, 0x7a, 0xa8, 0x51, 0x5a, 0x3a,
-	0xd2, 0x00, 0x7b, 0xa0, 0x11, 0x62, 0xea, 0x7f, 0x43, 0xd9, 0x8d, 0x21, 0xdf, 0x50, 0xe4, 0x9d,
-	0xff, 0x4b, 0x7e, 0x5b, 0xb8, 0xf5, 0x57, 0x2f, 0x7e, 0xf7, 0x25, 0x65, 0x37, 0x8a, 0x62, 0x5c,
-	0xb8, 0x7b, 0x5a, 0x6c, 0x9e, 0xc8, 0x43, 0xf5, 0x10, 0xd3, 0x32, 0x0c, 0xfe, 0x1e, 0x38, 0x65,
-	0x00, 0xcf, 0xfb, 0x7d, 0xca, 0x84, 0xd9, 0xeb, 0x4f, 0x6f, 0x0b, 0xb7, 0x61, 0x28, 0xaf, 0xb4,
-	0x67, 0x5c, 0xb8, 0x1f, 0x2d, 0x90, 0x9a, 0x1c, 0x0f, 0x35, 0x0c, 0xad, 0x09, 0x85, 0x5d, 0x50,
-	0x27, 0x71, 0xff, 0xf4, 0xfc, 0xa9, 0x59, 0x80, 0xa5, 0x16, 0xf0, 0xcb, 0x65, 0x0b, 0xa8, 0xbd,
-	0xbe, 0x78, 0x7b, 0x7a, 0xfe, 0x74, 0x32, 0x7f, 0xb3, 0x91, 0xb3, 0x2c, 0x1e, 0xaa, 0x69, 0xa8,
-	0x27, 0x7f, 0x01, 0x0c, 0xf4, 0x7b, 0x98, 0xf7, 0x54, 0x9b, 0x54, 0x3b, 0x47, 0xb7, 0x85, 0x0b,
-	0x34, 0xd3, 0xaf, 0x31, 0xef, 0x4d, 0xab, 0xde, 0x1d, 0xfd, 0x05, 0x67, 0x22, 0xce, 0xd3, 0x09,
-	0x17, 0xd0, 0xc9, 0x32, 0xaa, 0x9c, 0xee, 0xb9, 0x99, 0xee, 0xd6, 0xaa, 0xd3, 0x3d, 0x7f, 0x68,
-	0xba, 0xe7, 0xf3, 0xd3, 0xd5, 0x31, 0xa5, 0xc6, 0x73, 0xa3, 0xb1, 0xbd, 0xaa, 0xc6, 0xf3, 0x87,
-	0x34, 0x9e, 0xcf, 0x6b, 0xe8, 0x18, 0xd9, 0x97, 0x0b, 0xeb, 0x6c, 0xdb, 0x2b, 0xf7, 0xe5, 0xbd,
-	0x0a, 0x35, 0x4a, 0x8b, 0x66, 0xbf, 0x01, 0xad, 0x80, 0x66, 0x5c, 0x48, 0x5b, 0x46, 0xfb, 0x09,
-	0x31, 0x12, 0x55, 0x25, 0xf1, 0x7c, 0x99, 0xc4, 0x13, 0xf3, 0x59, 0x3e, 0x90, 0xee, 0xa1, 0xdd,
-	0x79, 0xb3, 0x16, 0xf3, 0x81, 0xd3, 0x27, 0x82, 0x30, 0xde, 0xcd, 0x59, 0x64, 0x84, 0x80, 0x12,
-	0xfa, 0xe9, 0x32, 0x21, 0xd3, 0xa1, 0x8b, 0xa9, 0x1e, 0x6a, 0x4e, 0x4d, 0x5a, 0xe0, 0x0f, 0xa0,
-	0x11, 0x4b, 0xd5, 0x6e, 0x9e, 0x18, 0xfa, 0x9a, 0xa2, 0x3f, 0x5b, 0x46, 0x6f, 0xbe, 0xaa, 0xf9,
-	0x44, 0x0f, 0xed, 0x4c, 0x0c, 0x9a, 0x3a, 0x04, 0x30, 0xcd, 0x63, 0xe6, 0x47, 0x09, 0x0e, 0x62,
-	0xc2, 0x0c, 0x7d, 0x5d, 0xd1, 0xff, 0x6c, 0x19, 0xfd, 0xc7, 0x9a, 0xfe, 0x7e, 0xb2, 0x87, 0x1c,
-	0x69, 0xfc, 0x95, 0xb6, 0x69, 0x95, 0x2b, 0x50, 0xef, 0x12, 0x96, 0xc4, 0x99, 0xe1, 0xdf, 0x51,
-	0xfc, 0x4f, 0x97, 0xf1, 0x9b, 0x0e, 0x9a, 0x4d, 0xf3, 0x50, 0x4d, 0xc3, 0x92, 0x34, 0xa1, 0x59,
-	0x48, 0x27, 0xa4, 0x8f, 0x56, 0x26, 0x9d, 0x4d, 0xf3, 0x50, 0x4d, 0x43, 0x4d, 0x1a, 0x81, 0x5d,
-	0xcc, 0x18, 0x7d, 0xbf, 0x50, 0x10, 0xa8, 0xb8, 0x3f, 0x5b, 0xc6, 0xfd, 0x58, 0x73, 0x3f, 0x90,
-	0xed, 0xa1, 0x47, 0xca, 0x3a, 0x57, 0x92, 0x10, 0xc0, 0x88, 0xe1, 0xd1, 0x82, 0x4e, 0x6b, 0xe5,
-	0xc2, 0xdf, 0x4f, 0xf6, 0x90, 0x23, 0x8d, 0x73, 0x2a, 0xef, 0x40, 0x2b, 0x25, 0x2c, 0x22, 0x7e,
-	0x46, 0x04, 0xef, 0x27, 0xb1, 0x30, 0x3a, 0x7b, 0x2b, 0x7f, 0x07, 0x0f, 0xa5, 0x7b, 0x08, 0x2a,
-	0xf3, 0x57, 0xc6, 0x5a, 0x76, 0x29, 0xef, 0xe1, 0x2c, 0xea, 0xe1, 0xd8, 0xa8, 0xec, 0xaf, 0xdc,
-	0xa5, 0xf3, 0x89, 0x1e, 0xda, 0x99, 0x18, 0xca, 0xad, 0x0e, 0x70, 0x16, 0xe4, 0x93, 0xad, 0xfe,
-	0x68, 0xe5, 0xad, 0x9e, 0x4d, 0x93, 0xa7, 0xab, 0x82, 0x8a, 0xf4, 0xd2, 0xb2, 0x1b, 0x4e, 0xf3,
-	0xd2, 0xb2, 0x9b, 0x8e, 0x73, 0x69, 0xd9, 0x8e, 0xf3, 0xe8, 0xd2, 0xb2, 0x77, 0x9d, 0x16, 0xda,
-	0x19, 0xd1, 0x84, 0xfa, 0x83, 0x67, 0x3a, 0x09, 0xd5, 0xc8, 0x7b, 0xcc, 0xcd, 0x1f, 0x0d, 0x6a,
-	0x04, 0x58, 0xe0, 0x64, 0xc4, 0x4d, 0x21, 0x90, 0xa3, 0xcb, 0x33, 0x73, 0x6c, 0x9d, 0x80, 0xcd,
-	0x2b, 0x21, 0xef, 0x25, 0x0e, 0xa8, 0xdc, 0x90, 0x91, 0x3e, 0x6c, 0x91, 0x1c, 0xc2, 0x16, 0xd8,
-	0x1c, 0xe0, 0x24, 0xd7, 0x17, 0x9c, 0x2a, 0xd2, 0xc0, 0x7b, 0x0b, 0x9a, 0xd7, 0x0c, 0x67, 0x1c,
-	0x07, 0x22, 0xa6, 0xd9, 0x1b, 0x1a, 0x71, 0x08, 0x81, 0xa5, 0xce, 0x09, 0x9d, 0xab, 0xc6, 0xf0,
-	0x27, 0xc0, 0x4a, 0x68, 0xc4, 0xdb, 0x1b, 0x87, 0x95, 0xa3, 0xda, 0xd9, 0xde, 0xfd, 0x2b, 0xc6,
-	0x1b, 0x1a, 0x21, 0x15, 0xe2, 0xfd, 0x73, 0x03, 0x54, 0xde, 0xd0, 0x08, 0xb6, 0xc1, 0x36, 0x0e,
-	0x43, 0x46, 0x38, 0x37, 0x4c, 0x13, 0x08, 0xf7, 0xc1, 0x96, 0xa0, 0xfd, 0x38, 0xd0, 0x74, 0x55,
-	0x64, 0x90, 0x14, 0x0e, 0xb1, 0xc0, 0xea, 0x60, 0xad, 0x23, 0x35, 0x86, 0x67, 0xa0, 0xae, 0x56,
-	0xe6, 0x67, 0x79, 0xda, 0x25, 0x4c, 0x9d, 0x8f, 0x56, 0xa7, 0x79, 0x57, 0xb8, 0x35, 0x65, 0xff,
-	0x4a, 0x99, 0xd1, 0x2c, 0x80, 0x9f, 0x80, 0x6d, 0x31, 0x9c, 0x3d, 0xeb, 0x76, 0xef, 0x0a, 0xb7,
-	0x29, 0xa6, 0xcb, 0x94, 0x47, 0x19, 0xda, 0x12, 0x43, 0x75, 0xa4, 0x9d, 0x00, 0x5b, 0x0c, 0xfd,
-	0x38, 0x0b, 0xc9, 0x50, 0x1d, 0x67, 0x56, 0xa7, 0x75, 0x57, 0xb8, 0xce, 0x4c, 0xf8, 0x85, 0xf4,
-	0xa1, 0x6d, 0x31, 0x54, 0x03, 0xf8, 0x09, 0x00, 0x7a, 0x4a, 0x4a, 0x41, 0x9f, 0x4e, 0x3b, 0x77,
-	0x85, 0x5b, 0x55, 0x56, 0xc5, 0x3d, 0x1d, 0x42, 0x0f, 0x6c, 0x6a, 0x6e, 0x5b, 0x71, 0xd7, 0xef,
-	0x0a, 0xd7, 0x4e, 0x68, 0xa4, 0x39, 0xb5, 0x4b, 0x96, 0x8a, 0x91, 0x94, 0x0e, 0x48, 0xa8, 0x8e,
-	0x08, 0x1b, 0x4d, 0xa0, 0xf7, 0xf7, 0x0d, 0x60, 0x5f, 0x0f, 0x11, 0xe1, 0x79, 0x22, 0xe0, 0x97,
-	0xc0, 0x09, 0x68, 0x26, 0x18, 0x0e, 0x84, 0x3f, 0x57, 0xda, 0xce, 0x93, 0xe9, 0x1f, 0xfa, 0x62,
-	0x84, 0x87, 0x9a, 0x13, 0xd3, 0x0b, 0x53, 0xff, 0x16, 0xd8, 0xec, 0x26, 0x94, 0xa6, 0xaa, 0x13,
-	0xea, 0x48, 0x03, 0x88, 0x54, 0xd5, 0xd4, 0x2e, 0x57, 0xd4, 0x45, 0xf2, 0xc7, 0xf7, 0x77, 0x79,
-	0xa1, 0x55, 0x3a, 0xfb, 0xe6, 0x32, 0xd9, 0xd0, 0xda, 0x26, 0xdf, 0x93, 0xb5, 0x55, 0xad, 0xe4,
-	0x80, 0x0a, 0x23, 0x42, 0x6d, 0x5a, 0x1d, 0xc9, 0x21, 0x7c, 0x0c, 0x6c, 0x46, 0x06, 0x84, 0x09,
-	0x12, 0xaa, 0xcd, 0xb1, 0x51, 0x89, 0xe1, 0xc7, 0xc0, 0x8e, 0x30, 0xf7, 0x73, 0x4e, 0x42, 0xbd,
-	0x13, 0x68, 0x3b, 0xc2, 0xfc, 0x6b, 0x4e, 0xc2, 0xcf, 0xad, 0xbf, 0x7e, 0xeb, 0xae, 0x79, 0x18,
-	0xd4, 0x5e, 0x04, 0x01, 0xe1, 0xfc, 0x3a, 0xef, 0x27, 0x64, 0x49, 0x87, 0x9d, 0x81, 0x3a, 0x17,
-	0x94, 0xe1, 0x88, 0xf8, 0x37, 0x64, 0x64, 0xfa, 0x4c, 0x77, 0x8d, 0xb1, 0xff, 0x86, 0x8c, 0x38,
-	0x9a, 0x05, 0x46, 0xe2, 0x5b, 0x0b, 0xd4, 0xae, 0x19, 0x0e, 0x88, 0xb9, 0xc0, 0xca, 0x5e, 0x95,
-	0x90, 0x19, 0x09, 0x83, 0xa4, 0xb6, 0x88, 0x53, 0x42, 0x73, 0x61, 0xbe, 0xa7, 0x09, 0x94, 0x19,
-	0x8c, 0x90, 0x21, 0x09, 0x54, 0x19, 0x2d, 0x64, 0x10, 0x3c, 0x07, 0x3b, 0x61, 0xcc, 0xd5, 0x6b,
-	0x80, 0x0b, 0x1c, 0xdc, 0xe8, 0xe5, 0x77, 0x9c, 0xbb, 0xc2, 0xad, 0x1b, 0xc7, 0x95, 0xb4, 0xa3,
-	0x39, 0x04, 0xbf, 0x00, 0xcd, 0x69, 0x9a, 0x9a, 0xad, 0xbe, 0x7f, 0x77, 0xe0, 0x5d, 0xe1, 0x36,
-	0xca, 0x50, 0xe5, 0x41, 0x0b, 0x58, 0xee, 0x74, 0x48, 0xba, 0x79, 0xa4, 0x9a, 0xcf, 0x46, 0x1a,
-	0x48, 0x6b, 0x12, 0xa7, 0xb1, 0x50, 0xcd, 0xb6, 0x89, 0x34, 0x80, 0x5f, 0x80, 0x2a, 0x1d, 0x10,
-	0xc6, 0xe2, 0x90, 0x70, 0x75, 0x81, 0xf8, 0xa1, 0xa7, 0x04, 0x9a, 0xc6, 0xcb, 0xc5, 0x99, 0x97,
-	0x4e, 0x4a, 0x52, 0xca, 0x46, 0xea, 0x8a, 0x60, 0x16, 0xa7, 0x1d, 0xbf, 0x55, 0x76, 0x34, 0x87,
-	0x60, 0x07, 0x40, 0x93, 0xc6, 0x88, 0xc8, 0x59, 0xe6, 0xab, 0xef, 0xbf, 0xae, 0x72, 0xd5, 0x57,
-	0xa8, 0xbd, 0x48, 0x39, 0x5f, 0x61, 0x81, 0xd1, 0x3d, 0x0b, 0xfc, 0x05, 0x80, 0x7a, 0x4f, 0xfc,
-	0x77, 0x9c, 0x96, 0x6f, 0x21, 0x7d, 0xc6, 0x2b, 0x7d, 0xed, 0x35, 0x73, 0x76, 0x34, 0xba, 0xe4,
-	0xd4, 0xac, 0xe2, 0xd2, 0xb2, 0x2d, 0x67, 0xf3, 0xd2, 0xb2, 0xb7, 0x1d, 0xbb, 0xac, 0x9f, 0x59,
-	0x05, 0xda, 0x9d, 0xe0, 0x99, 0xe9, 0x75, 0x2e, 0xbe, 0xbb, 0x3d, 0x58, 0xff, 0xfe, 0xf6, 0x60,
-	0xfd, 0x3f, 0xb7, 0x07, 0xeb, 0xff, 0xf8, 0x70, 0xb0, 0xf6, 0xfd, 0x87, 0x83, 0xb5, 0x7f, 0x7d,
-	0x38, 0x58, 0xfb, 0xe3, 0x49, 0x14, 0x8b, 0x5e, 0xde, 0x3d, 0x0e, 0x68, 0x7a, 0xd2, 0x23, 0x22,
-	0xff, 0xb4, 0xcf, 0xe8, 0x3b, 0x12, 0x08, 0x0d, 0x7a, 0x79, 0x57, 0x3e, 0x70, 0x87, 0xea, 0xa5,
-	0x2b, 0x46, 0x7d, 0xc2, 0xbb, 0x5b, 0xea, 0x05, 0xfb, 0xec, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x69, 0xbb, 0xb1, 0xae, 0x07, 0x0f, 0x00, 0x00,
+	// 1643 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0x4d, 0x6f, 0xe3, 0xc6,
+	0x19, 0xb6, 0x2d, 0xd9, 0xa6, 0x46, 0xb4, 0xc4, 0x1d, 0xcb, 0x8e, 0xb2, 0x8b, 0x9a, 0x2e, 0x4f,
+	0x0e, 0x9a, 0xd8, 0x6b, 0x6f, 0xdd, 0x2c, 0x12, 0xb4, 0x85, 0xb5, 0xeb, 0xb4, 0x76, 0xb7, 0x89,
+	0x31, 0xeb, 0x20, 0x68, 0xd1, 0x82, 0x18, 0x91, 0x13, 0x8a, 0x6b, 0x92, 0x23, 0xcc, 0x0c, 0x65,
+	0xa9, 0xbf, 0xa0, 0x40, 0x2f, 0xfd, 0x09, 0xf9, 0x39, 0x41, 0x4f, 0x39, 0x16, 0x7b, 0x20, 0x0a,
+	0xef, 0xcd, 0x47, 0xdf, 0x0b, 0x14, 0xf3, 0x21, 0xea, 0xc3, 0xae, 0xa1, 0x93, 0xe6, 0x79, 0x3f,
+	0x9e, 0x67, 0xde, 0x77, 0x5e, 0x6a, 0x48, 0xf0, 0x94, 0x88, 0x1e, 0x61, 0x69, 0x9c, 0x89, 0x03,
+	0x32, 0x48, 0x0f, 0x06, 0x87, 0xf2, 0x67, 0xbf, 0xcf, 0xa8, 0xa0, 0xd0, 0x29, 0x7d, 0xfb, 0xd2,
+	0x38, 0x38, 0x7c, 0xda, 0x8a, 0x68, 0x44, 0x95, 0xf3, 0x40, 0xae, 0x74, 0x9c, 0xf7, 0xbe, 0x02,
+	0xd6, 0x2e, 0x30, 0xc3, 0x29, 0x87, 0x87, 0xa0, 0x46, 0x06, 0xa9, 0x1f, 0x92, 0x8c, 0xa6, 0xed,
+	0xe5, 0xdd, 0xe5, 0xbd, 0x5a, 0xa7, 0x75, 0x57, 0xb8, 0xce, 0x08, 0xa7, 0xc9, 0x17, 0x5e, 0xe9,
+	0xf2, 0x90, 0x45, 0x06, 0xe9, 0x6b, 0xb9, 0x84, 0xbf, 0x06, 0x1b, 0x24, 0xc3, 0xdd, 0x84, 0xf8,
+	0x01, 0x23, 0x58, 0x90, 0xf6, 0xca, 0xee, 0xf2, 0x9e, 0xd5, 0x69, 0xdf, 0x15, 0x6e, 0xcb, 0xa4,
+	0x4d, 0xbb, 0x3d, 0x64, 0x6b, 0xfc, 0x4a, 0x41, 0xf8, 0x39, 0xa8, 0x8f, 0xfd, 0x38, 0x49, 0xda,
+	0x15, 0x95, 0xbc, 0x7d, 0x57, 0xb8, 0x70, 0x36, 0x19, 0x27, 0x89, 0x87, 0x80, 0x49, 0xc5, 0x49,
+	0x02, 0x4f, 0x00, 0x20, 0x43, 0xc1, 0xb0, 0x4f, 0xe2, 0x3e, 0x6f, 0x57, 0x77, 0x2b, 0x7b, 0x95,
+	0x8e, 0x77, 0x53, 0xb8, 0xb5, 0x53, 0x69, 0x3d, 0x3d, 0xbb, 0xe0, 0x77, 0x85, 0xfb, 0xc4, 0x90,
+	0x94, 0x81, 0x1e, 0xaa, 0x29, 0x70, 0x1a, 0xf7, 0x39, 0xfc, 0x2b, 0xb0, 0x83, 0x1e, 0x8e, 0x33,
+	0x3f, 0xa0, 0xd9, 0xf7, 0x71, 0xd4, 0x5e, 0xdd, 0x5d, 0xde, 0xab, 0x1f, 0xfd, 0x6c, 0x7f, 0xbe,
+	0x6f, 0xfb, 0xaf, 0x64, 0xd4, 0x2b, 0x15, 0xd4, 0x79, 0xf6, 0x63, 0xe1, 0x2e, 0xdd, 0x15, 0xee,
+	0xa6, 0xa6, 0x9e, 0x26, 0xf0, 0x50, 0x3d, 0x98, 0x44, 0xc2, 0x23, 0xb0, 0x85, 0x93, 0x84, 0x5e,
+	0xfb, 0x79, 0x26, 0x1b, 0x4d, 0x02, 0x41, 0x42, 0x5f, 0x0c, 0x79, 0x7b, 0x4d, 0x16, 0x89, 0x36,
+	0x95, 0xf3, 0xdb, 0x89, 0xef, 0x72, 0xc8, 0xe1, 0x37, 0xa0, 0x71, 0x4d, 0xba, 0x38, 0x17, 0xbd,
+	0xcc, 0x67, 0x7d, 0x3f, 0x0e, 0xdb, 0xeb, 0xea, 0x14, 0x3e, 0xb9, 0x29, 0x5c, 0xfb, 0x3b, 0xd2,
+	0x3d, 0x91, 0x1e, 0x74, 0x71, 0xf6, 0xfa, 0xae, 0x70, 0xb7, 0xf4, 0x0e, 0x66, 0xe3, 0x3d, 0x64,
+	0x8f, 0x0d, 0xa8, 0x7f, 0x16, 0x7a, 0xff, 0x6d, 0x80, 0xfa, 0xd4, 0xf6, 0xe1, 0x5f, 0x40, 0xb3,
+	0x47, 0x53, 0xc2, 0x05, 0xc1, 0xa1, 0xdf, 0x4d, 0x68, 0x70, 0x65, 0xce, 0xf9, 0xc5, 0xfb, 0xc2,
+	0xdd, 0x0a, 0x28, 0x4f, 0x29, 0xe7, 0xe1, 0xd5, 0x7e, 0x4c, 0x0f, 0x52, 0x2c, 0x7a, 0xfb, 0x67,
+	0x99, 0xb8, 0x2b, 0xdc, 0x6d, 0x2d, 0x35, 0x97, 0xe9, 0xa1, 0x46, 0x69, 0xe9, 0x48, 0x03, 0xec,
+	0x81, 0x46, 0x88, 0xa9, 0xff, 0x3d, 0x65, 0x57, 0x86, 0x7c, 0x45, 0x91, 0x77, 0xfe, 0x2f, 0xb9,
+	0xac, 0xeb, 0xf5, 0xc9, 0x37, 0x5f, 0x51, 0x76, 0xa5, 0x28, 0x26, 0x75, 0xcd, 0x12, 0x79, 0xc8,
+	0x0e, 0x31, 0x2d, 0xc3, 0xe0, 0x77, 0xc0, 0x29, 0x03, 0x78, 0xde, 0xef, 0x53, 0x26, 0xcc, 0xf0,
+	0x7c, 0x76, 0x53, 0xb8, 0x0d, 0x43, 0xf9, 0x56, 0x7b, 0xee, 0x0a, 0xf7, 0xa3, 0x39, 0x52, 0x93,
+	0xe3, 0xa1, 0x86, 0xa1, 0x35, 0xa1, 0xb0, 0x0b, 0x6c, 0x12, 0xf7, 0x0f, 0x8f, 0x9f, 0x9b, 0x02,
+	0xaa, 0xaa, 0x80, 0xdf, 0x3e, 0x56, 0x40, 0xfd, 0xf4, 0xec, 0xe2, 0xf0, 0xf8, 0xf9, 0x78, 0xff,
+	0x66, 0x32, 0xa6, 0x59, 0x3c, 0x54, 0xd7, 0x50, 0x6f, 0xfe, 0x0c, 0x18, 0xe8, 0xf7, 0x30, 0xef,
+	0xa9, 0xb9, 0xab, 0x75, 0xf6, 0x6e, 0x0a, 0x17, 0x68, 0xa6, 0xdf, 0x63, 0xde, 0x9b, 0x74, 0xbd,
+	0x3b, 0xfa, 0x1b, 0xce, 0x44, 0x9c, 0xa7, 0x63, 0x2e, 0xa0, 0x93, 0x65, 0x54, 0xb9, 0xdd, 0x63,
+	0xb3, 0xdd, 0xb5, 0x45, 0xb7, 0x7b, 0xfc, 0xd0, 0x76, 0x8f, 0x67, 0xb7, 0xab, 0x63, 0x4a, 0x8d,
+	0x97, 0x46, 0x63, 0x7d, 0x51, 0x8d, 0x97, 0x0f, 0x69, 0xbc, 0x9c, 0xd5, 0xd0, 0x31, 0x72, 0x2e,
+	0xe7, 0xea, 0x6c, 0x5b, 0x0b, 0xcf, 0xe5, 0xbd, 0x0e, 0x35, 0x4a, 0x8b, 0x66, 0xbf, 0x02, 0xad,
+	0x80, 0x66, 0x5c, 0x48, 0x5b, 0x46, 0xfb, 0x09, 0x31, 0x12, 0x35, 0x25, 0xf1, 0xf2, 0x31, 0x89,
+	0x67, 0xe6, 0x39, 0x7f, 0x20, 0xdd, 0x43, 0x9b, 0xb3, 0x66, 0x2d, 0xe6, 0x03, 0xa7, 0x4f, 0x04,
+	0x61, 0xbc, 0x9b, 0xb3, 0xc8, 0x08, 0x01, 0x25, 0xf4, 0xcb, 0xc7, 0x84, 0xcc, 0x84, 0xce, 0xa7,
+	0x7a, 0xa8, 0x39, 0x31, 0x69, 0x81, 0x3f, 0x81, 0x46, 0x2c, 0x55, 0xbb, 0x79, 0x62, 0xe8, 0xeb,
+	0x8a, 0xfe, 0xe8, 0x31, 0x7a, 0xf3, 0x54, 0xcd, 0x26, 0x7a, 0x68, 0x63, 0x6c, 0xd0, 0xd4, 0x21,
+	0x80, 0x69, 0x1e, 0x33, 0x3f, 0x4a, 0x70, 0x10, 0x13, 0x66, 0xe8, 0x6d, 0x45, 0xff, 0xab, 0xc7,
+	0xe8, 0x3f, 0xd6, 0xf4, 0xf7, 0x93, 0x3d, 0xe4, 0x48, 0xe3, 0xef, 0xb4, 0x4d, 0xab, 0xbc, 0x05,
+	0x76, 0x97, 0xb0, 0x24, 0xce, 0x0c, 0xff, 0x86, 0xe2, 0x7f, 0xfe, 0x18, 0xbf, 0x99, 0xa0, 0xe9,
+	0x34, 0x0f, 0xd5, 0x35, 0x2c, 0x49, 0x13, 0x9a, 0x85, 0x74, 0x4c, 0xfa, 0x64, 0x61, 0xd2, 0xe9,
+	0x34, 0x0f, 0xd5, 0x35, 0xd4, 0xa4, 0x11, 0xd8, 0xc4, 0x8c, 0xd1, 0xeb, 0xb9, 0x86, 0x40, 0xc5,
+	0xfd, 0xf9, 0x63, 0xdc, 0x4f, 0x35, 0xf7, 0x03, 0xd9, 0x1e, 0x7a, 0xa2, 0xac, 0x33, 0x2d, 0x09,
+	0x01, 0x8c, 0x18, 0x1e, 0xcd, 0xe9, 0xb4, 0x16, 0x6e, 0xfc, 0xfd, 0x64, 0x0f, 0x39, 0xd2, 0x38,
+	0xa3, 0xf2, 0x0e, 0xb4, 0x52, 0xc2, 0x22, 0xe2, 0x67, 0x44, 0xf0, 0x7e, 0x12, 0x0b, 0xa3, 0xb3,
+	0xb5, 0xf0, 0x73, 0xf0, 0x50, 0xba, 0x87, 0xa0, 0x32, 0x7f, 0x6d, 0xac, 0xe5, 0x94, 0xf2, 0x1e,
+	0xce, 0xa2, 0x1e, 0x8e, 0x8d, 0xca, 0xf6, 0xc2, 0x53, 0x3a, 0x9b, 0xe8, 0xa1, 0x8d, 0xb1, 0xa1,
+	0x3c, 0xea, 0x00, 0x67, 0x41, 0x3e, 0x3e, 0xea, 0x8f, 0x16, 0x3e, 0xea, 0xe9, 0x34, 0x79, 0x5d,
+	0x2b, 0xa8, 0x48, 0xcf, 0xab, 0x56, 0xc3, 0x69, 0x9e, 0x57, 0xad, 0xa6, 0xe3, 0x9c, 0x57, 0x2d,
+	0xc7, 0x79, 0x72, 0x5e, 0xb5, 0x36, 0x9d, 0x16, 0xda, 0x18, 0xd1, 0x84, 0xfa, 0x83, 0x17, 0x3a,
+	0x09, 0xd5, 0xc9, 0x35, 0xe6, 0xe6, 0x8f, 0x06, 0x35, 0x02, 0x2c, 0x70, 0x32, 0xe2, 0xa6, 0x11,
+	0xc8, 0xd1, 0xed, 0x99, 0xba, 0xb6, 0x0e, 0xc0, 0xea, 0x5b, 0x21, 0x5f, 0x74, 0x1c, 0x50, 0xb9,
+	0x22, 0x23, 0x7d, 0xd9, 0x22, 0xb9, 0x84, 0x2d, 0xb0, 0x3a, 0xc0, 0x49, 0xae, 0xdf, 0x98, 0x6a,
+	0x48, 0x03, 0xef, 0x02, 0x34, 0x2f, 0x19, 0xce, 0x38, 0x0e, 0x44, 0x4c, 0xb3, 0x37, 0x34, 0xe2,
+	0x10, 0x82, 0xaa, 0xba, 0x27, 0x74, 0xae, 0x5a, 0xc3, 0x4f, 0x40, 0x35, 0xa1, 0x11, 0x6f, 0xaf,
+	0xec, 0x56, 0xf6, 0xea, 0x47, 0x5b, 0xf7, 0xdf, 0x59, 0xde, 0xd0, 0x08, 0xa9, 0x10, 0xef, 0x5f,
+	0x2b, 0xa0, 0xf2, 0x86, 0x46, 0xb0, 0x0d, 0xd6, 0x71, 0x18, 0x32, 0xc2, 0xb9, 0x61, 0x1a, 0x43,
+	0xb8, 0x0d, 0xd6, 0x04, 0xed, 0xc7, 0x81, 0xa6, 0xab, 0x21, 0x83, 0xa4, 0x70, 0x88, 0x05, 0x56,
+	0x17, 0xab, 0x8d, 0xd4, 0x1a, 0x1e, 0x01, 0x5b, 0x55, 0xe6, 0x67, 0x79, 0xda, 0x25, 0x4c, 0xdd,
+	0x8f, 0xd5, 0x4e, 0xf3, 0xb6, 0x70, 0xeb, 0xca, 0xfe, 0xb5, 0x32, 0xa3, 0x69, 0x00, 0x3f, 0x05,
+	0xeb, 0x62, 0x38, 0x7d, 0xd7, 0x6d, 0xde, 0x16, 0x6e, 0x53, 0x4c, 0xca, 0x94, 0x57, 0x19, 0x5a,
+	0x13, 0x43, 0xf9, 0x0b, 0x0f, 0x80, 0x25, 0x86, 0x7e, 0x9c, 0x85, 0x64, 0xa8, 0xae, 0xb3, 0x6a,
+	0xa7, 0x75, 0x5b, 0xb8, 0xce, 0x54, 0xf8, 0x99, 0xf4, 0xa1, 0x75, 0x31, 0x54, 0x0b, 0xf8, 0x29,
+	0x00, 0x7a, 0x4b, 0x4a, 0x41, 0xdf, 0x4e, 0x1b, 0xb7, 0x85, 0x5b, 0x53, 0x56, 0xc5, 0x3d, 0x59,
+	0x42, 0x0f, 0xac, 0x6a, 0x6e, 0x4b, 0x71, 0xdb, 0xb7, 0x85, 0x6b, 0x25, 0x34, 0xd2, 0x9c, 0xda,
+	0x25, 0x5b, 0xc5, 0x48, 0x4a, 0x07, 0x24, 0x54, 0x57, 0x84, 0x85, 0xc6, 0xd0, 0xfb, 0xc7, 0x0a,
+	0xb0, 0x2e, 0x87, 0x88, 0xf0, 0x3c, 0x11, 0xf0, 0x2b, 0xe0, 0x04, 0x34, 0x13, 0x0c, 0x07, 0xc2,
+	0x9f, 0x69, 0x6d, 0xe7, 0xd9, 0xe4, 0x0f, 0x7d, 0x3e, 0xc2, 0x43, 0xcd, 0xb1, 0xe9, 0xc4, 0xf4,
+	0xbf, 0x05, 0x56, 0xbb, 0x09, 0xa5, 0xa9, 0x9a, 0x04, 0x1b, 0x69, 0x00, 0x91, 0xea, 0x9a, 0x3a,
+	0xe5, 0x8a, 0x7a, 0x33, 0xfd, 0xf9, 0xfd, 0x53, 0x9e, 0x1b, 0x95, 0xce, 0xb6, 0x79, 0x3b, 0x6d,
+	0x68, 0x6d, 0x93, 0xef, 0xc9, 0xde, 0xaa, 0x51, 0x72, 0x40, 0x85, 0x11, 0xa1, 0x0e, 0xcd, 0x46,
+	0x72, 0x09, 0x9f, 0x02, 0x8b, 0x91, 0x01, 0x61, 0x82, 0x84, 0xea, 0x70, 0x2c, 0x54, 0x62, 0xf8,
+	0x31, 0xb0, 0x22, 0xcc, 0xfd, 0x9c, 0x93, 0x50, 0x9f, 0x04, 0x5a, 0x8f, 0x30, 0xff, 0x96, 0x93,
+	0xf0, 0x8b, 0xea, 0xdf, 0x7f, 0x70, 0x97, 0x3c, 0x0c, 0xea, 0x27, 0x41, 0x40, 0x38, 0xbf, 0xcc,
+	0xfb, 0x09, 0x79, 0x64, 0xc2, 0x8e, 0x80, 0xcd, 0x05, 0x65, 0x38, 0x22, 0xfe, 0x15, 0x19, 0x99,
+	0x39, 0xd3, 0x53, 0x63, 0xec, 0x7f, 0x20, 0x23, 0x8e, 0xa6, 0x81, 0x91, 0xf8, 0xa1, 0x0a, 0xea,
+	0x97, 0x0c, 0x07, 0xc4, 0xbc, 0xc0, 0xca, 0x59, 0x95, 0x90, 0x19, 0x09, 0x83, 0xa4, 0xb6, 0x88,
+	0x53, 0x42, 0x73, 0x61, 0x9e, 0xa7, 0x31, 0x94, 0x19, 0x8c, 0x90, 0x21, 0x09, 0x54, 0x1b, 0xab,
+	0xc8, 0x20, 0x78, 0x0c, 0x36, 0xc2, 0x98, 0xab, 0xcf, 0x0b, 0x2e, 0x70, 0x70, 0xa5, 0xcb, 0xef,
+	0x38, 0xb7, 0x85, 0x6b, 0x1b, 0xc7, 0x5b, 0x69, 0x47, 0x33, 0x08, 0x7e, 0x09, 0x9a, 0x93, 0x34,
+	0xb5, 0x5b, 0xfd, 0x42, 0xdf, 0x81, 0xb7, 0x85, 0xdb, 0x28, 0x43, 0x95, 0x07, 0xcd, 0x61, 0x79,
+	0xd2, 0x21, 0xe9, 0xe6, 0x91, 0x1a, 0x3e, 0x0b, 0x69, 0x20, 0xad, 0x49, 0x9c, 0xc6, 0x42, 0x0d,
+	0xdb, 0x2a, 0xd2, 0x00, 0x7e, 0x09, 0x6a, 0x74, 0x40, 0x18, 0x8b, 0x43, 0xc2, 0xdb, 0x60, 0x81,
+	0x6f, 0x13, 0x34, 0x89, 0x97, 0xc5, 0x99, 0x4f, 0xa7, 0x94, 0xa4, 0x94, 0x8d, 0xda, 0xf5, 0x49,
+	0x71, 0xda, 0xf1, 0x47, 0x65, 0x47, 0x33, 0x08, 0x76, 0x00, 0x34, 0x69, 0x8c, 0x88, 0x9c, 0x65,
+	0xbe, 0x7a, 0xfe, 0x6d, 0x95, 0xab, 0x9e, 0x42, 0xed, 0x45, 0xca, 0xf9, 0x1a, 0x0b, 0x8c, 0xee,
+	0x59, 0xe0, 0x6f, 0x00, 0xd4, 0x67, 0xe2, 0xbf, 0xe3, 0xb4, 0xfc, 0xb8, 0xd2, 0x77, 0xbc, 0xd2,
+	0xd7, 0x5e, 0xb3, 0x67, 0x47, 0xa3, 0x73, 0x4e, 0x4d, 0x15, 0xe7, 0x55, 0xab, 0xea, 0xac, 0x9e,
+	0x57, 0xad, 0x75, 0xc7, 0x2a, 0xfb, 0x67, 0xaa, 0x40, 0x9b, 0x63, 0x3c, 0xb5, 0xbd, 0xce, 0xe9,
+	0x8f, 0x37, 0x3b, 0xcb, 0x3f, 0xdd, 0xec, 0x2c, 0xff, 0xe7, 0x66, 0x67, 0xf9, 0x9f, 0x1f, 0x76,
+	0x96, 0x7e, 0xfa, 0xb0, 0xb3, 0xf4, 0xef, 0x0f, 0x3b, 0x4b, 0x7f, 0xfe, 0x45, 0x14, 0x8b, 0x5e,
+	0xde, 0xdd, 0x0f, 0x68, 0x7a, 0xd0, 0x23, 0x22, 0xff, 0xac, 0xcf, 0xe8, 0x3b, 0x12, 0x08, 0x05,
+	0xe4, 0xd7, 0xf2, 0x50, 0x7d, 0x36, 0x8b, 0x51, 0x9f, 0xf0, 0xee, 0x9a, 0xfa, 0x1c, 0x7e, 0xf1,
+	0xbf, 0x01, 0x00, 0x74, 0xe2, 0x6f, 0x12, 0x54, 0x0f, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -813,6 +826,13 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WebAuthnRPID) > 0 {
+		i -= len(m.WebAuthnRPID)
+		copy(dAtA[i:], m.WebAuthnRPID)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.WebAuthnRPID)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.AllowUnprotectedTxs {
 		i--
 		if m.AllowUnprotectedTxs {
@@ -1567,6 +1587,10 @@ func (m *Params) Size() (n int) {
 	if m.AllowUnprotectedTxs {
 		n += 2
 	}
+	l = len(m.WebAuthnRPID)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
@@ -2063,6 +2087,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUnprotectedTxs = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebAuthnRPID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebAuthnRPID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -0,0 +1,41 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ForkAccount is the account state served by a ForkSource.
+type ForkAccount struct {
+	Nonce    uint64
+	Balance  *big.Int
+	CodeHash common.Hash
+}
+
+// ForkSource provides the state of a remote chain at a fixed height. A keeper
+// configured with a ForkSource loads accounts, code and storage from it the
+// first time they are read locally.
+type ForkSource interface {
+	// GetAccount returns the remote account, or nil if it doesn't exist.
+	GetAccount(addr common.Address) (*ForkAccount, error)
+	// GetCode returns the contract code deployed at the remote address.
+	GetCode(addr common.Address) ([]byte, error)
+	// GetStorage returns the value of the remote storage slot.
+	GetStorage(addr common.Address, key common.Hash) (common.Hash, error)
+}
@@ -0,0 +1,85 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// ImpersonationSignature returns the 65 bytes [R || S || V] signature that
+// marks a transaction as sent by the impersonated address: R is zero and S
+// holds the address.
+func ImpersonationSignature(addr common.Address) []byte {
+	sig := make([]byte, 65)
+	copy(sig[44:64], addr.Bytes())
+	return sig
+}
+
+// ImpersonatedSender returns the address carried by a transaction signed with
+// ImpersonationSignature. The signature doesn't authenticate the address: the
+// transaction must only be accepted if the EVM keeper impersonates it, see
+// Keeper.SetImpersonationHook.
+func ImpersonatedSender(tx *ethtypes.Transaction) (common.Address, bool) {
+	if tx == nil {
+		return common.Address{}, false
+	}
+
+	_, r, s := tx.RawSignatureValues()
+	if r == nil || s == nil || r.Sign() != 0 || s.Sign() == 0 || s.BitLen() > common.AddressLength*8 {
+		return common.Address{}, false
+	}
+	return common.BigToAddress(s), true
+}
+
+// Sender returns the address that signed the transaction or, for the
+// transactions signed with ImpersonationSignature, the address they claim.
+// It is only used to decode the signers of the transaction, which are
+// authenticated by the ante handler.
+func Sender(signer ethtypes.Signer, tx *ethtypes.Transaction) (common.Address, error) {
+	if from, ok := ImpersonatedSender(tx); ok {
+		return from, nil
+	}
+	return signer.Sender(tx)
+}
+
+// NewImpersonatedMessage builds the core message of a transaction sent by an
+// impersonated account, mirroring Transaction.AsMessage.
+func NewImpersonatedMessage(tx *ethtypes.Transaction, from common.Address, baseFee *big.Int) ethtypes.Message {
+	gasPrice := tx.GasPrice()
+	if baseFee != nil {
+		gasPrice = new(big.Int).Add(tx.GasTipCap(), baseFee)
+		if gasPrice.Cmp(tx.GasFeeCap()) > 0 {
+			gasPrice = tx.GasFeeCap()
+		}
+	}
+
+	return ethtypes.NewMessage(
+		from,
+		tx.To(),
+		tx.Nonce(),
+		tx.Value(),
+		tx.Gas(),
+		gasPrice,
+		tx.GasFeeCap(),
+		tx.GasTipCap(),
+		tx.Data(),
+		tx.AccessList(),
+		false,
+	)
+}
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	utiltx "github.com/hetu-project/hetu/v1/testutil/tx"
+	evmtypes "github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+func TestImpersonation(t *testing.T) {
+	chainID := big.NewInt(9000)
+	signer := ethtypes.LatestSignerForChainID(chainID)
+	from := utiltx.GenerateAddress()
+	to := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name   string
+		txData ethtypes.TxData
+	}{
+		{
+			"legacy tx",
+			&ethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(10), Gas: 21000, To: &to, Value: big.NewInt(1)},
+		},
+		{
+			"dynamic fee tx",
+			&ethtypes.DynamicFeeTx{ChainID: chainID, Nonce: 1, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10), Gas: 21000, To: &to, Value: big.NewInt(1)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := ethtypes.NewTx(tc.txData).WithSignature(signer, evmtypes.ImpersonationSignature(from))
+			require.NoError(t, err)
+			require.True(t, tx.Protected())
+
+			msg := &evmtypes.MsgEthereumTx{}
+			require.NoError(t, msg.FromEthereumTx(tx))
+
+			impersonated, ok := evmtypes.ImpersonatedSender(tx)
+			require.True(t, ok)
+			require.Equal(t, from, impersonated)
+
+			// the signers are decoded from the impersonation signature
+			sender, err := evmtypes.Sender(signer, tx)
+			require.NoError(t, err)
+			require.Equal(t, from, sender)
+
+			sender, err = msg.GetSender(chainID)
+			require.NoError(t, err)
+			require.Equal(t, from, sender)
+
+			coreMsg := evmtypes.NewImpersonatedMessage(tx, from, big.NewInt(2))
+			require.Equal(t, from, coreMsg.From())
+			require.Equal(t, tx.Nonce(), coreMsg.Nonce())
+			require.Equal(t, &to, coreMsg.To())
+
+			// the core message is only built by the keeper impersonating the sender
+			_, err = msg.AsMessage(signer, big.NewInt(2))
+			require.Error(t, err)
+		})
+	}
+}
@@ -45,6 +45,7 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixFork
 )
 
 // prefix bytes for the EVM transient store
@@ -60,6 +61,7 @@ var (
 	KeyPrefixCode    = []byte{prefixCode}
 	KeyPrefixStorage = []byte{prefixStorage}
 	KeyPrefixParams  = []byte{prefixParams}
+	KeyPrefixFork    = []byte{prefixFork}
 )
 
 // Transient Store key prefixes
@@ -75,6 +77,16 @@ func AddressStoragePrefix(address common.Address) []byte {
 	return append(KeyPrefixStorage, address.Bytes()...)
 }
 
+// ForkAccountKey defines the key marking an account as loaded from the fork source.
+func ForkAccountKey(address common.Address) []byte {
+	return append(KeyPrefixFork, address.Bytes()...)
+}
+
+// ForkStateKey defines the key marking a storage slot as loaded from the fork source.
+func ForkStateKey(address common.Address, key common.Hash) []byte {
+	return append(ForkAccountKey(address), key.Bytes()...)
+}
+
 // StateKey defines the full key under which an account state is stored.
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
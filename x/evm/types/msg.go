@@ -34,6 +34,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	proto "github.com/cosmos/gogoproto/proto"
 
 	evmapi "github.com/hetu-project/hetu/v1/api/ethermint/evm/v1"
 	"github.com/hetu-project/hetu/v1/types"
@@ -41,6 +42,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 var (
@@ -48,6 +50,7 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgCallContract{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -99,6 +102,29 @@ func newMsgEthereumTx(
 	}
 
 	switch {
+	case len(tx.AuthorizationList) > 0:
+		var gtc, gfc *sdkmath.Int
+		if tx.GasTipCap != nil {
+			gasTipCapInt := sdkmath.NewIntFromBigInt(tx.GasTipCap)
+			gtc = &gasTipCapInt
+		}
+		if tx.GasFeeCap != nil {
+			gasFeeCapInt := sdkmath.NewIntFromBigInt(tx.GasFeeCap)
+			gfc = &gasFeeCapInt
+		}
+
+		txData = &SetCodeTx{
+			ChainID:        cid,
+			Amount:         amt,
+			To:             toAddr,
+			GasTipCap:      gtc,
+			GasFeeCap:      gfc,
+			Nonce:          tx.Nonce,
+			GasLimit:       tx.GasLimit,
+			Data:           tx.Input,
+			Accesses:       NewAccessList(tx.Accesses),
+			Authorizations: tx.AuthorizationList,
+		}
 	case tx.Accesses == nil:
 		txData = &LegacyTx{
 			To:       toAddr,
@@ -143,7 +169,7 @@ func newMsgEthereumTx(
 	}
 
 	msg := MsgEthereumTx{Data: dataAny}
-	msg.Hash = msg.AsTransaction().Hash().Hex()
+	msg.Hash = msg.TxHash().Hex()
 	return &msg
 }
 
@@ -164,6 +190,28 @@ func (msg *MsgEthereumTx) FromEthereumTx(tx *ethtypes.Transaction) error {
 	return nil
 }
 
+// FromSetCodeTx populates the message fields from the given set code transaction
+func (msg *MsgEthereumTx) FromSetCodeTx(tx *SetCodeTx) error {
+	anyTxData, err := PackTxData(tx)
+	if err != nil {
+		return err
+	}
+
+	msg.Data = anyTxData
+	msg.Hash = tx.Hash().Hex()
+	return nil
+}
+
+// setCodeTx returns the tx data of the message if it is a set code transaction.
+func (msg MsgEthereumTx) setCodeTx() (*SetCodeTx, bool) {
+	txData, err := UnpackTxData(msg.Data)
+	if err != nil {
+		return nil, false
+	}
+	setCodeTx, ok := txData.(*SetCodeTx)
+	return setCodeTx, ok
+}
+
 // Route returns the route value of an MsgEthereumTx.
 func (msg MsgEthereumTx) Route() string { return RouterKey }
 
@@ -206,7 +254,7 @@ func (msg MsgEthereumTx) ValidateBasic() error {
 	}
 
 	// Validate Hash field after validated txData to avoid panic
-	txHash := msg.AsTransaction().Hash().Hex()
+	txHash := msg.TxHash().Hex()
 	if msg.Hash != txHash {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid tx hash %s, expected: %s", msg.Hash, txHash)
 	}
@@ -264,6 +312,24 @@ func (msg *MsgEthereumTx) Sign(ethSigner ethtypes.Signer, keyringSigner keyring.
 		return fmt.Errorf("sender address not defined for message")
 	}
 
+	if setCodeTx, ok := msg.setCodeTx(); ok {
+		sig, _, err := keyringSigner.SignByAddress(from, setCodeTx.SigHash(ethSigner.ChainID()).Bytes(), signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+		if err != nil {
+			return err
+		}
+		if len(sig) != crypto.SignatureLength {
+			return fmt.Errorf("wrong size for signature: got %d, want %d", len(sig), crypto.SignatureLength)
+		}
+
+		setCodeTx.SetSignatureValues(
+			ethSigner.ChainID(),
+			new(big.Int).SetBytes(sig[64:]),
+			new(big.Int).SetBytes(sig[:32]),
+			new(big.Int).SetBytes(sig[32:64]),
+		)
+		return msg.FromSetCodeTx(setCodeTx)
+	}
+
 	tx := msg.AsTransaction()
 	txHash := ethSigner.Hash(tx)
 
@@ -327,15 +393,60 @@ func (msg MsgEthereumTx) AsTransaction() *ethtypes.Transaction {
 	return ethtypes.NewTx(txData.AsEthereumData())
 }
 
+// TxHash returns the hash of the Ethereum transaction. Unlike AsTransaction().Hash(),
+// it supports the transaction types unknown to go-ethereum.
+func (msg MsgEthereumTx) TxHash() common.Hash {
+	if setCodeTx, ok := msg.setCodeTx(); ok {
+		return setCodeTx.Hash()
+	}
+	return msg.AsTransaction().Hash()
+}
+
 // AsMessage creates an Ethereum core.Message from the msg fields
 func (msg MsgEthereumTx) AsMessage(signer ethtypes.Signer, baseFee *big.Int) (core.Message, error) {
-	return msg.AsTransaction().AsMessage(signer, baseFee)
+	setCodeTx, ok := msg.setCodeTx()
+	if !ok {
+		return msg.AsTransaction().AsMessage(signer, baseFee)
+	}
+
+	from, err := setCodeTx.Sender()
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice := setCodeTx.GetGasFeeCap()
+	if baseFee != nil {
+		gasPrice = setCodeTx.EffectiveGasPrice(baseFee)
+	}
+
+	ethMsg := ethtypes.NewMessage(
+		from,
+		setCodeTx.GetTo(),
+		setCodeTx.GetNonce(),
+		bigOrZero(setCodeTx.GetValue()),
+		setCodeTx.GetGas(),
+		gasPrice,
+		setCodeTx.GetGasFeeCap(),
+		setCodeTx.GetGasTipCap(),
+		setCodeTx.GetData(),
+		setCodeTx.GetAccessList(),
+		false,
+	)
+	return NewSetCodeMessage(ethMsg, setCodeTx.Authorizations), nil
 }
 
 // GetSender extracts the sender address from the signature values using the latest signer for the given chainID.
 func (msg *MsgEthereumTx) GetSender(chainID *big.Int) (common.Address, error) {
-	signer := ethtypes.LatestSignerForChainID(chainID)
-	from, err := signer.Sender(msg.AsTransaction())
+	var (
+		from common.Address
+		err  error
+	)
+	if setCodeTx, ok := msg.setCodeTx(); ok {
+		from, err = setCodeTx.Sender()
+	} else {
+		signer := ethtypes.LatestSignerForChainID(chainID)
+		from, err = Sender(signer, msg.AsTransaction())
+	}
 	if err != nil {
 		return common.Address{}, err
 	}
@@ -351,6 +462,14 @@ func (msg MsgEthereumTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error
 
 // UnmarshalBinary decodes the canonical encoding of transactions.
 func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
+	if len(b) > 0 && b[0] == SetCodeTxType {
+		setCodeTx, err := UnmarshalSetCodeTx(b)
+		if err != nil {
+			return err
+		}
+		return msg.FromSetCodeTx(setCodeTx)
+	}
+
 	tx := &ethtypes.Transaction{}
 	if err := tx.UnmarshalBinary(b); err != nil {
 		return err
@@ -360,37 +479,56 @@ func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
 
 // BuildTx builds the canonical cosmos tx from ethereum msg
 func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.Tx, error) {
+	return buildTx(b, evmDenom, &ExtensionOptionsEthereumTx{}, msg)
+}
+
+// BuildBatchTx builds a cosmos tx executing the ethereum msgs as an atomic batch,
+// the msgs are expected to have the same sender and consecutive nonces.
+func BuildBatchTx(b client.TxBuilder, evmDenom string, msgs ...*MsgEthereumTx) (signing.Tx, error) {
+	if len(msgs) < 2 {
+		return nil, errors.New("batch requires at least two messages")
+	}
+	return buildTx(b, evmDenom, &ExtensionOptionsEthereumBatchTx{}, msgs...)
+}
+
+func buildTx(b client.TxBuilder, evmDenom string, ext proto.Message, msgs ...*MsgEthereumTx) (signing.Tx, error) {
 	builder, ok := b.(authtx.ExtensionOptionsTxBuilder)
 	if !ok {
 		return nil, errors.New("unsupported builder")
 	}
 
-	option, err := codectypes.NewAnyWithValue(&ExtensionOptionsEthereumTx{})
+	option, err := codectypes.NewAnyWithValue(ext)
 	if err != nil {
 		return nil, err
 	}
 
-	txData, err := UnpackTxData(msg.Data)
-	if err != nil {
-		return nil, err
-	}
 	fees := make(sdk.Coins, 0)
-	feeAmt := sdkmath.NewIntFromBigInt(txData.Fee())
-	if feeAmt.Sign() > 0 {
-		fees = append(fees, sdk.NewCoin(evmDenom, feeAmt))
+	sdkMsgs := make([]sdk.Msg, len(msgs))
+	var gasLimit uint64
+	for i, msg := range msgs {
+		txData, err := UnpackTxData(msg.Data)
+		if err != nil {
+			return nil, err
+		}
+		feeAmt := sdkmath.NewIntFromBigInt(txData.Fee())
+		if feeAmt.Sign() > 0 {
+			fees = fees.Add(sdk.NewCoin(evmDenom, feeAmt))
+		}
+
+		// A valid msg should have empty `From`
+		msg.From = ""
+		gasLimit += msg.GetGas()
+		sdkMsgs[i] = msg
 	}
 
 	builder.SetExtensionOptions(option)
 
-	// A valid msg should have empty `From`
-	msg.From = ""
-
-	err = builder.SetMsgs(msg)
+	err = builder.SetMsgs(sdkMsgs...)
 	if err != nil {
 		return nil, err
 	}
 	builder.SetFeeAmount(fees)
-	builder.SetGasLimit(msg.GetGas())
+	builder.SetGasLimit(gasLimit)
 	tx := builder.GetTx()
 	return tx, nil
 }
@@ -415,3 +553,47 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// NewMsgCallContract returns a new MsgCallContract calling the contract on behalf of the sender
+func NewMsgCallContract(sender sdk.AccAddress, contract common.Address, data []byte, amount sdkmath.Int, gasLimit uint64) *MsgCallContract {
+	return &MsgCallContract{
+		Sender:   sender.String(),
+		Contract: contract.Hex(),
+		Data:     data,
+		Amount:   amount,
+		GasLimit: gasLimit,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgCallContract message.
+func (m MsgCallContract) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCallContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if err := types.ValidateNonZeroAddress(m.Contract); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	if m.Amount.IsNil() || m.Amount.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount cannot be nil or negative %s", m.Amount)
+	}
+
+	if m.GasLimit == 0 {
+		return errorsmod.Wrap(ErrInvalidGasLimit, "gas limit must not be zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCallContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -921,3 +921,34 @@ func assertEqual(orig *ethtypes.Transaction, cpy *ethtypes.Transaction) error {
 	}
 	return nil
 }
+
+func (suite *MsgsTestSuite) TestMsgCallContract_ValidateBasic() {
+	sender := sdk.AccAddress(suite.from.Bytes())
+
+	testCases := []struct {
+		msg      string
+		malleate func(msg *types.MsgCallContract)
+		expPass  bool
+	}{
+		{"pass", func(*types.MsgCallContract) {}, true},
+		{"invalid sender", func(msg *types.MsgCallContract) { msg.Sender = "invalid" }, false},
+		{"invalid contract", func(msg *types.MsgCallContract) { msg.Contract = invalidAddress }, false},
+		{"zero contract", func(msg *types.MsgCallContract) { msg.Contract = common.Address{}.Hex() }, false},
+		{"nil amount", func(msg *types.MsgCallContract) { msg.Amount = sdkmath.Int{} }, false},
+		{"negative amount", func(msg *types.MsgCallContract) { msg.Amount = sdkmath.NewInt(-1) }, false},
+		{"zero gas limit", func(msg *types.MsgCallContract) { msg.GasLimit = 0 }, false},
+	}
+
+	for _, tc := range testCases {
+		msg := types.NewMsgCallContract(sender, suite.to, []byte{0x1}, sdkmath.NewInt(1), 100000)
+		tc.malleate(msg)
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.msg)
+			suite.Require().Equal([]sdk.AccAddress{sender}, msg.GetSigners(), tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
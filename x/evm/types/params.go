@@ -18,6 +18,7 @@ package types
 import (
 	"fmt"
 	"math/big"
+	"regexp"
 
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
@@ -36,8 +37,14 @@ var (
 	DefaultEnableCreate = true
 	// DefaultEnableCall enables contract calls (i.e true)
 	DefaultEnableCall = true
+	// DefaultWebAuthnRPID rejects all WebAuthn assertions (i.e empty)
+	DefaultWebAuthnRPID = ""
 )
 
+// rpIDRegex matches the lowercase domain names that can be used as WebAuthn
+// relying party IDs
+var rpIDRegex = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]*[a-z0-9])?\.)*[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
 // AvailableExtraEIPs define the list of all EIPs that can be enabled by the
 // EVM interpreter. These EIPs are applied in order and can override the
 // instruction sets from the latest hard fork enabled by the ChainConfig. For
@@ -67,6 +74,7 @@ func DefaultParams() Params {
 		ChainConfig:         DefaultChainConfig(),
 		ExtraEIPs:           nil,
 		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
+		WebAuthnRPID:        DefaultWebAuthnRPID,
 	}
 }
 
@@ -92,6 +100,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateWebAuthnRPID(p.WebAuthnRPID); err != nil {
+		return err
+	}
+
 	return validateChainConfig(p.ChainConfig)
 }
 
@@ -136,6 +148,19 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+func validateWebAuthnRPID(i interface{}) error {
+	rpID, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid WebAuthn relying party ID type: %T", i)
+	}
+
+	if rpID != "" && !rpIDRegex.MatchString(rpID) {
+		return fmt.Errorf("invalid WebAuthn relying party ID %s, expected a lowercase domain", rpID)
+	}
+
+	return nil
+}
+
 func validateChainConfig(i interface{}) error {
 	cfg, ok := i.(ChainConfig)
 	if !ok {
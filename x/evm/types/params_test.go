@@ -41,6 +41,24 @@ func TestParamsValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid WebAuthn relying party ID",
+			Params{
+				EvmDenom:     "stake",
+				ChainConfig:  DefaultChainConfig(),
+				WebAuthnRPID: "wallet.example.com",
+			},
+			false,
+		},
+		{
+			"invalid WebAuthn relying party ID",
+			Params{
+				EvmDenom:     "stake",
+				ChainConfig:  DefaultChainConfig(),
+				WebAuthnRPID: "https://wallet.example.com",
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,163 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"math"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/hetu-project/hetu/v1/types"
+)
+
+// setCodeAuthorizationMagic is the prefix of the signed payload of an authorization,
+// preventing collisions with the transaction signature hashes.
+const setCodeAuthorizationMagic = 0x05
+
+// DelegationPrefix is the prefix of the delegation designator set as code of the
+// authorities, followed by the address of the contract the code is delegated to.
+var DelegationPrefix = []byte{0xef, 0x01, 0x00}
+
+// DelegationLength is the length of a delegation designator.
+var DelegationLength = len(DelegationPrefix) + common.AddressLength
+
+// AddressToDelegation returns the delegation designator of the given address.
+func AddressToDelegation(addr common.Address) []byte {
+	return append(common.CopyBytes(DelegationPrefix), addr.Bytes()...)
+}
+
+// ParseDelegation returns the address the code is delegated to if the code is a
+// delegation designator.
+func ParseDelegation(code []byte) (common.Address, bool) {
+	if len(code) != DelegationLength || !bytes.HasPrefix(code, DelegationPrefix) {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(code[len(DelegationPrefix):]), true
+}
+
+// NewSetCodeAuthorization returns an unsigned authorization delegating the code of
+// its signer to the given address.
+func NewSetCodeAuthorization(chainID *big.Int, address common.Address, nonce uint64) SetCodeAuthorization {
+	return SetCodeAuthorization{
+		ChainID: sdkmath.NewIntFromBigInt(chainID),
+		Address: address.Hex(),
+		Nonce:   nonce,
+	}
+}
+
+// GetAddress returns the address of the contract the code is delegated to.
+func (a SetCodeAuthorization) GetAddress() common.Address {
+	return common.HexToAddress(a.Address)
+}
+
+// SigHash returns the hash signed by the authority, i.e.
+// keccak256(0x05 || rlp([chain_id, address, nonce])).
+func (a SetCodeAuthorization) SigHash() common.Hash {
+	return prefixedRlpHash(setCodeAuthorizationMagic, []interface{}{
+		a.ChainID.BigInt(),
+		a.GetAddress(),
+		a.Nonce,
+	})
+}
+
+// Sign signs the authorization with the given private key.
+func (a *SetCodeAuthorization) Sign(key *ecdsa.PrivateKey) error {
+	sig, err := crypto.Sign(a.SigHash().Bytes(), key)
+	if err != nil {
+		return err
+	}
+
+	a.R = new(big.Int).SetBytes(sig[:32]).Bytes()
+	a.S = new(big.Int).SetBytes(sig[32:64]).Bytes()
+	a.V = new(big.Int).SetUint64(uint64(sig[64])).Bytes()
+	return nil
+}
+
+// Authority recovers the address of the account which signed the authorization.
+func (a SetCodeAuthorization) Authority() (common.Address, error) {
+	v, r, s := rawSignatureValues(a.V, a.R, a.S)
+	return recoverPlain(a.SigHash(), v, r, s)
+}
+
+// Validate performs a stateless validation of the authorization fields.
+func (a SetCodeAuthorization) Validate() error {
+	if a.ChainID.IsNil() || a.ChainID.IsNegative() {
+		return errorsmod.Wrap(errortypes.ErrInvalidChainID, "authorization chain ID cannot be nil or negative")
+	}
+	if !types.IsValidInt256(a.ChainID.BigInt()) {
+		return errorsmod.Wrap(errortypes.ErrInvalidChainID, "authorization chain ID out of bound")
+	}
+
+	if err := types.ValidateAddress(a.Address); err != nil {
+		return errorsmod.Wrap(err, "invalid authorization address")
+	}
+
+	if a.Nonce == math.MaxUint64 {
+		return errorsmod.Wrap(errortypes.ErrInvalidSequence, "authorization nonce overflow")
+	}
+
+	if len(a.R) == 0 || len(a.S) == 0 {
+		return errorsmod.Wrap(errortypes.ErrNoSignatures, "authorization is not signed")
+	}
+	return nil
+}
+
+// recoverPlain recovers the signer of the hash from the y-parity V and the R, S
+// signature values. A nil V is the zero y-parity, since its bytes are empty.
+func recoverPlain(sighash common.Hash, v, r, s *big.Int) (common.Address, error) {
+	if v == nil {
+		v = new(big.Int)
+	}
+	if r == nil || s == nil || v.BitLen() > 8 {
+		return common.Address{}, errorsmod.Wrap(errortypes.ErrorInvalidSigner, "invalid signature values")
+	}
+	vb := byte(v.Uint64())
+	if !crypto.ValidateSignatureValues(vb, r, s, true) {
+		return common.Address{}, errorsmod.Wrap(errortypes.ErrorInvalidSigner, "invalid signature values")
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	sig[64] = vb
+
+	pub, err := crypto.Ecrecover(sighash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrap(errortypes.ErrorInvalidSigner, err.Error())
+	}
+	if len(pub) == 0 || pub[0] != 4 {
+		return common.Address{}, errorsmod.Wrap(errortypes.ErrorInvalidSigner, "invalid public key")
+	}
+	return common.BytesToAddress(crypto.Keccak256(pub[1:])[12:]), nil
+}
+
+// prefixedRlpHash returns keccak256(prefix || rlp(x)).
+func prefixedRlpHash(prefix byte, x interface{}) common.Hash {
+	bz, err := rlp.EncodeToBytes(x)
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash([]byte{prefix}, bz)
+}
@@ -0,0 +1,484 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package types
+
+import (
+	"errors"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"github.com/hetu-project/hetu/v1/types"
+)
+
+const (
+	// SetCodeTxType is the EIP-2718 type of the set code transactions.
+	SetCodeTxType = 0x04
+
+	// PerEmptyAccountCost is the intrinsic gas charged for each authorization of
+	// a set code transaction.
+	PerEmptyAccountCost = 25000
+	// PerAuthBaseCost is the cost of an authorization to an existing account, the
+	// difference with PerEmptyAccountCost is refunded.
+	PerAuthBaseCost = 12500
+)
+
+// setCodeAuthorizationRLP is the RLP representation of a SetCodeAuthorization.
+type setCodeAuthorizationRLP struct {
+	ChainID *big.Int
+	Address common.Address
+	Nonce   uint64
+	V, R, S *big.Int
+}
+
+// setCodeTxRLP is the RLP representation of a signed SetCodeTx, i.e. its
+// canonical encoding without the type prefix.
+type setCodeTxRLP struct {
+	ChainID        *big.Int
+	Nonce          uint64
+	GasTipCap      *big.Int
+	GasFeeCap      *big.Int
+	Gas            uint64
+	To             common.Address
+	Value          *big.Int
+	Data           []byte
+	AccessList     ethtypes.AccessList
+	Authorizations []setCodeAuthorizationRLP
+	V, R, S        *big.Int
+}
+
+// TxType returns the tx type
+func (tx *SetCodeTx) TxType() uint8 {
+	return SetCodeTxType
+}
+
+// Copy returns an instance with the same field values
+func (tx *SetCodeTx) Copy() TxData {
+	authorizations := make([]SetCodeAuthorization, len(tx.Authorizations))
+	for i, auth := range tx.Authorizations {
+		authorizations[i] = SetCodeAuthorization{
+			ChainID: auth.ChainID,
+			Address: auth.Address,
+			Nonce:   auth.Nonce,
+			V:       common.CopyBytes(auth.V),
+			R:       common.CopyBytes(auth.R),
+			S:       common.CopyBytes(auth.S),
+		}
+	}
+
+	return &SetCodeTx{
+		ChainID:        tx.ChainID,
+		Nonce:          tx.Nonce,
+		GasTipCap:      tx.GasTipCap,
+		GasFeeCap:      tx.GasFeeCap,
+		GasLimit:       tx.GasLimit,
+		To:             tx.To,
+		Amount:         tx.Amount,
+		Data:           common.CopyBytes(tx.Data),
+		Accesses:       tx.Accesses,
+		Authorizations: authorizations,
+		V:              common.CopyBytes(tx.V),
+		R:              common.CopyBytes(tx.R),
+		S:              common.CopyBytes(tx.S),
+	}
+}
+
+// GetChainID returns the chain id field from the SetCodeTx
+func (tx *SetCodeTx) GetChainID() *big.Int {
+	if tx.ChainID == nil {
+		return nil
+	}
+
+	return tx.ChainID.BigInt()
+}
+
+// GetAccessList returns the AccessList field.
+func (tx *SetCodeTx) GetAccessList() ethtypes.AccessList {
+	if tx.Accesses == nil {
+		return nil
+	}
+	return *tx.Accesses.ToEthAccessList()
+}
+
+// GetData returns the a copy of the input data bytes.
+func (tx *SetCodeTx) GetData() []byte {
+	return common.CopyBytes(tx.Data)
+}
+
+// GetGas returns the gas limit.
+func (tx *SetCodeTx) GetGas() uint64 {
+	return tx.GasLimit
+}
+
+// GetGasPrice returns the gas fee cap field.
+func (tx *SetCodeTx) GetGasPrice() *big.Int {
+	return tx.GetGasFeeCap()
+}
+
+// GetGasTipCap returns the gas tip cap field.
+func (tx *SetCodeTx) GetGasTipCap() *big.Int {
+	if tx.GasTipCap == nil {
+		return nil
+	}
+	return tx.GasTipCap.BigInt()
+}
+
+// GetGasFeeCap returns the gas fee cap field.
+func (tx *SetCodeTx) GetGasFeeCap() *big.Int {
+	if tx.GasFeeCap == nil {
+		return nil
+	}
+	return tx.GasFeeCap.BigInt()
+}
+
+// GetValue returns the tx amount.
+func (tx *SetCodeTx) GetValue() *big.Int {
+	if tx.Amount == nil {
+		return nil
+	}
+
+	return tx.Amount.BigInt()
+}
+
+// GetNonce returns the account sequence for the transaction.
+func (tx *SetCodeTx) GetNonce() uint64 { return tx.Nonce }
+
+// GetTo returns the pointer to the recipient address.
+func (tx *SetCodeTx) GetTo() *common.Address {
+	if tx.To == "" {
+		return nil
+	}
+	to := common.HexToAddress(tx.To)
+	return &to
+}
+
+// AsEthereumData returns a DynamicFeeTx transaction tx from the proto-formatted
+// TxData defined on the Cosmos EVM.
+//
+// NOTE: go-ethereum doesn't support set code transactions, the returned data only
+// carries the fee and gas fields of the transaction. Its hash and signature
+// must not be used, see Hash and Sender instead.
+func (tx *SetCodeTx) AsEthereumData() ethtypes.TxData {
+	v, r, s := tx.GetRawSignatureValues()
+	return &ethtypes.DynamicFeeTx{
+		ChainID:    tx.GetChainID(),
+		Nonce:      tx.GetNonce(),
+		GasTipCap:  tx.GetGasTipCap(),
+		GasFeeCap:  tx.GetGasFeeCap(),
+		Gas:        tx.GetGas(),
+		To:         tx.GetTo(),
+		Value:      tx.GetValue(),
+		Data:       tx.GetData(),
+		AccessList: tx.GetAccessList(),
+		V:          v,
+		R:          r,
+		S:          s,
+	}
+}
+
+// GetRawSignatureValues returns the V, R, S signature values of the transaction.
+// The return values should not be modified by the caller.
+func (tx *SetCodeTx) GetRawSignatureValues() (v, r, s *big.Int) {
+	return rawSignatureValues(tx.V, tx.R, tx.S)
+}
+
+// SetSignatureValues sets the signature values to the transaction.
+func (tx *SetCodeTx) SetSignatureValues(chainID, v, r, s *big.Int) {
+	if v != nil {
+		tx.V = v.Bytes()
+	}
+	if r != nil {
+		tx.R = r.Bytes()
+	}
+	if s != nil {
+		tx.S = s.Bytes()
+	}
+	if chainID != nil {
+		chainIDInt := sdkmath.NewIntFromBigInt(chainID)
+		tx.ChainID = &chainIDInt
+	}
+}
+
+// Validate performs a stateless validation of the tx fields.
+func (tx SetCodeTx) Validate() error {
+	if tx.GasTipCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas tip cap cannot nil")
+	}
+
+	if tx.GasFeeCap == nil {
+		return errorsmod.Wrap(ErrInvalidGasCap, "gas fee cap cannot nil")
+	}
+
+	if tx.GasTipCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas tip cap cannot be negative %s", tx.GasTipCap)
+	}
+
+	if tx.GasFeeCap.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidGasCap, "gas fee cap cannot be negative %s", tx.GasFeeCap)
+	}
+
+	if !types.IsValidInt256(tx.GetGasTipCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if !types.IsValidInt256(tx.GetGasFeeCap()) {
+		return errorsmod.Wrap(ErrInvalidGasCap, "out of bound")
+	}
+
+	if tx.GasFeeCap.LT(*tx.GasTipCap) {
+		return errorsmod.Wrapf(
+			ErrInvalidGasCap, "max priority fee per gas higher than max fee per gas (%s > %s)",
+			tx.GasTipCap, tx.GasFeeCap,
+		)
+	}
+
+	if !types.IsValidInt256(tx.Fee()) {
+		return errorsmod.Wrap(ErrInvalidGasFee, "out of bound")
+	}
+
+	amount := tx.GetValue()
+	// Amount can be 0
+	if amount != nil && amount.Sign() == -1 {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount cannot be negative %s", amount)
+	}
+	if !types.IsValidInt256(amount) {
+		return errorsmod.Wrap(ErrInvalidAmount, "out of bound")
+	}
+
+	// set code transactions cannot create contracts
+	if err := types.ValidateAddress(tx.To); err != nil {
+		return errorsmod.Wrap(err, "invalid to address")
+	}
+
+	if len(tx.Authorizations) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "authorization list cannot be empty")
+	}
+
+	for i, auth := range tx.Authorizations {
+		if err := auth.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid authorization %d", i)
+		}
+	}
+
+	chainID := tx.GetChainID()
+
+	if chainID == nil {
+		return errorsmod.Wrap(
+			errortypes.ErrInvalidChainID,
+			"chain ID must be present on SetCode txs",
+		)
+	}
+
+	if !(chainID.Cmp(big.NewInt(560001)) == 0 || chainID.Cmp(big.NewInt(560000)) == 0 || chainID.Cmp(big.NewInt(560002)) == 0) {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidChainID,
+			"chain ID must be 560000 or 560001 or 560002 on Evmos, got %s", chainID,
+		)
+	}
+
+	return nil
+}
+
+// Fee returns gasprice * gaslimit.
+func (tx SetCodeTx) Fee() *big.Int {
+	return fee(tx.GetGasFeeCap(), tx.GasLimit)
+}
+
+// Cost returns amount + gasprice * gaslimit.
+func (tx SetCodeTx) Cost() *big.Int {
+	return cost(tx.Fee(), tx.GetValue())
+}
+
+// EffectiveGasPrice returns the effective gas price
+func (tx *SetCodeTx) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	return EffectiveGasPrice(baseFee, tx.GasFeeCap.BigInt(), tx.GasTipCap.BigInt())
+}
+
+// EffectiveFee returns effective_gasprice * gaslimit.
+func (tx SetCodeTx) EffectiveFee(baseFee *big.Int) *big.Int {
+	return fee(tx.EffectiveGasPrice(baseFee), tx.GasLimit)
+}
+
+// EffectiveCost returns amount + effective_gasprice * gaslimit.
+func (tx SetCodeTx) EffectiveCost(baseFee *big.Int) *big.Int {
+	return cost(tx.EffectiveFee(baseFee), tx.GetValue())
+}
+
+// SigHash returns the hash signed by the sender of the transaction for the
+// given chain ID.
+func (tx *SetCodeTx) SigHash(chainID *big.Int) common.Hash {
+	enc := tx.toRLP()
+	return prefixedRlpHash(SetCodeTxType, []interface{}{
+		chainID,
+		enc.Nonce,
+		enc.GasTipCap,
+		enc.GasFeeCap,
+		enc.Gas,
+		enc.To,
+		enc.Value,
+		enc.Data,
+		enc.AccessList,
+		enc.Authorizations,
+	})
+}
+
+// Hash returns the hash of the canonical encoding of the signed transaction.
+func (tx *SetCodeTx) Hash() common.Hash {
+	return prefixedRlpHash(SetCodeTxType, tx.toRLP())
+}
+
+// Sender recovers the address of the account which signed the transaction.
+func (tx *SetCodeTx) Sender() (common.Address, error) {
+	chainID := tx.GetChainID()
+	if chainID == nil {
+		return common.Address{}, errorsmod.Wrap(errortypes.ErrInvalidChainID, "chain ID must be present on SetCode txs")
+	}
+	v, r, s := tx.GetRawSignatureValues()
+	return recoverPlain(tx.SigHash(chainID), v, r, s)
+}
+
+// MarshalBinary returns the canonical encoding of the transaction, i.e.
+// 0x04 || rlp(tx).
+func (tx *SetCodeTx) MarshalBinary() ([]byte, error) {
+	bz, err := rlp.EncodeToBytes(tx.toRLP())
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{SetCodeTxType}, bz...), nil
+}
+
+// UnmarshalSetCodeTx decodes the canonical encoding of a set code transaction.
+func UnmarshalSetCodeTx(b []byte) (*SetCodeTx, error) {
+	if len(b) == 0 || b[0] != SetCodeTxType {
+		return nil, errors.New("invalid set code transaction type")
+	}
+
+	var enc setCodeTxRLP
+	if err := rlp.DecodeBytes(b[1:], &enc); err != nil {
+		return nil, err
+	}
+
+	for _, value := range []*big.Int{enc.ChainID, enc.GasTipCap, enc.GasFeeCap, enc.Value} {
+		if !types.IsValidInt256(value) {
+			return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "set code transaction value out of bound")
+		}
+	}
+
+	chainID := sdkmath.NewIntFromBigInt(enc.ChainID)
+	gasTipCap := sdkmath.NewIntFromBigInt(enc.GasTipCap)
+	gasFeeCap := sdkmath.NewIntFromBigInt(enc.GasFeeCap)
+	amount := sdkmath.NewIntFromBigInt(enc.Value)
+
+	tx := &SetCodeTx{
+		ChainID:        &chainID,
+		Nonce:          enc.Nonce,
+		GasTipCap:      &gasTipCap,
+		GasFeeCap:      &gasFeeCap,
+		GasLimit:       enc.Gas,
+		To:             enc.To.Hex(),
+		Amount:         &amount,
+		Data:           enc.Data,
+		Accesses:       NewAccessList(&enc.AccessList),
+		Authorizations: make([]SetCodeAuthorization, len(enc.Authorizations)),
+	}
+
+	for i, auth := range enc.Authorizations {
+		if !types.IsValidInt256(auth.ChainID) {
+			return nil, errorsmod.Wrap(errortypes.ErrInvalidChainID, "authorization chain ID out of bound")
+		}
+		tx.Authorizations[i] = NewSetCodeAuthorization(auth.ChainID, auth.Address, auth.Nonce)
+		tx.Authorizations[i].V = auth.V.Bytes()
+		tx.Authorizations[i].R = auth.R.Bytes()
+		tx.Authorizations[i].S = auth.S.Bytes()
+	}
+
+	tx.SetSignatureValues(nil, enc.V, enc.R, enc.S)
+	return tx, nil
+}
+
+// toRLP returns the RLP representation of the transaction, the nil values are
+// encoded as zero.
+func (tx *SetCodeTx) toRLP() *setCodeTxRLP {
+	v, r, s := tx.GetRawSignatureValues()
+	enc := &setCodeTxRLP{
+		ChainID:        bigOrZero(tx.GetChainID()),
+		Nonce:          tx.Nonce,
+		GasTipCap:      bigOrZero(tx.GetGasTipCap()),
+		GasFeeCap:      bigOrZero(tx.GetGasFeeCap()),
+		Gas:            tx.GasLimit,
+		Value:          bigOrZero(tx.GetValue()),
+		Data:           tx.Data,
+		AccessList:     tx.GetAccessList(),
+		Authorizations: make([]setCodeAuthorizationRLP, len(tx.Authorizations)),
+		V:              bigOrZero(v),
+		R:              bigOrZero(r),
+		S:              bigOrZero(s),
+	}
+	if to := tx.GetTo(); to != nil {
+		enc.To = *to
+	}
+	if enc.AccessList == nil {
+		enc.AccessList = ethtypes.AccessList{}
+	}
+
+	for i, auth := range tx.Authorizations {
+		av, ar, as := rawSignatureValues(auth.V, auth.R, auth.S)
+		var chainID *big.Int
+		if !auth.ChainID.IsNil() {
+			chainID = auth.ChainID.BigInt()
+		}
+		enc.Authorizations[i] = setCodeAuthorizationRLP{
+			ChainID: bigOrZero(chainID),
+			Address: auth.GetAddress(),
+			Nonce:   auth.Nonce,
+			V:       bigOrZero(av),
+			R:       bigOrZero(ar),
+			S:       bigOrZero(as),
+		}
+	}
+	return enc
+}
+
+func bigOrZero(i *big.Int) *big.Int {
+	if i == nil {
+		return new(big.Int)
+	}
+	return i
+}
+
+// SetCodeMessage is the core.Message of a set code transaction, carrying the
+// authorizations applied before the execution of the call.
+type SetCodeMessage struct {
+	ethtypes.Message
+
+	authorizations []SetCodeAuthorization
+}
+
+// NewSetCodeMessage returns a SetCodeMessage wrapping the given message.
+func NewSetCodeMessage(msg ethtypes.Message, authorizations []SetCodeAuthorization) SetCodeMessage {
+	return SetCodeMessage{Message: msg, authorizations: authorizations}
+}
+
+// AuthorizationList returns the authorizations of the transaction.
+func (m SetCodeMessage) AuthorizationList() []SetCodeAuthorization {
+	return m.authorizations
+}
@@ -0,0 +1,177 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	utiltx "github.com/hetu-project/hetu/v1/testutil/tx"
+	"github.com/hetu-project/hetu/v1/x/evm/types"
+)
+
+func newSignedAuthorization(t *testing.T, chainID *big.Int, delegate common.Address, nonce uint64) (common.Address, types.SetCodeAuthorization) {
+	authority, priv := utiltx.NewAddrKey()
+	key, err := priv.ToECDSA()
+	require.NoError(t, err)
+
+	auth := types.NewSetCodeAuthorization(chainID, delegate, nonce)
+	require.NoError(t, auth.Sign(key))
+	return authority, auth
+}
+
+func TestDelegation(t *testing.T) {
+	addr := utiltx.GenerateAddress()
+
+	code := types.AddressToDelegation(addr)
+	require.Len(t, code, types.DelegationLength)
+
+	delegate, ok := types.ParseDelegation(code)
+	require.True(t, ok)
+	require.Equal(t, addr, delegate)
+
+	_, ok = types.ParseDelegation(append(code, 0x00))
+	require.False(t, ok)
+	_, ok = types.ParseDelegation(addr.Bytes())
+	require.False(t, ok)
+}
+
+func TestSetCodeAuthorization(t *testing.T) {
+	chainID := big.NewInt(560001)
+	delegate := utiltx.GenerateAddress()
+
+	authority, auth := newSignedAuthorization(t, chainID, delegate, 3)
+	require.NoError(t, auth.Validate())
+	require.Equal(t, delegate, auth.GetAddress())
+
+	recovered, err := auth.Authority()
+	require.NoError(t, err)
+	require.Equal(t, authority, recovered)
+
+	// the signature doesn't cover a different nonce
+	auth.Nonce = 4
+	recovered, err = auth.Authority()
+	require.NoError(t, err)
+	require.NotEqual(t, authority, recovered)
+
+	unsigned := types.NewSetCodeAuthorization(chainID, delegate, 0)
+	require.Error(t, unsigned.Validate())
+}
+
+func TestMsgEthereumTx_SetCodeTx(t *testing.T) {
+	chainID := big.NewInt(560001)
+	from, priv := utiltx.NewAddrKey()
+	to := utiltx.GenerateAddress()
+	_, auth := newSignedAuthorization(t, chainID, utiltx.GenerateAddress(), 0)
+
+	msg := types.NewTx(&types.EvmTxArgs{
+		ChainID:           chainID,
+		Nonce:             1,
+		To:                &to,
+		GasLimit:          100000,
+		GasFeeCap:         big.NewInt(10),
+		GasTipCap:         big.NewInt(1),
+		Amount:            big.NewInt(5),
+		Input:             []byte("input"),
+		Accesses:          &ethtypes.AccessList{},
+		AuthorizationList: []types.SetCodeAuthorization{auth},
+	})
+	msg.From = from.Hex()
+	require.NoError(t, msg.Sign(ethtypes.LatestSignerForChainID(chainID), utiltx.NewSigner(priv)))
+	require.NoError(t, msg.ValidateBasic())
+
+	txData, err := types.UnpackTxData(msg.Data)
+	require.NoError(t, err)
+	setCodeTx, ok := txData.(*types.SetCodeTx)
+	require.True(t, ok)
+	require.Equal(t, uint8(types.SetCodeTxType), setCodeTx.TxType())
+	require.Equal(t, setCodeTx.Hash().Hex(), msg.Hash)
+	require.NotEqual(t, msg.AsTransaction().Hash(), msg.TxHash())
+
+	sender, err := msg.GetSender(chainID)
+	require.NoError(t, err)
+	require.Equal(t, from, sender)
+
+	coreMsg, err := msg.AsMessage(ethtypes.LatestSignerForChainID(chainID), nil)
+	require.NoError(t, err)
+	setCodeMsg, ok := coreMsg.(types.SetCodeMessage)
+	require.True(t, ok)
+	require.Equal(t, from, setCodeMsg.From())
+	require.Len(t, setCodeMsg.AuthorizationList(), 1)
+
+	// canonical encoding round trip
+	bz, err := setCodeTx.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, byte(types.SetCodeTxType), bz[0])
+
+	decoded := &types.MsgEthereumTx{}
+	require.NoError(t, decoded.UnmarshalBinary(bz))
+	require.NoError(t, decoded.ValidateBasic())
+	require.Equal(t, msg.Hash, decoded.Hash)
+
+	sender, err = decoded.GetSender(chainID)
+	require.NoError(t, err)
+	require.Equal(t, from, sender)
+}
+
+func TestSetCodeTx_Validate(t *testing.T) {
+	chainID := big.NewInt(560001)
+	to := utiltx.GenerateAddress()
+	_, auth := newSignedAuthorization(t, chainID, utiltx.GenerateAddress(), 0)
+
+	testCases := []struct {
+		name     string
+		malleate func(args *types.EvmTxArgs)
+		expPass  bool
+	}{
+		{
+			"pass",
+			func(_ *types.EvmTxArgs) {},
+			true,
+		},
+		{
+			"fail - contract creation",
+			func(args *types.EvmTxArgs) { args.To = nil },
+			false,
+		},
+		{
+			"fail - unsigned authorization",
+			func(args *types.EvmTxArgs) {
+				args.AuthorizationList = []types.SetCodeAuthorization{
+					types.NewSetCodeAuthorization(chainID, to, 0),
+				}
+			},
+			false,
+		},
+		{
+			"fail - invalid chain ID",
+			func(args *types.EvmTxArgs) { args.ChainID = big.NewInt(1) },
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args := &types.EvmTxArgs{
+				ChainID:           chainID,
+				To:                &to,
+				GasLimit:          100000,
+				GasFeeCap:         big.NewInt(10),
+				GasTipCap:         big.NewInt(1),
+				AuthorizationList: []types.SetCodeAuthorization{auth},
+			}
+			tc.malleate(args)
+
+			txData, err := types.UnpackTxData(types.NewTx(args).Data)
+			require.NoError(t, err)
+			if tc.expPass {
+				require.NoError(t, txData.Validate())
+			} else {
+				require.Error(t, txData.Validate())
+			}
+		})
+	}
+}
@@ -31,7 +31,7 @@ var supportedTxs = map[string]func() TxDataV2{
 // getEVMSender extracts the sender address from the signature values using the latest signer for the given chainID.
 func getEVMSender(txData TxDataV2) (common.Address, error) {
 	signer := ethtypes.LatestSignerForChainID(txData.GetChainID())
-	from, err := signer.Sender(ethtypes.NewTx(txData.AsEthereumData()))
+	from, err := Sender(signer, ethtypes.NewTx(txData.AsEthereumData()))
 	if err != nil {
 		return common.Address{}, err
 	}
@@ -26,7 +26,7 @@ import (
 )
 
 // EvmTxArgs encapsulates all possible params to create all EVM txs types.
-// This includes LegacyTx, DynamicFeeTx and AccessListTx
+// This includes LegacyTx, DynamicFeeTx, AccessListTx and SetCodeTx
 type EvmTxArgs struct {
 	Nonce     uint64
 	GasLimit  uint64
@@ -38,6 +38,8 @@ type EvmTxArgs struct {
 	GasTipCap *big.Int
 	To        *common.Address
 	Accesses  *ethtypes.AccessList
+	// AuthorizationList creates a SetCodeTx if not empty
+	AuthorizationList []SetCodeAuthorization
 }
 
 // GetTxPriority returns the priority of a given Ethereum tx. It relies of the
@@ -23,6 +23,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/hashicorp/go-metrics"
 
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -39,6 +40,9 @@ var _ types.MsgServer = Keeper{}
 // registered through governance.
 // If user doesn't have enough balance of coin, it will attempt to convert
 // ERC20 tokens to the coin denomination, and continue with a regular transfer.
+// The amount converted from ERC20, zero if none, is recorded for every sent
+// packet so that a refund on an error acknowledgement or timeout restores the
+// original split.
 func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -64,25 +68,25 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 	pairID := k.erc20Keeper.GetTokenPairID(ctx, denom)
 	if len(pairID) == 0 {
 		// no-op: token is not registered so we can proceed with regular transfer
-		return k.Keeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+		return k.transfer(ctx, msg, sdkmath.ZeroInt())
 	}
 
 	pair, _ := k.erc20Keeper.GetTokenPair(ctx, pairID)
 	if !pair.Enabled {
 		// no-op: pair is not enabled so we can proceed with regular transfer
-		return k.Keeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+		return k.transfer(ctx, msg, sdkmath.ZeroInt())
 	}
 
 	sender := sdk.MustAccAddressFromBech32(msg.Sender)
 	senderAcc := k.accountKeeper.GetAccount(ctx, sender)
 
 	if erc20types.IsModuleAccount(senderAcc) {
-		return k.Keeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+		return k.transfer(ctx, msg, sdkmath.ZeroInt())
 	}
 
 	if !k.erc20Keeper.IsERC20Enabled(ctx) {
 		// no-op: continue with regular transfer
-		return k.Keeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+		return k.transfer(ctx, msg, sdkmath.ZeroInt())
 	}
 
 	// update the msg denom to the token pair denom
@@ -102,7 +106,7 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 			)
 		}()
 
-		return k.Keeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+		return k.transfer(ctx, msg, sdkmath.ZeroInt())
 	}
 
 	// only convert the remaining difference
@@ -130,5 +134,17 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 		)
 	}()
 
-	return k.Keeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	return k.transfer(ctx, msg, difference)
+}
+
+// transfer performs the ICS20 transfer and records the amount of the packet
+// that was converted from its ERC20 representation.
+func (k Keeper) transfer(ctx sdk.Context, msg *types.MsgTransfer, erc20Amount sdkmath.Int) (*types.MsgTransferResponse, error) {
+	res, err := k.Keeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	k.erc20Keeper.SetPacketERC20Amount(ctx, msg.SourcePort, msg.SourceChannel, res.Sequence, erc20Amount)
+	return res, nil
 }
@@ -27,9 +27,10 @@ func (suite *KeeperTestSuite) TestTransfer() {
 	authAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
 
 	testCases := []struct {
-		name     string
-		malleate func() *types.MsgTransfer
-		expPass  bool
+		name           string
+		malleate       func() *types.MsgTransfer
+		expPass        bool
+		expERC20Amount int64
 	}{
 		{
 			"pass - no token pair",
@@ -46,6 +47,7 @@ func (suite *KeeperTestSuite) TestTransfer() {
 				return transferMsg
 			},
 			true,
+			0,
 		},
 		{
 			"error - invalid sender",
@@ -59,6 +61,7 @@ func (suite *KeeperTestSuite) TestTransfer() {
 				return transferMsg
 			},
 			false,
+			0,
 		},
 		{
 			"no-op - disabled erc20 by params - sufficient sdk.Coins balance)",
@@ -91,6 +94,7 @@ func (suite *KeeperTestSuite) TestTransfer() {
 				return transferMsg
 			},
 			true,
+			0,
 		},
 		{
 			"error - disabled erc20 by params - insufficient sdk.Coins balance)",
@@ -114,6 +118,7 @@ func (suite *KeeperTestSuite) TestTransfer() {
 				return transferMsg
 			},
 			false,
+			0,
 		},
 		{
 			"no-op - pair not registered",
@@ -134,6 +139,7 @@ func (suite *KeeperTestSuite) TestTransfer() {
 				return transferMsg
 			},
 			true,
+			0,
 		},
 		{
 			"no-op - pair is disabled",
@@ -160,6 +166,7 @@ func (suite *KeeperTestSuite) TestTransfer() {
 				return transferMsg
 			},
 			true,
+			0,
 		},
 		{
 			"no-op - sender is a module account",
@@ -182,6 +189,7 @@ func (suite *KeeperTestSuite) TestTransfer() {
 				return transferMsg
 			},
 			false,
+			0,
 		},
 		{
 			"pass - has enough balance in erc20 - need to convert",
@@ -200,6 +208,7 @@ func (suite *KeeperTestSuite) TestTransfer() {
 				return transferMsg
 			},
 			true,
+			10,
 		},
 		{
 			"pass - has enough balance in coins",
@@ -222,6 +231,7 @@ func (suite *KeeperTestSuite) TestTransfer() {
 				return transferMsg
 			},
 			true,
+			0,
 		},
 		{
 			"error - fail conversion - no balance in erc20",
@@ -237,6 +247,7 @@ func (suite *KeeperTestSuite) TestTransfer() {
 				return transferMsg
 			},
 			false,
+			0,
 		},
 	}
 	for _, tc := range testCases {
@@ -256,9 +267,15 @@ func (suite *KeeperTestSuite) TestTransfer() {
 			)
 			msg := tc.malleate()
 
-			_, err = suite.app.TransferKeeper.Transfer(sdk.WrapSDKContext(suite.ctx), msg)
+			res, err := suite.app.TransferKeeper.Transfer(sdk.WrapSDKContext(suite.ctx), msg)
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				// the converted amount is recorded for every packet, so that the
+				// refunds never convert the whole amount
+				erc20Amount, found := suite.app.Erc20Keeper.GetPacketERC20Amount(suite.ctx, msg.SourcePort, msg.SourceChannel, res.Sequence)
+				suite.Require().True(found)
+				suite.Require().Equal(math.NewInt(tc.expERC20Amount), erc20Amount)
 			} else {
 				suite.Require().Error(err)
 			}
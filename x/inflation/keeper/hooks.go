@@ -27,11 +27,12 @@ import (
 )
 
 // BeforeEpochStart: noop, We don't need to do anything here
-func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {
+func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) error {
+	return nil
 }
 
 // AfterEpochEnd mints and allocates coins at the end of each epoch end
-func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	params := k.GetParams(ctx)
 	skippedEpochs := k.GetSkippedEpochs(ctx)
 
@@ -39,7 +40,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	if !params.EnableInflation {
 		// check if the epochIdentifier is "day" before incrementing.
 		if epochIdentifier != epochstypes.DayEpochID {
-			return
+			return nil
 		}
 		skippedEpochs++
 
@@ -51,12 +52,12 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 			"epoch-number", epochNumber,
 			"skipped-epochs", skippedEpochs,
 		)
-		return
+		return nil
 	}
 
 	expEpochID := k.GetEpochIdentifier(ctx)
 	if epochIdentifier != expEpochID {
-		return
+		return nil
 	}
 
 	// mint coins, update supply
@@ -76,7 +77,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 			"SKIPPING INFLATION: negative epoch mint provision",
 			"value", epochMintProvision.String(),
 		)
-		return
+		return nil
 	}
 
 	mintedCoin := sdk.Coin{
@@ -86,7 +87,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 
 	staking, incentives, communityPool, err := k.MintAndAllocateInflation(ctx, mintedCoin, params)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
 	// If period is passed, update the period. A period is
@@ -147,6 +148,8 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 			sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
 		),
 	)
+
+	return nil
 }
 
 // ___________________________________________________________________________________________________
@@ -164,10 +167,10 @@ func (k Keeper) Hooks() Hooks {
 }
 
 // epochs hooks
-func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
-	h.k.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return h.k.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
 }
 
-func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
-	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
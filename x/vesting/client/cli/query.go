@@ -27,6 +27,11 @@ import (
 	"github.com/hetu-project/hetu/v1/x/vesting/types"
 )
 
+// Query command flags
+const (
+	FlagTime = "time"
+)
+
 // GetQueryCmd returns the parent command for all vesting CLI query commands.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -39,6 +44,9 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalancesCmd(),
+		GetUnlockScheduleCmd(),
+		GetFunderAccountsCmd(),
+		GetSupplyCmd(),
 	)
 	return cmd
 }
@@ -75,3 +83,112 @@ func GetBalancesCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetUnlockScheduleCmd queries the future lockup and vesting events of a
+// vesting account
+func GetUnlockScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock-schedule ADDRESS",
+		Short: "Gets the future lockup and vesting events of a vesting account",
+		Long:  "Gets the future lockup and vesting events of a vesting account, with the tokens that are unlocked, vested and become spendable at each event",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryUnlockScheduleRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.UnlockSchedule(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFunderAccountsCmd queries the clawback vesting accounts funded by a
+// given funder
+func GetFunderAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funder-accounts FUNDER_ADDRESS",
+		Short: "Gets the clawback vesting accounts funded by a funder",
+		Long:  "Gets the clawback vesting accounts funded by a funder",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFunderAccountsRequest{
+				Funder:     args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.FunderAccounts(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "funder accounts")
+	return cmd
+}
+
+// GetSupplyCmd queries the aggregate locked and unvested tokens of all the
+// clawback vesting accounts
+func GetSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply",
+		Short: "Gets the total locked and unvested tokens of all clawback vesting accounts",
+		Long:  "Gets the total locked and unvested tokens of all clawback vesting accounts at the given unix time (--time), which defaults to the current block time",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			readTime, _ := cmd.Flags().GetInt64(FlagTime)
+
+			req := &types.QuerySupplyRequest{
+				Time: readTime,
+			}
+
+			res, err := queryClient.Supply(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagTime, 0, "unix time at which the supply is computed (defaults to the current block time)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -40,6 +40,7 @@ const (
 	FlagVesting  = "vesting"
 	FlagClawback = "clawback"
 	FlagFunder   = "funder"
+	FlagAmount   = "amount"
 )
 
 // NewTxCmd returns a root CLI command handler for certain modules/vesting
@@ -58,6 +59,7 @@ func NewTxCmd() *cobra.Command {
 		NewMsgClawbackCmd(),
 		NewMsgUpdateVestingFunderCmd(),
 		NewMsgConvertVestingAccountCmd(),
+		NewMsgAmendVestingScheduleCmd(),
 	)
 
 	return txCmd
@@ -155,6 +157,8 @@ func NewMsgClawbackCmd() *cobra.Command {
 		Short: "Transfer unvested amount out of a ClawbackVestingAccount.",
 		Long: `Must be requested by the original funder address (--from).
 		May provide a destination address (--dest), otherwise the coins return to the funder.
+		May provide an amount (--amount) to claw back only a part of the unvested coins,
+		in which case the latest vesting events are removed first.
 		Delegated or undelegating staking tokens will be transferred in the delegated (undelegating) state.
 		The recipient is vulnerable to slashing, and must act to unbond the tokens if desired.`,
 		Args: cobra.ExactArgs(1),
@@ -179,6 +183,15 @@ func NewMsgClawbackCmd() *cobra.Command {
 			}
 
 			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
+
+			amountString, _ := cmd.Flags().GetString(FlagAmount)
+			if amountString != "" {
+				msg.Amount, err = sdk.ParseCoinsNormalized(amountString)
+				if err != nil {
+					return fmt.Errorf("bad amount: %w", err)
+				}
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -188,6 +201,7 @@ func NewMsgClawbackCmd() *cobra.Command {
 	}
 
 	cmd.Flags().String(FlagDest, "", "address of destination (defaults to funder)")
+	cmd.Flags().String(FlagAmount, "", "unvested amount to claw back (defaults to all unvested coins)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -261,3 +275,74 @@ func NewMsgConvertVestingAccountCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewMsgAmendVestingScheduleCmd returns a CLI command handler for amending
+// the future lockup and vesting periods of a ClawbackVestingAccount.
+func NewMsgAmendVestingScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "amend-vesting-schedule VESTING_ACCOUNT_ADDRESS",
+		Short: "Replace the future lockup and/or vesting periods of an existing ClawbackVestingAccount.",
+		Long: `Must be requested by the current funder address (--from).
+Must provide a lockup periods file (--lockup), a vesting periods file (--vesting), or both.
+The periods already passed are kept, so each file must describe exactly the coins that are
+still locked or unvested, and its first event must happen after the current block time.
+A schedule whose file is omitted is left unchanged. The files use the same format as the
+create-clawback-vesting-account command.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var (
+				lockupStart, vestingStart     int64
+				lockupPeriods, vestingPeriods sdkvesting.Periods
+			)
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			vestingAcc, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			lockupFile, _ := cmd.Flags().GetString(FlagLockup)
+			vestingFile, _ := cmd.Flags().GetString(FlagVesting)
+			if lockupFile == "" && vestingFile == "" {
+				return fmt.Errorf("must specify at least one of %s or %s", FlagLockup, FlagVesting)
+			}
+			if lockupFile != "" {
+				lockupStart, lockupPeriods, err = ReadScheduleFile(lockupFile)
+				if err != nil {
+					return err
+				}
+			}
+			if vestingFile != "" {
+				vestingStart, vestingPeriods, err = ReadScheduleFile(vestingFile)
+				if err != nil {
+					return err
+				}
+			}
+
+			// only align the schedules if both are amended
+			commonStart := lockupStart
+			switch {
+			case lockupFile == "":
+				commonStart = vestingStart
+			case vestingFile != "":
+				commonStart, _ = types.AlignSchedules(lockupStart, vestingStart, lockupPeriods, vestingPeriods)
+			}
+
+			msg := types.NewMsgAmendVestingSchedule(clientCtx.GetFromAddress(), vestingAcc, time.Unix(commonStart, 0), lockupPeriods, vestingPeriods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockup, "", "path to file containing the new unlocking periods")
+	cmd.Flags().String(FlagVesting, "", "path to file containing the new vesting periods")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
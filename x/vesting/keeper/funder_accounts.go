@@ -0,0 +1,85 @@
+// Copyright 2022 Evmos Foundation
+// This file is part of the Evmos Network packages.
+//
+// Evmos is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Evmos packages are distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Evmos packages. If not, see https://github.com/evmos/evmos/blob/main/LICENSE
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hetu-project/hetu/v1/x/vesting/types"
+)
+
+// SetFunderAccount indexes the clawback vesting account under its funder
+func (k Keeper) SetFunderAccount(ctx sdk.Context, funder, account sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FunderAccountKey(funder, account), []byte{1})
+}
+
+// DeleteFunderAccount removes the clawback vesting account from the index of
+// its funder
+func (k Keeper) DeleteFunderAccount(ctx sdk.Context, funder, account sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FunderAccountKey(funder, account))
+}
+
+// HasFunderAccount returns true if the clawback vesting account is indexed
+// under the funder
+func (k Keeper) HasFunderAccount(ctx sdk.Context, funder, account sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.FunderAccountKey(funder, account))
+}
+
+// funderAccountsStore returns the store of the accounts funded by the funder,
+// keyed by account address
+func (k Keeper) funderAccountsStore(ctx sdk.Context, funder sdk.AccAddress) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.FunderPrefix(funder))
+}
+
+// IterateFunderAccounts iterates over the indexed clawback vesting accounts of
+// all the funders, ordered by funder and account address
+func (k Keeper) IterateFunderAccounts(ctx sdk.Context, cb func(funder, account sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixFunderAccount)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// key: prefix | len(funder) | funder | account
+		key := iterator.Key()[len(types.KeyPrefixFunderAccount):]
+		funderLen := int(key[0])
+		funder := sdk.AccAddress(key[1 : 1+funderLen])
+		account := sdk.AccAddress(key[1+funderLen:])
+
+		if cb(funder, account) {
+			break
+		}
+	}
+}
+
+// IndexFunderAccounts indexes all the clawback vesting accounts of the auth
+// store under their funder. It is only meant to build the index on genesis and
+// on the store migration, as it iterates over every account.
+func (k Keeper) IndexFunderAccounts(ctx sdk.Context) {
+	k.accountKeeper.IterateAccounts(ctx, func(acc sdk.AccountI) bool {
+		va, ok := acc.(*types.ClawbackVestingAccount)
+		if !ok {
+			return false
+		}
+
+		k.SetFunderAccount(ctx, sdk.MustAccAddressFromBech32(va.FunderAddress), va.GetAddress())
+		return false
+	})
+}
@@ -18,8 +18,11 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -73,3 +76,125 @@ func (k Keeper) Balances(
 		Vested:   vested,
 	}, nil
 }
+
+// UnlockSchedule returns the future lockup and vesting events of a clawback
+// vesting account
+func (k Keeper) UnlockSchedule(
+	goCtx context.Context,
+	req *types.QueryUnlockScheduleRequest,
+) (*types.QueryUnlockScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Get vesting account
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"account for address '%s'", req.Address,
+		)
+	}
+
+	// Check if clawback vesting account
+	clawbackAccount, isClawback := acc.(*types.ClawbackVestingAccount)
+	if !isClawback {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"account at address '%s' is not a vesting account ", req.Address,
+		)
+	}
+
+	return &types.QueryUnlockScheduleResponse{
+		Events: clawbackAccount.GetUnlockEvents(ctx.BlockTime()),
+	}, nil
+}
+
+// FunderAccounts returns the clawback vesting accounts funded by a given
+// funder, ordered by address
+func (k Keeper) FunderAccounts(
+	goCtx context.Context,
+	req *types.QueryFunderAccountsRequest,
+) (*types.QueryFunderAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	funder, err := sdk.AccAddressFromBech32(req.Funder)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var accounts []types.ClawbackVestingAccount
+	pageRes, err := query.Paginate(
+		k.funderAccountsStore(ctx, funder),
+		req.Pagination,
+		func(key, _ []byte) error {
+			va, ok := k.accountKeeper.GetAccount(ctx, key).(*types.ClawbackVestingAccount)
+			if !ok {
+				return fmt.Errorf("indexed account %s is not a clawback vesting account", sdk.AccAddress(key))
+			}
+			accounts = append(accounts, *va)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryFunderAccountsResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Supply returns the aggregate locked and unvested tokens of all the clawback
+// vesting accounts at the requested time
+func (k Keeper) Supply(
+	goCtx context.Context,
+	req *types.QuerySupplyRequest,
+) (*types.QuerySupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	readTime := ctx.BlockTime()
+	if req.Time != 0 {
+		readTime = time.Unix(req.Time, 0)
+	}
+
+	res := &types.QuerySupplyResponse{
+		Locked:   sdk.Coins{},
+		Unvested: sdk.Coins{},
+	}
+
+	var err error
+	k.IterateFunderAccounts(ctx, func(_, account sdk.AccAddress) bool {
+		va, ok := k.accountKeeper.GetAccount(ctx, account).(*types.ClawbackVestingAccount)
+		if !ok {
+			err = fmt.Errorf("indexed account %s is not a clawback vesting account", account)
+			return true
+		}
+
+		res.Locked = res.Locked.Add(va.GetLockedOnly(readTime)...)
+		res.Unvested = res.Unvested.Add(va.GetUnvestedOnly(readTime)...)
+		res.Accounts++
+		return false
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
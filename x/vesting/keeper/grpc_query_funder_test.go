@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetu-project/hetu/v1/app"
+	"github.com/hetu-project/hetu/v1/testutil"
+	utiltx "github.com/hetu-project/hetu/v1/testutil/tx"
+	feemarkettypes "github.com/hetu-project/hetu/v1/x/feemarket/types"
+	"github.com/hetu-project/hetu/v1/x/vesting/keeper"
+	"github.com/hetu-project/hetu/v1/x/vesting/types"
+)
+
+// setupQueryTest returns an app initialized from the genesis state, a context
+// on top of it and a query client of the vesting module.
+func setupQueryTest(t *testing.T) (*app.Evmos, sdk.Context, types.QueryClient) {
+	t.Helper()
+
+	hetuApp := app.Setup(false, feemarkettypes.DefaultGenesisState())
+	header := testutil.NewHeader(
+		1, time.Now().UTC(), "hetu_560001-1", sdk.ConsAddress(utiltx.GenerateAddress().Bytes()), nil, nil,
+	)
+	ctx := hetuApp.BaseApp.NewContextLegacy(false, header)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, hetuApp.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, hetuApp.VestingKeeper)
+	return hetuApp, ctx, types.NewQueryClient(queryHelper)
+}
+
+// createClawbackVestingAccount funds the funder and creates a clawback vesting
+// account for the given address with the default test schedules.
+func createClawbackVestingAccount(t *testing.T, hetuApp *app.Evmos, ctx sdk.Context, funder, vestingAddr sdk.AccAddress) {
+	t.Helper()
+
+	err := testutil.FundAccount(ctx, hetuApp.BankKeeper, funder, balances)
+	require.NoError(t, err)
+
+	msg := types.NewMsgCreateClawbackVestingAccount(
+		funder,
+		vestingAddr,
+		ctx.BlockTime(),
+		lockupPeriods,
+		vestingPeriods,
+		false,
+	)
+	_, err = hetuApp.VestingKeeper.CreateClawbackVestingAccount(ctx, msg)
+	require.NoError(t, err)
+}
+
+func TestUnlockSchedule(t *testing.T) {
+	var req *types.QueryUnlockScheduleRequest
+	addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name      string
+		malleate  func(*app.Evmos, sdk.Context)
+		expEvents int
+		expPass   bool
+	}{
+		{
+			"empty req",
+			func(*app.Evmos, sdk.Context) {
+				req = &types.QueryUnlockScheduleRequest{}
+			},
+			0,
+			false,
+		},
+		{
+			"invalid account - not found",
+			func(*app.Evmos, sdk.Context) {
+				req = &types.QueryUnlockScheduleRequest{
+					Address: addr.String(),
+				}
+			},
+			0,
+			false,
+		},
+		{
+			"valid - lockup and vesting events are merged",
+			func(hetuApp *app.Evmos, ctx sdk.Context) {
+				createClawbackVestingAccount(t, hetuApp, ctx, sdk.AccAddress(types.ModuleName), addr)
+				req = &types.QueryUnlockScheduleRequest{
+					Address: addr.String(),
+				}
+			},
+			5,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hetuApp, ctx, queryClient := setupQueryTest(t)
+			tc.malleate(hetuApp, ctx)
+
+			res, err := queryClient.UnlockSchedule(ctx, req)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, res.Events, tc.expEvents)
+
+			spendable := sdk.NewCoins()
+			for _, event := range res.Events {
+				spendable = spendable.Add(event.Spendable...)
+			}
+			require.Equal(t, balances, spendable)
+		})
+	}
+}
+
+func TestFunderAccounts(t *testing.T) {
+	var req *types.QueryFunderAccountsRequest
+	funder := sdk.AccAddress(types.ModuleName)
+
+	testCases := []struct {
+		name        string
+		malleate    func(*app.Evmos, sdk.Context)
+		expAccounts []sdk.AccAddress
+		expTotal    uint64
+		expNextKey  bool
+		expPass     bool
+	}{
+		{
+			"empty req",
+			func(*app.Evmos, sdk.Context) {
+				req = &types.QueryFunderAccountsRequest{}
+			},
+			nil,
+			0,
+			false,
+			false,
+		},
+		{
+			"valid - no accounts",
+			func(*app.Evmos, sdk.Context) {
+				req = &types.QueryFunderAccountsRequest{
+					Funder: funder.String(),
+				}
+			},
+			nil,
+			0,
+			false,
+			true,
+		},
+		{
+			"valid - paginated",
+			func(hetuApp *app.Evmos, ctx sdk.Context) {
+				createClawbackVestingAccount(t, hetuApp, ctx, funder, addr2)
+				createClawbackVestingAccount(t, hetuApp, ctx, funder, addr3)
+				createClawbackVestingAccount(t, hetuApp, ctx, addr, addr4)
+				req = &types.QueryFunderAccountsRequest{
+					Funder:     funder.String(),
+					Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+				}
+			},
+			// accounts are ordered by address
+			[]sdk.AccAddress{minAddress(addr2, addr3)},
+			2,
+			true,
+			true,
+		},
+		{
+			"valid - funder updated",
+			func(hetuApp *app.Evmos, ctx sdk.Context) {
+				createClawbackVestingAccount(t, hetuApp, ctx, addr, addr2)
+				createClawbackVestingAccount(t, hetuApp, ctx, addr, addr3)
+				_, err := hetuApp.VestingKeeper.UpdateVestingFunder(ctx, types.NewMsgUpdateVestingFunder(addr, funder, addr3))
+				require.NoError(t, err)
+				req = &types.QueryFunderAccountsRequest{
+					Funder:     funder.String(),
+					Pagination: &query.PageRequest{CountTotal: true},
+				}
+			},
+			[]sdk.AccAddress{addr3},
+			1,
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hetuApp, ctx, queryClient := setupQueryTest(t)
+			tc.malleate(hetuApp, ctx)
+
+			res, err := queryClient.FunderAccounts(ctx, req)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, res.Accounts, len(tc.expAccounts))
+			for i, account := range res.Accounts {
+				require.Equal(t, tc.expAccounts[i].String(), account.Address)
+				require.Equal(t, funder.String(), account.FunderAddress)
+			}
+			require.Equal(t, tc.expTotal, res.Pagination.Total)
+			require.Equal(t, tc.expNextKey, res.Pagination.NextKey != nil)
+		})
+	}
+}
+
+func TestSupply(t *testing.T) {
+	hetuApp, ctx, queryClient := setupQueryTest(t)
+	createClawbackVestingAccount(t, hetuApp, ctx, sdk.AccAddress(types.ModuleName), addr2)
+	createClawbackVestingAccount(t, hetuApp, ctx, addr, addr3)
+
+	res, err := queryClient.Supply(ctx, &types.QuerySupplyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Accounts)
+	require.Equal(t, balances.Add(balances...), res.Locked)
+	require.Equal(t, balances.Add(balances...), res.Unvested)
+
+	// once the lockup has ended, nothing is locked and half of each grant is
+	// still unvested
+	endLockup := ctx.BlockTime().Add(5000 * time.Second).Unix()
+	res, err = queryClient.Supply(ctx, &types.QuerySupplyRequest{Time: endLockup})
+	require.NoError(t, err)
+	require.True(t, res.Locked.IsZero())
+	require.Equal(t, quarter.Add(quarter...).Add(quarter...).Add(quarter...), res.Unvested)
+
+	// accounts missing from the index are not part of the supply until the
+	// migration indexes them again
+	hetuApp.VestingKeeper.DeleteFunderAccount(ctx, addr, addr3)
+	res, err = queryClient.Supply(ctx, &types.QuerySupplyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Accounts)
+
+	err = keeper.NewMigrator(hetuApp.VestingKeeper).Migrate1to2(ctx)
+	require.NoError(t, err)
+	require.True(t, hetuApp.VestingKeeper.HasFunderAccount(ctx, addr, addr3))
+
+	res, err = queryClient.Supply(ctx, &types.QuerySupplyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Accounts)
+}
+
+// minAddress returns the lowest of the two addresses in byte order.
+func minAddress(a, b sdk.AccAddress) sdk.AccAddress {
+	if string(a) < string(b) {
+		return a
+	}
+	return b
+}
@@ -123,6 +123,7 @@ func (k Keeper) CreateClawbackVestingAccount(
 		)
 		acc := ak.NewAccount(ctx, vestingAcc)
 		ak.SetAccount(ctx, acc)
+		k.SetFunderAccount(ctx, from, to)
 		madeNewAcc = true
 	}
 
@@ -212,8 +213,19 @@ func (k Keeper) Clawback(
 		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "clawback can only be executed after vesting begins: %s", va.FunderAddress)
 	}
 
+	// Partial clawbacks can only reclaim coins that are still unvested
+	if !msg.Amount.IsZero() {
+		unvested := va.GetUnvestedOnly(ctx.BlockTime())
+		if !msg.Amount.IsAllLTE(unvested) {
+			return nil, errorsmod.Wrapf(types.ErrInsufficientUnvestedCoins,
+				"clawback amount %s exceeds unvested coins %s", msg.Amount, unvested,
+			)
+		}
+	}
+
 	// Perform clawback transfer
-	if err := k.transferClawback(ctx, *va, dest); err != nil {
+	clawedBack, err := k.transferClawback(ctx, *va, dest, msg.Amount)
+	if err != nil {
 		return nil, err
 	}
 
@@ -224,6 +236,7 @@ func (k Keeper) Clawback(
 				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
 				sdk.NewAttribute(types.AttributeKeyAccount, msg.AccountAddress),
 				sdk.NewAttribute(types.AttributeKeyDestination, msg.DestAddress),
+				sdk.NewAttribute(types.AttributeKeyCoins, clawedBack.String()),
 			),
 		},
 	)
@@ -273,6 +286,8 @@ func (k Keeper) UpdateVestingFunder(
 	va.FunderAddress = msg.NewFunderAddress
 	// set the account with the updated funder
 	ak.SetAccount(ctx, va)
+	k.DeleteFunderAccount(ctx, sdk.MustAccAddressFromBech32(msg.FunderAddress), vesting)
+	k.SetFunderAccount(ctx, newFunder, vesting)
 
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
@@ -321,10 +336,72 @@ func (k Keeper) ConvertVestingAccount(
 	ethAccount := evmostypes.ProtoAccount().(*evmostypes.EthAccount)
 	ethAccount.BaseAccount = vestingAcc.BaseAccount
 	k.accountKeeper.SetAccount(ctx, ethAccount)
+	k.DeleteFunderAccount(ctx, sdk.MustAccAddressFromBech32(vestingAcc.FunderAddress), address)
 
 	return &types.MsgConvertVestingAccountResponse{}, nil
 }
 
+// AmendVestingSchedule replaces the future lockup and/or vesting periods of a
+// ClawbackVestingAccount. Periods that already passed are preserved, so the
+// vested and unlocked amounts of the account remain unchanged.
+func (k Keeper) AmendVestingSchedule(
+	goCtx context.Context,
+	msg *types.MsgAmendVestingSchedule,
+) (*types.MsgAmendVestingScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// NOTE: errors checked during msg validation
+	vesting := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+
+	// Check if vesting account exists
+	acc := k.accountKeeper.GetAccount(ctx, vesting)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "account %s does not exist", msg.VestingAddress)
+	}
+
+	// Check if account is a clawback vesting account
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account not subject to clawback: %s", msg.VestingAddress)
+	}
+
+	// Check if account current funder is same as in msg
+	if va.FunderAddress != msg.FunderAddress {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "schedule can only be amended by funder %s", va.FunderAddress)
+	}
+
+	amendedAcc, err := va.ComputeAmendedSchedule(
+		ctx.BlockTime().Unix(),
+		msg.StartTime.Unix(),
+		msg.LockupPeriods,
+		msg.VestingPeriods,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSchedule, err.Error())
+	}
+
+	if err := k.checkScheduleInvariants(ctx, *va, amendedAcc, sdk.Coins{}); err != nil {
+		return nil, err
+	}
+
+	// set the account with the amended schedules
+	k.accountKeeper.SetAccount(ctx, &amendedAcc)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeAmendVestingSchedule,
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+				sdk.NewAttribute(types.AttributeKeyStartTime, msg.StartTime.String()),
+				sdk.NewAttribute(types.AttributeKeyEndTime, time.Unix(amendedAcc.EndTime, 0).UTC().String()),
+			),
+		},
+	)
+
+	return &types.MsgAmendVestingScheduleResponse{}, nil
+}
+
 // addGrant merges a new clawback vesting grant into an existing
 // ClawbackVestingAccount.
 func (k Keeper) addGrant(
@@ -375,16 +452,32 @@ func (k Keeper) addGrant(
 
 // transferClawback transfers unvested tokens in a ClawbackVestingAccount to
 // dest address, updates the lockup schedule and removes future vesting events.
+// If amount is empty, all the unvested tokens are transferred. It returns the
+// transferred amount.
 func (k Keeper) transferClawback(
 	ctx sdk.Context,
 	va types.ClawbackVestingAccount,
 	dest sdk.AccAddress,
-) error {
+	amount sdk.Coins,
+) (sdk.Coins, error) {
 	// Compute clawback amount, unlock unvested tokens and remove future vesting events
-	updatedAcc, toClawBack := va.ComputeClawback(ctx.BlockTime().Unix())
+	var (
+		updatedAcc types.ClawbackVestingAccount
+		toClawBack sdk.Coins
+	)
+	if amount.IsZero() {
+		updatedAcc, toClawBack = va.ComputeClawback(ctx.BlockTime().Unix())
+	} else {
+		updatedAcc, toClawBack = va.ComputePartialClawback(ctx.BlockTime().Unix(), amount)
+	}
+
 	if toClawBack.IsZero() {
 		// no-op, nothing to transfer
-		return nil
+		return toClawBack, nil
+	}
+
+	if err := k.checkScheduleInvariants(ctx, va, updatedAcc, toClawBack); err != nil {
+		return nil, err
 	}
 
 	// set the account with the updated values of the vesting schedule
@@ -398,5 +491,40 @@ func (k Keeper) transferClawback(
 	// different denoms (because of store iteration).
 
 	// Transfer clawback to the destination (funder)
-	return k.bankKeeper.SendCoins(ctx, addr, dest, toClawBack)
+	if err := k.bankKeeper.SendCoins(ctx, addr, dest, toClawBack); err != nil {
+		return nil, err
+	}
+
+	return toClawBack, nil
+}
+
+// checkScheduleInvariants verifies that an update of a ClawbackVestingAccount
+// schedule does not modify its vested coins, and that the amount a full
+// clawback (see ComputeClawback) would return is only reduced by the clawed
+// back coins.
+func (k Keeper) checkScheduleInvariants(
+	ctx sdk.Context,
+	before, after types.ClawbackVestingAccount,
+	clawedBack sdk.Coins,
+) error {
+	blockTime := ctx.BlockTime()
+
+	vestedBefore := sdk.NewCoins(before.GetVestedCoins(blockTime)...)
+	vestedAfter := sdk.NewCoins(after.GetVestedCoins(blockTime)...)
+	if !vestedBefore.Equal(vestedAfter) {
+		return errorsmod.Wrapf(types.ErrInvalidSchedule,
+			"vested coins changed from %s to %s", vestedBefore, vestedAfter,
+		)
+	}
+
+	_, unvestedBefore := before.ComputeClawback(blockTime.Unix())
+	_, unvestedAfter := after.ComputeClawback(blockTime.Unix())
+	expUnvested := sdk.NewCoins(unvestedBefore...).Sub(clawedBack...)
+	if !expUnvested.Equal(sdk.NewCoins(unvestedAfter...)) {
+		return errorsmod.Wrapf(types.ErrInvalidSchedule,
+			"expected %s clawable coins after update, got %s", expUnvested, unvestedAfter,
+		)
+	}
+
+	return nil
 }
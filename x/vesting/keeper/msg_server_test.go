@@ -442,6 +442,179 @@ func (suite *KeeperTestSuite) TestMsgUpdateVestingFunder() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgPartialClawback() {
+	testCases := []struct {
+		name          string
+		amount        sdk.Coins
+		expClawedBack sdk.Coins
+		expectedPass  bool
+	}{
+		{
+			"fail - amount exceeds unvested coins",
+			sdk.NewCoins(sdk.NewInt64Coin("test", 600)),
+			nil,
+			false,
+		},
+		{
+			"fail - amount denom not vesting",
+			sdk.NewCoins(sdk.NewInt64Coin("other", 100)),
+			nil,
+			false,
+		},
+		{
+			"pass - claw back part of the unvested coins",
+			sdk.NewCoins(sdk.NewInt64Coin("test", 250)),
+			sdk.NewCoins(sdk.NewInt64Coin("test", 250)),
+			true,
+		},
+		{
+			"pass - claw back all the unvested coins",
+			sdk.NewCoins(sdk.NewInt64Coin("test", 500)),
+			sdk.NewCoins(sdk.NewInt64Coin("test", 500)),
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr, balances)
+			suite.Require().NoError(err)
+
+			// Create Clawback Vesting Account with two vested periods
+			startTime := suite.ctx.BlockTime().Add(-4500 * time.Second)
+			createMsg := types.NewMsgCreateClawbackVestingAccount(addr, addr2, startTime, lockupPeriods, vestingPeriods, false)
+			_, err = suite.app.VestingKeeper.CreateClawbackVestingAccount(ctx, createMsg)
+			suite.Require().NoError(err)
+
+			// Perform partial clawback
+			msg := types.NewMsgClawback(addr, addr2, addr3)
+			msg.Amount = tc.amount
+			res, err := suite.app.VestingKeeper.Clawback(ctx, msg)
+
+			balanceDest := suite.app.BankKeeper.GetBalance(suite.ctx, addr3, "test")
+			if tc.expectedPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(&types.MsgClawbackResponse{}, res)
+				suite.Require().Equal(tc.expClawedBack[0], balanceDest)
+
+				acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr2)
+				va, ok := acc.(*types.ClawbackVestingAccount)
+				suite.Require().True(ok)
+				suite.Require().Equal(balances.Sub(tc.expClawedBack...), va.OriginalVesting)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 500)), va.GetVestedOnly(suite.ctx.BlockTime()))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+				suite.Require().True(balanceDest.IsZero())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgAmendVestingSchedule() {
+	half := sdk.NewCoins(sdk.NewInt64Coin("test", 500))
+
+	testCases := []struct {
+		name         string
+		funder       sdk.AccAddress
+		vestingAcc   sdk.AccAddress
+		startTime    func() time.Time
+		lockup       sdkvesting.Periods
+		vesting      sdkvesting.Periods
+		expectedPass bool
+	}{
+		{
+			"fail - non-existent vesting account",
+			addr,
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+			func() time.Time { return suite.ctx.BlockTime() },
+			nil,
+			sdkvesting.Periods{{Length: 10000, Amount: half}},
+			false,
+		},
+		{
+			"fail - wrong funder",
+			addr3,
+			addr2,
+			func() time.Time { return suite.ctx.BlockTime() },
+			nil,
+			sdkvesting.Periods{{Length: 10000, Amount: half}},
+			false,
+		},
+		{
+			"fail - amended periods include vested coins",
+			addr,
+			addr2,
+			func() time.Time { return suite.ctx.BlockTime() },
+			nil,
+			sdkvesting.Periods{{Length: 10000, Amount: balances}},
+			false,
+		},
+		{
+			"fail - amended periods end before the block time",
+			addr,
+			addr2,
+			func() time.Time { return suite.ctx.BlockTime().Add(-1000 * time.Second) },
+			nil,
+			sdkvesting.Periods{{Length: 500, Amount: half}},
+			false,
+		},
+		{
+			"pass - lengthen the vesting schedule",
+			addr,
+			addr2,
+			func() time.Time { return suite.ctx.BlockTime() },
+			nil,
+			sdkvesting.Periods{{Length: 5000, Amount: quarter}, {Length: 5000, Amount: quarter}},
+			true,
+		},
+		{
+			"pass - extend the lockup cliff",
+			addr,
+			addr2,
+			func() time.Time { return suite.ctx.BlockTime() },
+			sdkvesting.Periods{{Length: 10000, Amount: balances}},
+			nil,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr, balances)
+			suite.Require().NoError(err)
+
+			// Create Clawback Vesting Account with two vested periods
+			startTime := suite.ctx.BlockTime().Add(-4500 * time.Second)
+			createMsg := types.NewMsgCreateClawbackVestingAccount(addr, addr2, startTime, lockupPeriods, vestingPeriods, false)
+			_, err = suite.app.VestingKeeper.CreateClawbackVestingAccount(ctx, createMsg)
+			suite.Require().NoError(err)
+
+			before := suite.app.AccountKeeper.GetAccount(suite.ctx, addr2).(*types.ClawbackVestingAccount)
+
+			msg := types.NewMsgAmendVestingSchedule(tc.funder, tc.vestingAcc, tc.startTime(), tc.lockup, tc.vesting)
+			res, err := suite.app.VestingKeeper.AmendVestingSchedule(ctx, msg)
+
+			if tc.expectedPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(&types.MsgAmendVestingScheduleResponse{}, res)
+
+				after := suite.app.AccountKeeper.GetAccount(suite.ctx, addr2).(*types.ClawbackVestingAccount)
+				suite.Require().Equal(before.OriginalVesting, after.OriginalVesting)
+				suite.Require().Equal(before.GetVestedOnly(suite.ctx.BlockTime()), after.GetVestedOnly(suite.ctx.BlockTime()))
+				suite.Require().Equal(suite.ctx.BlockTime().Add(10000*time.Second).Unix(), after.EndTime)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestClawbackVestingAccountStore() {
 	suite.SetupTest()
 
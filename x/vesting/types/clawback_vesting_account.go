@@ -18,6 +18,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -220,6 +221,147 @@ func (va ClawbackVestingAccount) ComputeClawback(
 	return va, totalUnvested
 }
 
+// ComputePartialClawback returns an account with the latest future vesting
+// events reduced by the given amount and the clawback amount. The amount is
+// capped to the coins that are still unvested at the clawback time, so that
+// clawing back the total unvested amount is equivalent to ComputeClawback.
+// Lockup events are capped to the new original vesting amount.
+func (va ClawbackVestingAccount) ComputePartialClawback(
+	clawbackTime int64,
+	amount sdk.Coins,
+) (ClawbackVestingAccount, sdk.Coins) {
+	// NOTE: error must be checked during message execution
+	if clawbackTime < va.GetStartTime() {
+		return va, sdk.Coins{}
+	}
+
+	totalUnvested := va.GetUnvestedOnly(time.Unix(clawbackTime, 0))
+	toClawBack := totalUnvested.Min(amount)
+	if toClawBack.IsZero() {
+		return va, sdk.Coins{}
+	}
+
+	// Cap both schedules to the remaining original vesting amount. As the
+	// clawed back coins are unvested, this only removes the latest vesting
+	// events and leaves the vested amount untouched.
+	capPeriods := sdkvesting.Periods{
+		{
+			Length: 0,
+			Amount: va.OriginalVesting.Sub(toClawBack...),
+		},
+	}
+
+	_, newLockingEnd, newLockupPeriods := ConjunctPeriods(va.GetStartTime(), va.GetStartTime(), va.LockupPeriods, capPeriods)
+	_, newVestingEnd, newVestingPeriods := ConjunctPeriods(va.GetStartTime(), va.GetStartTime(), va.VestingPeriods, capPeriods)
+
+	va.OriginalVesting = capPeriods[0].Amount
+	va.EndTime = Max64(newVestingEnd, newLockingEnd)
+	va.LockupPeriods = newLockupPeriods
+	va.VestingPeriods = newVestingPeriods
+
+	return va, toClawBack
+}
+
+// ComputeAmendedSchedule returns an account where the lockup and vesting
+// events after the amend time are replaced by the given periods, which are
+// relative to startTime. Events at or before the amend time are preserved, so
+// the amended periods must describe exactly the coins that are still locked or
+// unvested. An empty periods list keeps the corresponding schedule unchanged.
+func (va ClawbackVestingAccount) ComputeAmendedSchedule(
+	amendTime, startTime int64,
+	lockupPeriods, vestingPeriods sdkvesting.Periods,
+) (ClawbackVestingAccount, error) {
+	if startTime < va.GetStartTime() {
+		return va, fmt.Errorf("amended periods cannot start before the account start time %d", va.GetStartTime())
+	}
+
+	newLockupEnd := va.GetStartTime() + va.LockupPeriods.TotalLength()
+	newLockupPeriods := va.LockupPeriods
+	if len(lockupPeriods) > 0 {
+		var err error
+		newLockupEnd, newLockupPeriods, err = va.amendPeriods(va.LockupPeriods, lockupPeriods, amendTime, startTime)
+		if err != nil {
+			return va, fmt.Errorf("invalid lockup periods: %w", err)
+		}
+	}
+
+	newVestingEnd := va.GetStartTime() + va.VestingPeriods.TotalLength()
+	newVestingPeriods := va.VestingPeriods
+	if len(vestingPeriods) > 0 {
+		var err error
+		newVestingEnd, newVestingPeriods, err = va.amendPeriods(va.VestingPeriods, vestingPeriods, amendTime, startTime)
+		if err != nil {
+			return va, fmt.Errorf("invalid vesting periods: %w", err)
+		}
+	}
+
+	va.EndTime = Max64(newLockupEnd, newVestingEnd)
+	va.LockupPeriods = newLockupPeriods
+	va.VestingPeriods = newVestingPeriods
+
+	return va, nil
+}
+
+// amendPeriods merges the periods of a schedule that passed at the amend time
+// with the new periods, which must describe the remaining amount of the
+// schedule and start after the amend time. It returns the new end time and the
+// merged periods relative to the account start time.
+func (va ClawbackVestingAccount) amendPeriods(
+	periods, newPeriods sdkvesting.Periods,
+	amendTime, startTime int64,
+) (int64, sdkvesting.Periods, error) {
+	passed := ReadPastPeriodCount(va.GetStartTime(), va.EndTime, periods, amendTime)
+	past := ReadSchedule(va.GetStartTime(), va.EndTime, periods, va.OriginalVesting, amendTime)
+	remaining := va.OriginalVesting.Sub(past...)
+
+	if !coinEq(newPeriods.TotalAmount(), remaining) {
+		return 0, nil, fmt.Errorf("periods must describe the remaining %s, got %s", remaining, newPeriods.TotalAmount())
+	}
+
+	if startTime+newPeriods[0].Length <= amendTime {
+		return 0, nil, fmt.Errorf("first period must end after %d", amendTime)
+	}
+
+	_, endTime, merged := DisjunctPeriods(va.GetStartTime(), startTime, periods[:passed], newPeriods)
+	return endTime, merged, nil
+}
+
+// GetUnlockEvents returns the lockup and vesting events that happen after
+// readTime, with simultaneous events merged into a single one.
+func (va ClawbackVestingAccount) GetUnlockEvents(readTime time.Time) []UnlockEvent {
+	// the union of both schedules contains the time of every lockup and
+	// vesting event
+	_, _, periods := DisjunctPeriods(va.GetStartTime(), va.GetStartTime(), va.LockupPeriods, va.GetVestingPeriods())
+
+	events := []UnlockEvent{}
+	prevTime := readTime
+	eventTime := va.GetStartTime()
+
+	for _, period := range periods {
+		eventTime += period.Length
+		t := time.Unix(eventTime, 0)
+		// events at or before the read time already happened
+		if !t.After(readTime) {
+			continue
+		}
+
+		event := UnlockEvent{
+			Time:      t.UTC(),
+			Unlocked:  va.GetUnlockedOnly(t).Sub(va.GetUnlockedOnly(prevTime)...),
+			Vested:    va.GetVestedOnly(t).Sub(va.GetVestedOnly(prevTime)...),
+			Spendable: va.GetVestedCoins(t).Sub(va.GetVestedCoins(prevTime)...),
+		}
+		prevTime = t
+
+		if event.Unlocked.IsZero() && event.Vested.IsZero() {
+			continue
+		}
+		events = append(events, event)
+	}
+
+	return events
+}
+
 // HasLockedCoins returns true if the blocktime has not passed all clawback
 // account's lockup periods
 func (va ClawbackVestingAccount) HasLockedCoins(blockTime time.Time) bool {
@@ -538,3 +538,261 @@ func (suite *VestingAccountTestSuite) TestComputeClawback() {
 		})
 	}
 }
+
+func (suite *VestingAccountTestSuite) TestComputePartialClawback() {
+	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(feeDenom, x) }
+	stake := func(x int64) sdk.Coin { return sdk.NewInt64Coin(stakeDenom, x) }
+	now := tmtime.Now()
+	lockupPeriods := sdkvesting.Periods{
+		{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(1000), stake(100))}, // noon
+	}
+	vestingPeriods := sdkvesting.Periods{
+		{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(200))},            // 8am
+		{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))}, // 9am
+		{Length: int64(6 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))}, // 3pm
+		{Length: int64(2 * 3600), Amount: sdk.NewCoins(fee(200))},            // 5pm
+		{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200))},            // 6pm
+	}
+
+	testCases := []struct {
+		name               string
+		time               int64
+		amount             sdk.Coins
+		expClawedBack      sdk.Coins
+		expOriginalVesting sdk.Coins
+		expLockupPeriods   sdkvesting.Periods
+		expVestingPeriods  sdkvesting.Periods
+	}{
+		{
+			"should no-op if clawed back before start time",
+			now.Add(-time.Hour).Unix(),
+			sdk.NewCoins(fee(100)),
+			sdk.Coins{},
+			origCoins,
+			lockupPeriods,
+			vestingPeriods,
+		},
+		{
+			"should remove the latest vesting events first",
+			now.Add(11 * time.Hour).Unix(),
+			sdk.NewCoins(fee(300)),
+			sdk.NewCoins(fee(300)),
+			sdk.NewCoins(fee(700), stake(100)),
+			sdkvesting.Periods{{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(700), stake(100))}},
+			sdkvesting.Periods{
+				{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(200))},
+				{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))},
+				{Length: int64(6 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))},
+				{Length: int64(2 * 3600), Amount: sdk.NewCoins(fee(100))},
+			},
+		},
+		{
+			"should cap the amount to the unvested coins",
+			now.Add(11 * time.Hour).Unix(),
+			sdk.NewCoins(fee(1000)),
+			sdk.NewCoins(fee(600)),
+			sdk.NewCoins(fee(400), stake(100)),
+			sdkvesting.Periods{{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(400), stake(100))}},
+			sdkvesting.Periods{
+				{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(200))},
+				{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))},
+				{Length: int64(6 * 3600), Amount: sdk.NewCoins(stake(50))},
+			},
+		},
+		{
+			"should be equivalent to a full clawback when clawing back all unvested coins",
+			now.Add(11 * time.Hour).Unix(),
+			sdk.NewCoins(fee(600), stake(50)),
+			sdk.NewCoins(fee(600), stake(50)),
+			sdk.NewCoins(fee(400), stake(50)),
+			sdkvesting.Periods{{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(400), stake(50))}},
+			vestingPeriods[:2],
+		},
+		{
+			"should clawback zero coins after all vesting and locked periods",
+			now.Add(23 * time.Hour).Unix(),
+			sdk.NewCoins(fee(100)),
+			sdk.Coins{},
+			sdk.NewCoins(fee(1000), stake(100)),
+			lockupPeriods,
+			vestingPeriods,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+			bacc := authtypes.NewBaseAccountWithAddress(addr)
+			va := types.NewClawbackVestingAccount(bacc, sdk.AccAddress([]byte("funder")), origCoins, now, lockupPeriods, vestingPeriods)
+
+			va2, amt := va.ComputePartialClawback(tc.time, tc.amount)
+
+			suite.Require().Equal(tc.expClawedBack, amt)
+			suite.Require().Equal(tc.expOriginalVesting, va2.OriginalVesting)
+			suite.Require().Equal(tc.expLockupPeriods, va2.LockupPeriods)
+			suite.Require().Equal(tc.expVestingPeriods, va2.VestingPeriods)
+			// vested coins must not change
+			suite.Require().Equal(va.GetVestedCoins(time.Unix(tc.time, 0)), va2.GetVestedCoins(time.Unix(tc.time, 0)))
+		})
+	}
+}
+
+func (suite *VestingAccountTestSuite) TestComputeAmendedSchedule() {
+	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(feeDenom, x) }
+	stake := func(x int64) sdk.Coin { return sdk.NewInt64Coin(stakeDenom, x) }
+	now := tmtime.Now()
+	lockupPeriods := sdkvesting.Periods{
+		{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(1000), stake(100))}, // noon
+	}
+	vestingPeriods := sdkvesting.Periods{
+		{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(200))},            // 8am
+		{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))}, // 9am
+		{Length: int64(6 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))}, // 3pm
+		{Length: int64(2 * 3600), Amount: sdk.NewCoins(fee(200))},            // 5pm
+		{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200))},            // 6pm
+	}
+	amendTime := now.Add(11 * time.Hour)
+
+	testCases := []struct {
+		name              string
+		startTime         time.Time
+		lockup            sdkvesting.Periods
+		vesting           sdkvesting.Periods
+		expLockupPeriods  sdkvesting.Periods
+		expVestingPeriods sdkvesting.Periods
+		expEndTime        int64
+		expErr            bool
+	}{
+		{
+			"pass - lengthen the remaining vesting periods",
+			amendTime,
+			nil,
+			sdkvesting.Periods{{Length: int64(13 * 3600), Amount: sdk.NewCoins(fee(600), stake(50))}},
+			lockupPeriods,
+			sdkvesting.Periods{
+				{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(200))},
+				{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))},
+				{Length: int64(15 * 3600), Amount: sdk.NewCoins(fee(600), stake(50))},
+			},
+			now.Add(24 * time.Hour).Unix(),
+			false,
+		},
+		{
+			"pass - extend the lockup cliff",
+			amendTime,
+			sdkvesting.Periods{{Length: int64(10 * 3600), Amount: sdk.NewCoins(fee(1000), stake(100))}},
+			nil,
+			sdkvesting.Periods{{Length: int64(21 * 3600), Amount: sdk.NewCoins(fee(1000), stake(100))}},
+			vestingPeriods,
+			now.Add(21 * time.Hour).Unix(),
+			false,
+		},
+		{
+			"fail - periods do not describe the unvested coins",
+			amendTime,
+			nil,
+			sdkvesting.Periods{{Length: int64(13 * 3600), Amount: sdk.NewCoins(fee(1000), stake(100))}},
+			nil,
+			nil,
+			0,
+			true,
+		},
+		{
+			"fail - first period ends before the amend time",
+			now,
+			nil,
+			sdkvesting.Periods{{Length: int64(10 * 3600), Amount: sdk.NewCoins(fee(600), stake(50))}},
+			nil,
+			nil,
+			0,
+			true,
+		},
+		{
+			"fail - periods start before the account start time",
+			now.Add(-time.Hour),
+			nil,
+			sdkvesting.Periods{{Length: int64(13 * 3600), Amount: sdk.NewCoins(fee(600), stake(50))}},
+			nil,
+			nil,
+			0,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+			bacc := authtypes.NewBaseAccountWithAddress(addr)
+			va := types.NewClawbackVestingAccount(bacc, sdk.AccAddress([]byte("funder")), origCoins, now, lockupPeriods, vestingPeriods)
+
+			va2, err := va.ComputeAmendedSchedule(amendTime.Unix(), tc.startTime.Unix(), tc.lockup, tc.vesting)
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(origCoins, va2.OriginalVesting)
+			suite.Require().Equal(tc.expLockupPeriods, va2.LockupPeriods)
+			suite.Require().Equal(tc.expVestingPeriods, va2.VestingPeriods)
+			suite.Require().Equal(tc.expEndTime, va2.EndTime)
+			suite.Require().Equal(va.GetVestedCoins(amendTime), va2.GetVestedCoins(amendTime))
+		})
+	}
+}
+
+func (suite *VestingAccountTestSuite) TestGetUnlockEvents() {
+	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(feeDenom, x) }
+	stake := func(x int64) sdk.Coin { return sdk.NewInt64Coin(stakeDenom, x) }
+	now := tmtime.Now()
+	lockupPeriods := sdkvesting.Periods{
+		{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(1000), stake(100))}, // noon
+	}
+	vestingPeriods := sdkvesting.Periods{
+		{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(200))},            // 8am
+		{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))}, // 9am
+		{Length: int64(6 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))}, // 3pm
+		{Length: int64(2 * 3600), Amount: sdk.NewCoins(fee(200))},            // 5pm
+		{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200))},            // 6pm
+	}
+
+	addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, sdk.AccAddress([]byte("funder")), origCoins, now, lockupPeriods, vestingPeriods)
+
+	testCases := []struct {
+		name      string
+		readTime  time.Time
+		expEvents []types.UnlockEvent
+	}{
+		{
+			"all events after the schedules ended",
+			now.Add(19 * time.Hour),
+			[]types.UnlockEvent{},
+		},
+		{
+			"merged events after the first vesting period",
+			now.Add(8*time.Hour + 30*time.Minute),
+			[]types.UnlockEvent{
+				{Time: now.Add(9 * time.Hour), Vested: sdk.NewCoins(fee(200), stake(50))},
+				{Time: now.Add(12 * time.Hour), Unlocked: sdk.NewCoins(fee(1000), stake(100)), Spendable: sdk.NewCoins(fee(400), stake(50))},
+				{Time: now.Add(15 * time.Hour), Vested: sdk.NewCoins(fee(200), stake(50)), Spendable: sdk.NewCoins(fee(200), stake(50))},
+				{Time: now.Add(17 * time.Hour), Vested: sdk.NewCoins(fee(200)), Spendable: sdk.NewCoins(fee(200))},
+				{Time: now.Add(18 * time.Hour), Vested: sdk.NewCoins(fee(200)), Spendable: sdk.NewCoins(fee(200))},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			events := va.GetUnlockEvents(tc.readTime)
+			suite.Require().Len(events, len(tc.expEvents))
+			for i, event := range events {
+				suite.Require().Equal(tc.expEvents[i].Time.Unix(), event.Time.Unix())
+				suite.Require().True(tc.expEvents[i].Unlocked.Equal(event.Unlocked), "unlocked %d: %s", i, event.Unlocked)
+				suite.Require().True(tc.expEvents[i].Vested.Equal(event.Vested), "vested %d: %s", i, event.Vested)
+				suite.Require().True(tc.expEvents[i].Spendable.Equal(event.Spendable), "spendable %d: %s", i, event.Spendable)
+			}
+		})
+	}
+}
@@ -25,4 +25,6 @@ var (
 	ErrInsufficientVestedCoins   = errorsmod.Register(ModuleName, 2, "insufficient vested coins error")
 	ErrVestingLockup             = errorsmod.Register(ModuleName, 3, "vesting lockup error")
 	ErrInsufficientUnlockedCoins = errorsmod.Register(ModuleName, 4, "insufficient unlocked coins error")
+	ErrInsufficientUnvestedCoins = errorsmod.Register(ModuleName, 5, "insufficient unvested coins error")
+	ErrInvalidSchedule           = errorsmod.Register(ModuleName, 6, "invalid vesting schedule")
 )
@@ -21,9 +21,11 @@ const (
 	EventTypeCreateClawbackVestingAccount = "create_clawback_vesting_account"
 	EventTypeClawback                     = "clawback"
 	EventTypeUpdateVestingFunder          = "update_vesting_funder"
+	EventTypeAmendVestingSchedule         = "amend_vesting_schedule"
 
 	AttributeKeyCoins       = "coins"
 	AttributeKeyStartTime   = "start_time"
+	AttributeKeyEndTime     = "end_time"
 	AttributeKeyMerge       = "merge"
 	AttributeKeyAccount     = "account"
 	AttributeKeyFunder      = "funder"
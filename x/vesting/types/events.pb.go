@@ -113,6 +113,8 @@ type EventClawback struct {
 	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 	// destination is the address of the destination
 	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	// coins is the amount of unvested coins clawed back
+	Coins string `protobuf:"bytes,4,opt,name=coins,proto3" json:"coins,omitempty"`
 }
 
 func (m *EventClawback) Reset()         { *m = EventClawback{} }
@@ -169,6 +171,13 @@ func (m *EventClawback) GetDestination() string {
 	return ""
 }
 
+func (m *EventClawback) GetCoins() string {
+	if m != nil {
+		return m.Coins
+	}
+	return ""
+}
+
 // EventUpdateVestingFunder defines the event type for updating the vesting funder
 type EventUpdateVestingFunder struct {
 	// funder is the address of the funder
@@ -233,36 +242,114 @@ func (m *EventUpdateVestingFunder) GetNewFunder() string {
 	return ""
 }
 
+// EventAmendVestingSchedule defines the event type for amending the lockup
+// and vesting schedules of a clawback vesting account
+type EventAmendVestingSchedule struct {
+	// funder is the address of the funder
+	Funder string `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
+	// account is the address of the account
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// start_time is the time the amended periods are relative to
+	StartTime string `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the new end time of the account
+	EndTime string `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *EventAmendVestingSchedule) Reset()         { *m = EventAmendVestingSchedule{} }
+func (m *EventAmendVestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*EventAmendVestingSchedule) ProtoMessage()    {}
+func (*EventAmendVestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48ea095c8e21d4cd, []int{3}
+}
+func (m *EventAmendVestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAmendVestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAmendVestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAmendVestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAmendVestingSchedule.Merge(m, src)
+}
+func (m *EventAmendVestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAmendVestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAmendVestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAmendVestingSchedule proto.InternalMessageInfo
+
+func (m *EventAmendVestingSchedule) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *EventAmendVestingSchedule) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventAmendVestingSchedule) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *EventAmendVestingSchedule) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreateClawbackVestingAccount)(nil), "evmos.vesting.v1.EventCreateClawbackVestingAccount")
 	proto.RegisterType((*EventClawback)(nil), "evmos.vesting.v1.EventClawback")
 	proto.RegisterType((*EventUpdateVestingFunder)(nil), "evmos.vesting.v1.EventUpdateVestingFunder")
+	proto.RegisterType((*EventAmendVestingSchedule)(nil), "evmos.vesting.v1.EventAmendVestingSchedule")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v1/events.proto", fileDescriptor_48ea095c8e21d4cd) }
 
 var fileDescriptor_48ea095c8e21d4cd = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x9b, 0x42, 0x8b, 0x7a, 0x08, 0x09, 0x45, 0x08, 0x79, 0x69, 0x54, 0x3a, 0xb1, 0x90,
-	0xa8, 0xea, 0x13, 0x40, 0x05, 0x1b, 0x0b, 0x02, 0x06, 0x96, 0xca, 0x71, 0x8f, 0x36, 0x94, 0xd8,
-	0x91, 0x7d, 0x49, 0xe1, 0x2d, 0x78, 0x04, 0x1e, 0x87, 0xb1, 0x23, 0x23, 0x6a, 0x5f, 0x04, 0xc5,
-	0x36, 0x28, 0x8c, 0x8c, 0xdf, 0x7f, 0xbe, 0xff, 0xfe, 0xf3, 0x41, 0x1f, 0xab, 0x5c, 0x99, 0xa4,
-	0x42, 0x43, 0x99, 0x9c, 0x27, 0xd5, 0x28, 0xc1, 0x0a, 0x25, 0x99, 0xb8, 0xd0, 0x8a, 0x54, 0x78,
-	0x68, 0xcb, 0xb1, 0x2f, 0xc7, 0xd5, 0x68, 0xf8, 0x1e, 0xc0, 0xc9, 0x65, 0xfd, 0x64, 0xa2, 0x91,
-	0x13, 0x4e, 0x9e, 0xf9, 0x2a, 0xe5, 0x62, 0x79, 0xef, 0x5e, 0x9c, 0x0b, 0xa1, 0x4a, 0x49, 0xe1,
-	0x31, 0x74, 0x0d, 0xca, 0x19, 0x6a, 0x16, 0x0c, 0x82, 0xd3, 0xde, 0x8d, 0xa7, 0xf0, 0x08, 0x3a,
-	0x42, 0x65, 0xd2, 0xb0, 0xb6, 0x95, 0x1d, 0x84, 0x7d, 0x00, 0x43, 0x5c, 0xd3, 0x94, 0xb2, 0x1c,
-	0xd9, 0x8e, 0x2d, 0xf5, 0xac, 0x72, 0x9b, 0xe5, 0x58, 0x37, 0xe5, 0xa8, 0xe7, 0xc8, 0x76, 0x5d,
-	0x93, 0x85, 0x90, 0xc1, 0x1e, 0x77, 0xd3, 0x58, 0xc7, 0xea, 0x3f, 0x38, 0x14, 0x70, 0xe0, 0x12,
-	0xfa, 0x6c, 0x75, 0x9a, 0xc7, 0xb2, 0x99, 0xc6, 0x51, 0xd3, 0xa2, 0xfd, 0xc7, 0x22, 0x1c, 0xc0,
-	0xfe, 0xcc, 0x6e, 0xc4, 0x29, 0x53, 0xd2, 0x47, 0x6a, 0x4a, 0xc3, 0x25, 0x30, 0x3b, 0xe4, 0xae,
-	0x98, 0x71, 0x42, 0xbf, 0xfe, 0x95, 0xf3, 0xfd, 0xff, 0xbc, 0x3e, 0x80, 0xc4, 0xd5, 0xd4, 0x77,
-	0xf9, 0x1f, 0x90, 0xb8, 0x72, 0x86, 0x17, 0xd7, 0x1f, 0x9b, 0x28, 0x58, 0x6f, 0xa2, 0xe0, 0x6b,
-	0x13, 0x05, 0x6f, 0xdb, 0xa8, 0xb5, 0xde, 0x46, 0xad, 0xcf, 0x6d, 0xd4, 0x7a, 0x18, 0xcf, 0x33,
-	0x5a, 0x94, 0x69, 0x2c, 0x54, 0x9e, 0x2c, 0x90, 0xca, 0xb3, 0x42, 0xab, 0x27, 0x14, 0xe4, 0x60,
-	0x51, 0xa6, 0xf5, 0x49, 0x5f, 0x7e, 0xef, 0x4b, 0xaf, 0x05, 0x9a, 0xb4, 0x6b, 0x8f, 0x3b, 0xfe,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x91, 0xf4, 0xab, 0x1f, 0xfd, 0x01, 0x00, 0x00,
+	// 358 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xbb, 0x4e, 0xeb, 0x40,
+	0x10, 0x8d, 0x73, 0xf3, 0xb8, 0x99, 0xab, 0x2b, 0x21, 0x0b, 0x21, 0xa7, 0x88, 0x15, 0x5c, 0xd1,
+	0x60, 0x2b, 0xe2, 0x0b, 0x42, 0x04, 0x12, 0x2d, 0xaf, 0x82, 0x26, 0x72, 0xbc, 0x43, 0x62, 0x12,
+	0xef, 0x5a, 0xde, 0xb1, 0x43, 0xbe, 0x80, 0x96, 0x4f, 0xe0, 0x73, 0x28, 0x53, 0x52, 0xa2, 0xe4,
+	0x47, 0x90, 0x77, 0x17, 0x30, 0x0d, 0x12, 0x94, 0x67, 0xce, 0xce, 0x9c, 0x73, 0x66, 0x07, 0x7a,
+	0x58, 0x24, 0x42, 0x06, 0x05, 0x4a, 0x8a, 0xf9, 0x34, 0x28, 0x06, 0x01, 0x16, 0xc8, 0x49, 0xfa,
+	0x69, 0x26, 0x48, 0xd8, 0x3b, 0x8a, 0xf6, 0x0d, 0xed, 0x17, 0x03, 0xef, 0xc9, 0x82, 0xfd, 0x93,
+	0xf2, 0xc9, 0x28, 0xc3, 0x90, 0x70, 0xb4, 0x08, 0x97, 0x93, 0x30, 0x9a, 0x5f, 0xeb, 0x17, 0xc3,
+	0x28, 0x12, 0x39, 0x27, 0x7b, 0x0f, 0x5a, 0x12, 0x39, 0xc3, 0xcc, 0xb1, 0xfa, 0xd6, 0x41, 0xe7,
+	0xdc, 0x20, 0x7b, 0x17, 0x9a, 0x91, 0x88, 0xb9, 0x74, 0xea, 0xaa, 0xac, 0x81, 0xdd, 0x03, 0x90,
+	0x14, 0x66, 0x34, 0xa6, 0x38, 0x41, 0xe7, 0x8f, 0xa2, 0x3a, 0xaa, 0x72, 0x19, 0x27, 0x58, 0x36,
+	0x25, 0x98, 0x4d, 0xd1, 0x69, 0xe8, 0x26, 0x05, 0x6c, 0x07, 0xda, 0xa1, 0x56, 0x73, 0x9a, 0xaa,
+	0xfe, 0x0e, 0xbd, 0x15, 0xfc, 0xd7, 0x0e, 0x8d, 0xb7, 0xd2, 0xcd, 0x6d, 0x5e, 0x75, 0xa3, 0x51,
+	0x75, 0x44, 0xfd, 0xcb, 0x08, 0xbb, 0x0f, 0xff, 0x98, 0x4a, 0x14, 0x52, 0x2c, 0xb8, 0xb1, 0x54,
+	0x2d, 0x7d, 0x26, 0x69, 0x54, 0x92, 0x78, 0x73, 0x70, 0x94, 0xf4, 0x55, 0xca, 0x42, 0x42, 0xb3,
+	0x94, 0x53, 0xad, 0xf6, 0x73, 0x17, 0x3d, 0x00, 0x8e, 0xcb, 0xb1, 0xe9, 0x32, 0x7b, 0xe1, 0xb8,
+	0xd4, 0x03, 0xbd, 0x07, 0x0b, 0xba, 0x4a, 0x6d, 0x98, 0x20, 0x67, 0x46, 0xec, 0x22, 0x9a, 0x21,
+	0xcb, 0x17, 0xf8, 0x3b, 0xb9, 0xef, 0xbe, 0xa1, 0x0b, 0x7f, 0x91, 0x33, 0x4d, 0xea, 0xd0, 0x6d,
+	0xe4, 0xac, 0xa4, 0x8e, 0xcf, 0x9e, 0x37, 0xae, 0xb5, 0xde, 0xb8, 0xd6, 0xeb, 0xc6, 0xb5, 0x1e,
+	0xb7, 0x6e, 0x6d, 0xbd, 0x75, 0x6b, 0x2f, 0x5b, 0xb7, 0x76, 0x13, 0x4c, 0x63, 0x9a, 0xe5, 0x13,
+	0x3f, 0x12, 0x49, 0x30, 0x43, 0xca, 0x0f, 0xd3, 0x4c, 0xdc, 0x61, 0x44, 0x0a, 0x94, 0xe7, 0x76,
+	0xff, 0x71, 0x7b, 0xb4, 0x4a, 0x51, 0x4e, 0x5a, 0xea, 0xf0, 0x8e, 0xde, 0x06, 0x00, 0xfe, 0xdf,
+	0x59, 0x04, 0x99, 0x02, 0x00, 0x00,
 }
 
 func (m *EventCreateClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -343,6 +430,13 @@ func (m *EventClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Coins) > 0 {
+		i -= len(m.Coins)
+		copy(dAtA[i:], m.Coins)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Coins)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Destination) > 0 {
 		i -= len(m.Destination)
 		copy(dAtA[i:], m.Destination)
@@ -411,6 +505,57 @@ func (m *EventUpdateVestingFunder) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *EventAmendVestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAmendVestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAmendVestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EndTime) > 0 {
+		i -= len(m.EndTime)
+		copy(dAtA[i:], m.EndTime)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.EndTime)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StartTime) > 0 {
+		i -= len(m.StartTime)
+		copy(dAtA[i:], m.StartTime)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StartTime)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -469,6 +614,10 @@ func (m *EventClawback) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.Coins)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -493,6 +642,31 @@ func (m *EventUpdateVestingFunder) Size() (n int) {
 	return n
 }
 
+func (m *EventAmendVestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StartTime)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.EndTime)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -834,6 +1008,38 @@ func (m *EventClawback) Unmarshal(dAtA []byte) error {
 			}
 			m.Destination = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1001,6 +1207,184 @@ func (m *EventUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventAmendVestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAmendVestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAmendVestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
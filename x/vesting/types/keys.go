@@ -16,6 +16,11 @@
 
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module's name.
 	ModuleName = "vesting"
@@ -26,3 +31,26 @@ const (
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
 )
+
+// prefix bytes for the vesting persistent store
+const (
+	prefixFunderAccount = iota + 1
+)
+
+// KVStore key prefixes
+var (
+	// KeyPrefixFunderAccount indexes the clawback vesting accounts by funder
+	KeyPrefixFunderAccount = []byte{prefixFunderAccount}
+)
+
+// FunderPrefix returns the prefix of the index keys of the accounts funded by
+// the given funder.
+func FunderPrefix(funder sdk.AccAddress) []byte {
+	return append(KeyPrefixFunderAccount, address.MustLengthPrefix(funder)...)
+}
+
+// FunderAccountKey returns the index key of a clawback vesting account funded
+// by the given funder.
+func FunderAccountKey(funder, account sdk.AccAddress) []byte {
+	return append(FunderPrefix(funder), account...)
+}
@@ -30,6 +30,7 @@ var (
 	_ sdk.Msg = &MsgClawback{}
 	_ sdk.Msg = &MsgConvertVestingAccount{}
 	_ sdk.Msg = &MsgUpdateVestingFunder{}
+	_ sdk.Msg = &MsgAmendVestingSchedule{}
 )
 
 const (
@@ -37,6 +38,7 @@ const (
 	TypeMsgClawback                     = "clawback"
 	TypeMsgUpdateVestingFunder          = "update_vesting_funder"
 	TypeMsgConvertVestingAccount        = "convert_vesting_account"
+	TypeMsgAmendVestingSchedule         = "amend_vesting_schedule"
 )
 
 // NewMsgCreateClawbackVestingAccount creates new instance of MsgCreateClawbackVestingAccount
@@ -159,6 +161,16 @@ func (msg MsgClawback) ValidateBasic() error {
 		}
 	}
 
+	// An empty amount claws back all the unvested coins
+	if len(msg.Amount) > 0 {
+		if !msg.Amount.IsValid() {
+			return errortypes.ErrInvalidCoins.Wrap(msg.Amount.String())
+		}
+		if !msg.Amount.IsAllPositive() {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid clawback amount, amounts must be positive")
+		}
+	}
+
 	return nil
 }
 
@@ -252,3 +264,73 @@ func (msg MsgConvertVestingAccount) GetSigners() []sdk.AccAddress {
 	vesting := sdk.MustAccAddressFromBech32(msg.VestingAddress)
 	return []sdk.AccAddress{vesting}
 }
+
+// NewMsgAmendVestingSchedule creates new instance of MsgAmendVestingSchedule
+func NewMsgAmendVestingSchedule(
+	funder, vestingAcc sdk.AccAddress,
+	startTime time.Time,
+	lockupPeriods,
+	vestingPeriods sdkvesting.Periods,
+) *MsgAmendVestingSchedule {
+	return &MsgAmendVestingSchedule{
+		FunderAddress:  funder.String(),
+		VestingAddress: vestingAcc.String(),
+		StartTime:      startTime,
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+}
+
+// Route returns the message route for a MsgAmendVestingSchedule.
+func (msg MsgAmendVestingSchedule) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgAmendVestingSchedule.
+func (msg MsgAmendVestingSchedule) Type() string { return TypeMsgAmendVestingSchedule }
+
+// ValidateBasic runs stateless checks on the MsgAmendVestingSchedule message
+func (msg MsgAmendVestingSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.GetFunderAddress()); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.GetVestingAddress()); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting account address")
+	}
+
+	if len(msg.LockupPeriods) == 0 && len(msg.VestingPeriods) == 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting and/or lockup schedules must be present")
+	}
+
+	if err := validatePeriods(msg.LockupPeriods, "lockup"); err != nil {
+		return err
+	}
+
+	return validatePeriods(msg.VestingPeriods, "vesting")
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgAmendVestingSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAmendVestingSchedule) GetSigners() []sdk.AccAddress {
+	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	return []sdk.AccAddress{funder}
+}
+
+// validatePeriods checks that every period has a positive length and amount
+func validatePeriods(periods sdkvesting.Periods, schedule string) error {
+	for i, period := range periods {
+		if period.Length < 1 {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+		}
+		if !period.Amount.IsValid() {
+			return errortypes.ErrInvalidCoins.Wrap(period.Amount.String())
+		}
+		if !period.Amount.IsAllPositive() {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid amount in %s periods, amounts must be positive", schedule)
+		}
+	}
+	return nil
+}
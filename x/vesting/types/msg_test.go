@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/stretchr/testify/suite"
@@ -232,6 +233,7 @@ func (suite *MsgsTestSuite) TestMsgClawback() {
 		funder     string
 		addr       string
 		dest       string
+		amount     sdk.Coins
 		expectPass bool
 	}{
 		{
@@ -239,6 +241,7 @@ func (suite *MsgsTestSuite) TestMsgClawback() {
 			"foo",
 			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
 			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			nil,
 			false,
 		},
 		{
@@ -246,6 +249,7 @@ func (suite *MsgsTestSuite) TestMsgClawback() {
 			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
 			"foo",
 			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			nil,
 			false,
 		},
 		{
@@ -253,6 +257,7 @@ func (suite *MsgsTestSuite) TestMsgClawback() {
 			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
 			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
 			"foo",
+			nil,
 			false,
 		},
 		{
@@ -260,13 +265,31 @@ func (suite *MsgsTestSuite) TestMsgClawback() {
 			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
 			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
 			"",
+			nil,
 			true,
 		},
+		{
+			"msg create clawback vesting account - invalid amount",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			"",
+			sdk.Coins{{Denom: "test", Amount: math.NewInt(-1)}},
+			false,
+		},
 		{
 			"msg create clawback vesting account - pass",
 			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
 			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
 			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			nil,
+			true,
+		},
+		{
+			"msg create clawback vesting account - pass with amount",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			"",
+			sdk.NewCoins(sdk.NewInt64Coin("test", 100)),
 			true,
 		},
 	}
@@ -276,6 +299,7 @@ func (suite *MsgsTestSuite) TestMsgClawback() {
 			tc.funder,
 			tc.addr,
 			tc.dest,
+			tc.amount,
 		}
 		err := tx.ValidateBasic()
 
@@ -410,3 +434,97 @@ func (suite *MsgsTestSuite) TestMsgConvertVestingAccount() {
 		}
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgAmendVestingScheduleGetters() {
+	msgInvalid := types.MsgAmendVestingSchedule{}
+	msg := types.NewMsgAmendVestingSchedule(
+		sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+		sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+		time.Unix(100200300, 0),
+		sdkvesting.Periods{{Length: 200000, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 10000000))}},
+		nil,
+	)
+	suite.Require().Equal(types.RouterKey, msg.Route())
+	suite.Require().Equal(types.TypeMsgAmendVestingSchedule, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgAmendVestingSchedule() {
+	periods := sdkvesting.Periods{{Length: 200000, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 10000000))}}
+
+	testCases := []struct {
+		name       string
+		funder     string
+		vesting    string
+		lockup     sdkvesting.Periods
+		vestingP   sdkvesting.Periods
+		expectPass bool
+	}{
+		{
+			"fail - invalid funder address",
+			"foo",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			periods,
+			nil,
+			false,
+		},
+		{
+			"fail - invalid vesting address",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			"foo",
+			periods,
+			nil,
+			false,
+		},
+		{
+			"fail - no periods",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - invalid period length",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			nil,
+			sdkvesting.Periods{{Length: 0, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 10000000))}},
+			false,
+		},
+		{
+			"fail - invalid period amount",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			sdkvesting.Periods{{Length: 200000, Amount: sdk.Coins{}}},
+			nil,
+			false,
+		},
+		{
+			"pass - lockup and vesting periods",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+			periods,
+			periods,
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := types.MsgAmendVestingSchedule{
+			FunderAddress:  tc.funder,
+			VestingAddress: tc.vesting,
+			StartTime:      time.Unix(100200300, 0),
+			LockupPeriods:  tc.lockup,
+			VestingPeriods: tc.vestingP,
+		}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.name)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.name)
+		}
+	}
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	github_com_cosmos_cosmos_sdk_x_auth_vesting_types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -185,6 +187,9 @@ type MsgClawback struct {
 	// to. If empty, the tokens will be transferred back to the original funder of
 	// the account.
 	DestAddress string `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
+	// amount defines the unvested coins to claw back. If empty, all the unvested
+	// coins are clawed back.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
 func (m *MsgClawback) Reset()         { *m = MsgClawback{} }
@@ -241,6 +246,13 @@ func (m *MsgClawback) GetDestAddress() string {
 	return ""
 }
 
+func (m *MsgClawback) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // MsgClawbackResponse defines the MsgClawback response type.
 type MsgClawbackResponse struct {
 }
@@ -464,6 +476,130 @@ func (m *MsgConvertVestingAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertVestingAccountResponse proto.InternalMessageInfo
 
+// MsgAmendVestingSchedule defines a message that replaces the future lockup
+// and/or vesting periods of a ClawbackVestingAccount.
+type MsgAmendVestingSchedule struct {
+	// funder_address is the current funder address of the ClawbackVestingAccount
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// vesting_address is the address of the ClawbackVestingAccount being amended
+	VestingAddress string `protobuf:"bytes,2,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// start_time defines the time the new lockup and vesting periods are
+	// relative to
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// lockup_periods defines the new unlocking schedule for the coins that are
+	// still locked. If empty, the current lockup schedule is kept.
+	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
+	// vesting_periods defines the new vesting schedule for the coins that are
+	// still unvested. If empty, the current vesting schedule is kept.
+	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,5,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+}
+
+func (m *MsgAmendVestingSchedule) Reset()         { *m = MsgAmendVestingSchedule{} }
+func (m *MsgAmendVestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendVestingSchedule) ProtoMessage()    {}
+func (*MsgAmendVestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5db113bc0c7240c, []int{8}
+}
+func (m *MsgAmendVestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendVestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendVestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAmendVestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendVestingSchedule.Merge(m, src)
+}
+func (m *MsgAmendVestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendVestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendVestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAmendVestingSchedule proto.InternalMessageInfo
+
+func (m *MsgAmendVestingSchedule) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgAmendVestingSchedule) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+func (m *MsgAmendVestingSchedule) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgAmendVestingSchedule) GetLockupPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.LockupPeriods
+	}
+	return nil
+}
+
+func (m *MsgAmendVestingSchedule) GetVestingPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+// MsgAmendVestingScheduleResponse defines the MsgAmendVestingSchedule response
+// type.
+type MsgAmendVestingScheduleResponse struct {
+}
+
+func (m *MsgAmendVestingScheduleResponse) Reset()         { *m = MsgAmendVestingScheduleResponse{} }
+func (m *MsgAmendVestingScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendVestingScheduleResponse) ProtoMessage()    {}
+func (*MsgAmendVestingScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5db113bc0c7240c, []int{9}
+}
+func (m *MsgAmendVestingScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendVestingScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendVestingScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAmendVestingScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendVestingScheduleResponse.Merge(m, src)
+}
+func (m *MsgAmendVestingScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendVestingScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendVestingScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAmendVestingScheduleResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateClawbackVestingAccount)(nil), "evmos.vesting.v1.MsgCreateClawbackVestingAccount")
 	proto.RegisterType((*MsgCreateClawbackVestingAccountResponse)(nil), "evmos.vesting.v1.MsgCreateClawbackVestingAccountResponse")
@@ -473,61 +609,71 @@ func init() {
 	proto.RegisterType((*MsgUpdateVestingFunderResponse)(nil), "evmos.vesting.v1.MsgUpdateVestingFunderResponse")
 	proto.RegisterType((*MsgConvertVestingAccount)(nil), "evmos.vesting.v1.MsgConvertVestingAccount")
 	proto.RegisterType((*MsgConvertVestingAccountResponse)(nil), "evmos.vesting.v1.MsgConvertVestingAccountResponse")
+	proto.RegisterType((*MsgAmendVestingSchedule)(nil), "evmos.vesting.v1.MsgAmendVestingSchedule")
+	proto.RegisterType((*MsgAmendVestingScheduleResponse)(nil), "evmos.vesting.v1.MsgAmendVestingScheduleResponse")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v1/tx.proto", fileDescriptor_d5db113bc0c7240c) }
 
 var fileDescriptor_d5db113bc0c7240c = []byte{
-	// 769 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcf, 0x4b, 0x1b, 0x4d,
-	0x18, 0xc7, 0x33, 0xe6, 0x8d, 0xe8, 0x44, 0xa3, 0xac, 0xfa, 0xbe, 0x79, 0x17, 0xdd, 0xc4, 0x50,
-	0x31, 0x0d, 0xba, 0xdb, 0x44, 0x29, 0x28, 0xbd, 0xa8, 0xe0, 0x2d, 0x50, 0x42, 0x5b, 0x68, 0x2f,
-	0x61, 0xb3, 0x19, 0x37, 0xa9, 0xee, 0xce, 0xb2, 0x33, 0x9b, 0xd8, 0x5b, 0xf1, 0x54, 0x7a, 0x12,
-	0x4a, 0xef, 0xbd, 0xf4, 0x62, 0x29, 0xf8, 0x67, 0x48, 0x4f, 0x42, 0x2f, 0x3d, 0xd5, 0xa2, 0x05,
-	0x7b, 0xe9, 0xff, 0x50, 0x76, 0x66, 0x76, 0xd5, 0x65, 0xaa, 0xf6, 0xd2, 0x93, 0x3b, 0xcf, 0xf3,
-	0x9d, 0xe7, 0xf9, 0x3c, 0x3f, 0x9c, 0xc0, 0xff, 0x51, 0xcf, 0xc1, 0xc4, 0xe8, 0x21, 0x42, 0xbb,
-	0xae, 0x6d, 0xf4, 0xaa, 0x06, 0xdd, 0xd5, 0x3d, 0x1f, 0x53, 0xac, 0x8c, 0x33, 0x97, 0x2e, 0x5c,
-	0x7a, 0xaf, 0xaa, 0xfe, 0x67, 0x61, 0x12, 0xaa, 0x1d, 0xc2, 0x94, 0x0e, 0xb1, 0xb9, 0x54, 0xbd,
-	0x23, 0x1c, 0x17, 0x61, 0x5a, 0x88, 0x9a, 0xd5, 0xe8, 0x2c, 0x54, 0x93, 0x36, 0xb6, 0x31, 0xfb,
-	0x34, 0xc2, 0x2f, 0x61, 0x9d, 0xb6, 0x31, 0xb6, 0x77, 0x90, 0x61, 0x7a, 0x5d, 0xc3, 0x74, 0x5d,
-	0x4c, 0x4d, 0xda, 0xc5, 0x2e, 0x11, 0xde, 0x82, 0xf0, 0xb2, 0x53, 0x2b, 0xd8, 0x32, 0x68, 0xd7,
-	0x41, 0x84, 0x9a, 0x8e, 0xc7, 0x05, 0xa5, 0x9f, 0x69, 0x58, 0xa8, 0x13, 0x7b, 0xc3, 0x47, 0x26,
-	0x45, 0x1b, 0x3b, 0x66, 0xbf, 0x65, 0x5a, 0xdb, 0x4f, 0x78, 0xde, 0x35, 0xcb, 0xc2, 0x81, 0x4b,
-	0x95, 0x59, 0x38, 0xb2, 0xe5, 0x63, 0xa7, 0x69, 0xb6, 0xdb, 0x3e, 0x22, 0x24, 0x0f, 0x8a, 0xa0,
-	0x3c, 0xdc, 0xc8, 0x86, 0xb6, 0x35, 0x6e, 0x52, 0x66, 0x20, 0xa4, 0x38, 0x16, 0x0c, 0x30, 0xc1,
-	0x30, 0xc5, 0x91, 0x7b, 0x03, 0x42, 0x42, 0x4d, 0x9f, 0x36, 0xc3, 0xf4, 0xf9, 0x74, 0x11, 0x94,
-	0xb3, 0x35, 0x55, 0xe7, 0x6c, 0x7a, 0xc4, 0xa6, 0x3f, 0x8a, 0xd8, 0xd6, 0x87, 0x8e, 0xbe, 0x16,
-	0x52, 0xfb, 0x27, 0x05, 0xd0, 0x18, 0x66, 0xf7, 0x42, 0x8f, 0xf2, 0x0a, 0xc0, 0xdc, 0x0e, 0xb6,
-	0xb6, 0x03, 0xaf, 0xe9, 0x21, 0xbf, 0x8b, 0xdb, 0x24, 0xff, 0x4f, 0x31, 0x5d, 0xce, 0xd6, 0x34,
-	0x9d, 0xf7, 0xef, 0x52, 0xaf, 0x59, 0xff, 0xf4, 0x87, 0x4c, 0xb6, 0xbe, 0x16, 0x46, 0x3b, 0x38,
-	0x29, 0xac, 0xd8, 0x5d, 0xda, 0x09, 0x5a, 0xba, 0x85, 0x1d, 0x43, 0x74, 0x9c, 0xff, 0x59, 0x24,
-	0xed, 0x6d, 0x63, 0xd7, 0x30, 0x03, 0xda, 0x89, 0x67, 0x40, 0x5f, 0x78, 0x88, 0x88, 0x08, 0xa4,
-	0x31, 0xca, 0x13, 0x8b, 0xa3, 0xf2, 0x1a, 0xc0, 0x31, 0x21, 0x8c, 0x59, 0x32, 0x7f, 0x8b, 0x25,
-	0x27, 0xcc, 0x11, 0xcc, 0x24, 0xcc, 0x38, 0xc8, 0xb7, 0x51, 0x7e, 0xb0, 0x08, 0xca, 0x43, 0x0d,
-	0x7e, 0x58, 0x9d, 0xfa, 0xf1, 0xae, 0x90, 0xda, 0x3b, 0x3f, 0xac, 0x5c, 0x99, 0x5d, 0xe9, 0x2e,
-	0x9c, 0xbf, 0x61, 0xdc, 0x0d, 0x44, 0x3c, 0xec, 0x12, 0x54, 0x7a, 0x0b, 0x60, 0x36, 0xd4, 0x0a,
-	0x95, 0x32, 0x07, 0x73, 0x5b, 0x81, 0xdb, 0x46, 0x7e, 0x62, 0x11, 0x46, 0xb9, 0x35, 0x9a, 0xf5,
-	0x3c, 0x1c, 0x33, 0x79, 0xa4, 0xc4, 0x3e, 0xe4, 0x84, 0x39, 0x12, 0xce, 0xc2, 0x91, 0x36, 0x22,
-	0x17, 0xaa, 0x34, 0x5f, 0xab, 0xd0, 0x26, 0x24, 0xab, 0x13, 0x61, 0x01, 0x89, 0xac, 0xa5, 0x29,
-	0x38, 0x71, 0x09, 0x2b, 0xc6, 0xfd, 0x00, 0xe0, 0xbf, 0x75, 0x62, 0x3f, 0xf6, 0xda, 0x26, 0x45,
-	0xa2, 0xa4, 0x4d, 0x76, 0xf3, 0xb6, 0xe4, 0x0b, 0x50, 0x71, 0x51, 0xbf, 0x99, 0x90, 0x72, 0xf8,
-	0x71, 0x17, 0xf5, 0x37, 0x93, 0x75, 0x46, 0x2b, 0x70, 0xb5, 0x82, 0x68, 0x3e, 0xd7, 0x16, 0x51,
-	0x84, 0x9a, 0x1c, 0x36, 0xae, 0xe7, 0x29, 0xcc, 0x87, 0x65, 0x62, 0xb7, 0x87, 0x7c, 0x9a, 0xf8,
-	0x8f, 0x94, 0xe4, 0x06, 0xd2, 0xdc, 0x93, 0x61, 0xee, 0xa4, 0xb6, 0x54, 0x82, 0xc5, 0xdf, 0x85,
-	0x8e, 0xd2, 0xd7, 0x0e, 0x32, 0x30, 0x5d, 0x27, 0xb6, 0xf2, 0x09, 0xc0, 0xe9, 0x6b, 0x5f, 0x87,
-	0xaa, 0x9e, 0x7c, 0xe8, 0xf4, 0x1b, 0x36, 0x4c, 0x5d, 0xf9, 0xe3, 0x2b, 0x71, 0x57, 0x1e, 0xec,
-	0x7d, 0xfe, 0xfe, 0x66, 0xe0, 0xbe, 0xb2, 0x6c, 0x48, 0x5e, 0x5e, 0xc3, 0x62, 0x21, 0x9a, 0x96,
-	0x88, 0xd1, 0x8c, 0x0b, 0x17, 0xac, 0x7d, 0x38, 0x14, 0xaf, 0xf3, 0x8c, 0x1c, 0x42, 0xb8, 0xd5,
-	0xb9, 0x6b, 0xdd, 0x31, 0xcf, 0x1c, 0xe3, 0x29, 0x28, 0x33, 0x72, 0x9e, 0x28, 0xd9, 0x7b, 0x00,
-	0x27, 0x64, 0x9b, 0x59, 0x96, 0x66, 0x91, 0x28, 0xd5, 0x7b, 0xb7, 0x55, 0xc6, 0x68, 0x35, 0x86,
-	0xb6, 0xa0, 0x54, 0xa4, 0x68, 0x01, 0xbb, 0x19, 0x77, 0x88, 0xaf, 0xa7, 0xf2, 0x11, 0xc0, 0x29,
-	0xf9, 0xca, 0x55, 0xe4, 0xfd, 0x90, 0x69, 0xd5, 0xda, 0xed, 0xb5, 0x31, 0xed, 0x32, 0xa3, 0xd5,
-	0x95, 0x05, 0x79, 0x23, 0xf9, 0xdd, 0xe4, 0x40, 0xd5, 0xcc, 0xcb, 0xf3, 0xc3, 0x0a, 0x58, 0xaf,
-	0x1f, 0x9d, 0x6a, 0xe0, 0xf8, 0x54, 0x03, 0xdf, 0x4e, 0x35, 0xb0, 0x7f, 0xa6, 0xa5, 0x8e, 0xcf,
-	0xb4, 0xd4, 0x97, 0x33, 0x2d, 0xf5, 0x6c, 0xe9, 0xd2, 0x3b, 0xdb, 0x41, 0x34, 0x58, 0xf4, 0x7c,
-	0xfc, 0x1c, 0x59, 0x94, 0x1f, 0x3a, 0x41, 0x2b, 0x4c, 0xb0, 0x7b, 0xf5, 0xa5, 0x6d, 0x0d, 0xb2,
-	0x9f, 0xa4, 0xa5, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbe, 0xb5, 0x47, 0xbb, 0xde, 0x07, 0x00,
-	0x00,
+	// 904 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0xdf, 0xc9, 0x92, 0x28, 0x99, 0xb4, 0x69, 0xe5, 0x24, 0x64, 0x6b, 0x35, 0xf6, 0x66, 0x45,
+	0xd4, 0x6d, 0x48, 0xec, 0xee, 0xb6, 0x42, 0x6a, 0xc4, 0x25, 0x89, 0x54, 0x89, 0x43, 0x24, 0xb4,
+	0xfc, 0x91, 0xe0, 0xb2, 0x9a, 0xb5, 0x27, 0x8e, 0xc9, 0xda, 0x63, 0x79, 0xc6, 0xbb, 0xe1, 0x86,
+	0x7a, 0x42, 0x9c, 0x2a, 0xf1, 0x05, 0xb8, 0x70, 0x00, 0x84, 0xd4, 0x8f, 0x51, 0x71, 0xaa, 0x84,
+	0x84, 0x38, 0x11, 0x94, 0x20, 0x95, 0x0b, 0x5f, 0x80, 0x13, 0x9a, 0x3f, 0x9e, 0x26, 0x66, 0x9a,
+	0x6e, 0x0f, 0x70, 0xea, 0x29, 0x3b, 0xef, 0xfd, 0xde, 0x7b, 0xbf, 0xf9, 0xbd, 0x37, 0xcf, 0x81,
+	0x37, 0xf0, 0x28, 0x21, 0xd4, 0x1f, 0x61, 0xca, 0xe2, 0x34, 0xf2, 0x47, 0x1d, 0x9f, 0x1d, 0x7b,
+	0x59, 0x4e, 0x18, 0xb1, 0xae, 0x0b, 0x97, 0xa7, 0x5c, 0xde, 0xa8, 0x63, 0x3b, 0x01, 0xa1, 0x1c,
+	0x3d, 0x40, 0x14, 0xfb, 0xa3, 0xce, 0x00, 0x33, 0xd4, 0xf1, 0x03, 0x12, 0xa7, 0x32, 0xc2, 0x5e,
+	0x51, 0xfe, 0x84, 0x8a, 0x4c, 0x09, 0x8d, 0x94, 0xe3, 0x2d, 0xe5, 0x78, 0x5e, 0x46, 0xc6, 0x96,
+	0xb9, 0x25, 0x6a, 0x29, 0x22, 0x11, 0x11, 0x3f, 0x7d, 0xfe, 0x4b, 0x59, 0x6f, 0x46, 0x84, 0x44,
+	0x43, 0xec, 0xa3, 0x2c, 0xf6, 0x51, 0x9a, 0x12, 0x86, 0x58, 0x4c, 0x52, 0xaa, 0xbc, 0xae, 0xf2,
+	0x8a, 0xd3, 0xa0, 0x38, 0xf0, 0x59, 0x9c, 0x60, 0xca, 0x50, 0x92, 0x49, 0x40, 0xeb, 0xaf, 0x3a,
+	0x74, 0xf7, 0x69, 0xb4, 0x97, 0x63, 0xc4, 0xf0, 0xde, 0x10, 0x8d, 0x07, 0x28, 0x38, 0xfa, 0x58,
+	0xd6, 0xdd, 0x09, 0x02, 0x52, 0xa4, 0xcc, 0x5a, 0x83, 0x57, 0x0e, 0x72, 0x92, 0xf4, 0x51, 0x18,
+	0xe6, 0x98, 0xd2, 0x06, 0x68, 0x82, 0xf6, 0x5c, 0x6f, 0x9e, 0xdb, 0x76, 0xa4, 0xc9, 0x5a, 0x85,
+	0x90, 0x11, 0x0d, 0x98, 0x12, 0x80, 0x39, 0x46, 0x4a, 0xf7, 0x1e, 0x84, 0x94, 0xa1, 0x9c, 0xf5,
+	0x79, 0xf9, 0x46, 0xbd, 0x09, 0xda, 0xf3, 0x5d, 0xdb, 0x93, 0xdc, 0xbc, 0x92, 0x9b, 0xf7, 0x61,
+	0xc9, 0x6d, 0x77, 0xf6, 0xc9, 0x6f, 0x6e, 0xed, 0xd1, 0x89, 0x0b, 0x7a, 0x73, 0x22, 0x8e, 0x7b,
+	0xac, 0x2f, 0x01, 0x5c, 0x18, 0x92, 0xe0, 0xa8, 0xc8, 0xfa, 0x19, 0xce, 0x63, 0x12, 0xd2, 0xc6,
+	0x1b, 0xcd, 0x7a, 0x7b, 0xbe, 0xeb, 0x78, 0x52, 0xbf, 0x73, 0xbd, 0x10, 0xfa, 0x79, 0xef, 0x0b,
+	0xd8, 0xee, 0x0e, 0xcf, 0xf6, 0xfd, 0x89, 0x7b, 0x3f, 0x8a, 0xd9, 0x61, 0x31, 0xf0, 0x02, 0x92,
+	0xf8, 0x4a, 0x71, 0xf9, 0x67, 0x8b, 0x86, 0x47, 0xfe, 0xb1, 0x8f, 0x0a, 0x76, 0xa8, 0x7b, 0xc0,
+	0x3e, 0xcf, 0x30, 0x55, 0x19, 0x68, 0xef, 0xaa, 0x2c, 0xac, 0x8e, 0xd6, 0x57, 0x00, 0x5e, 0x53,
+	0x40, 0xcd, 0x65, 0xfa, 0xff, 0xe2, 0xb2, 0xa0, 0xcc, 0x25, 0x99, 0x25, 0x38, 0x9d, 0xe0, 0x3c,
+	0xc2, 0x8d, 0x99, 0x26, 0x68, 0xcf, 0xf6, 0xe4, 0x61, 0x7b, 0xf9, 0xcf, 0x6f, 0xdc, 0xda, 0xc3,
+	0x67, 0x8f, 0x37, 0x2e, 0xf4, 0xae, 0x75, 0x1b, 0xde, 0x7a, 0x49, 0xbb, 0x7b, 0x98, 0x66, 0x24,
+	0xa5, 0xb8, 0xf5, 0x37, 0x80, 0xf3, 0x1c, 0xab, 0x50, 0xd6, 0x3a, 0x5c, 0x38, 0x28, 0xd2, 0x10,
+	0xe7, 0x95, 0x41, 0xb8, 0x2a, 0xad, 0x65, 0xaf, 0x6f, 0xc1, 0x6b, 0x48, 0x66, 0xaa, 0xcc, 0xc3,
+	0x82, 0x32, 0x97, 0xc0, 0x35, 0x78, 0x25, 0xc4, 0xf4, 0x39, 0xaa, 0x2e, 0xc7, 0x8a, 0xdb, 0x4a,
+	0x48, 0x00, 0x67, 0x50, 0xc2, 0x63, 0x54, 0xa7, 0x6f, 0x94, 0xea, 0xf2, 0x27, 0xa6, 0xa5, 0xdd,
+	0x23, 0x71, 0xba, 0x7b, 0x47, 0x09, 0xdb, 0xbe, 0x54, 0x58, 0xa9, 0x24, 0x0f, 0xa0, 0x3d, 0x95,
+	0x7a, 0x7b, 0x91, 0xab, 0x54, 0xb9, 0x5a, 0x6b, 0x19, 0x2e, 0x9e, 0xbb, 0xbb, 0xd6, 0xe4, 0x07,
+	0x00, 0xdf, 0xdc, 0xa7, 0xd1, 0x47, 0x59, 0x88, 0x18, 0x56, 0xba, 0x3d, 0x10, 0x91, 0x93, 0xca,
+	0xb3, 0x09, 0xad, 0x14, 0x8f, 0xfb, 0x15, 0xa8, 0x54, 0xe8, 0x7a, 0x8a, 0xc7, 0x0f, 0xaa, 0x62,
+	0x96, 0x73, 0x76, 0x51, 0xa6, 0x72, 0x08, 0x14, 0xd0, 0x7c, 0x89, 0x26, 0x74, 0xcc, 0x64, 0xf5,
+	0x7d, 0x3e, 0x81, 0x0d, 0x7e, 0x4d, 0x92, 0x8e, 0x70, 0xce, 0x2a, 0xcf, 0xde, 0x50, 0x1b, 0x18,
+	0x6b, 0x2f, 0xf1, 0xda, 0x55, 0x6c, 0xab, 0x05, 0x9b, 0x2f, 0x4a, 0xad, 0xcb, 0x9f, 0xd4, 0xe1,
+	0xca, 0x3e, 0x8d, 0x76, 0x12, 0x9c, 0x86, 0x0a, 0xf2, 0x41, 0x70, 0x88, 0xc3, 0x62, 0x88, 0x5f,
+	0x61, 0xdc, 0xaa, 0x2c, 0xa7, 0x4c, 0x2c, 0x5f, 0xef, 0xa0, 0xff, 0x70, 0x07, 0x6d, 0xaf, 0x94,
+	0xdb, 0xa6, 0x3a, 0x82, 0x6b, 0xd0, 0x7d, 0x41, 0x83, 0xcb, 0x21, 0xe8, 0xfe, 0x32, 0x03, 0xeb,
+	0xfb, 0x34, 0xb2, 0x7e, 0x02, 0xf0, 0xe6, 0xa5, 0xdf, 0xa1, 0x8e, 0x57, 0xfd, 0xe4, 0x7a, 0x2f,
+	0xd9, 0x65, 0xf6, 0xfd, 0x57, 0x0e, 0xd1, 0xb3, 0xf9, 0xee, 0xc3, 0x9f, 0xff, 0xf8, 0x7a, 0xea,
+	0x1d, 0xeb, 0x9e, 0x6f, 0xf8, 0x1f, 0xc0, 0x0f, 0x44, 0x8a, 0x7e, 0xa0, 0x72, 0xf4, 0xf5, 0x0c,
+	0x2a, 0xae, 0x63, 0x38, 0xab, 0x17, 0xe7, 0xaa, 0x99, 0x84, 0x72, 0xdb, 0xeb, 0x97, 0xba, 0x35,
+	0x9f, 0x75, 0xc1, 0xc7, 0xb5, 0x56, 0xcd, 0x7c, 0xca, 0x62, 0xdf, 0x02, 0xb8, 0x68, 0x5a, 0x4f,
+	0x6d, 0x63, 0x15, 0x03, 0xd2, 0xbe, 0x33, 0x29, 0x52, 0x53, 0xeb, 0x0a, 0x6a, 0x9b, 0xd6, 0x86,
+	0x91, 0x5a, 0x21, 0x22, 0xb5, 0x42, 0x72, 0x40, 0xac, 0x1f, 0x01, 0x5c, 0x36, 0xef, 0x9d, 0x0d,
+	0xb3, 0x1e, 0x26, 0xac, 0xdd, 0x9d, 0x1c, 0xab, 0xd9, 0xde, 0x13, 0x6c, 0x3d, 0x6b, 0xd3, 0x2c,
+	0xa4, 0x8c, 0xfd, 0x57, 0x43, 0xbf, 0x03, 0x70, 0xc9, 0xb8, 0xa7, 0x6e, 0x1b, 0x29, 0x98, 0xa0,
+	0x76, 0x67, 0x62, 0xa8, 0x26, 0x7b, 0x57, 0x90, 0xdd, 0xb2, 0xde, 0x36, 0x92, 0x45, 0x3c, 0x54,
+	0x53, 0xa5, 0x65, 0x9d, 0xe9, 0x2f, 0x9e, 0x3d, 0xde, 0x00, 0xbb, 0xef, 0x3d, 0x39, 0x75, 0xc0,
+	0xd3, 0x53, 0x07, 0xfc, 0x7e, 0xea, 0x80, 0x47, 0x67, 0x4e, 0xed, 0xe9, 0x99, 0x53, 0xfb, 0xf5,
+	0xcc, 0xa9, 0x7d, 0xea, 0x9f, 0x7b, 0xf9, 0x87, 0x98, 0x15, 0x5b, 0x59, 0x4e, 0x3e, 0xc3, 0x01,
+	0x13, 0x07, 0x9e, 0xfb, 0xf8, 0xe2, 0xbb, 0x1f, 0xcc, 0x88, 0x05, 0x79, 0xf7, 0x9f, 0x01, 0x00,
+	0x21, 0xf7, 0xa9, 0xeb, 0x10, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -545,13 +691,17 @@ type MsgClient interface {
 	// CreateClawbackVestingAccount creats a vesting account that is subject to
 	// clawback and the configuration of vesting and lockup schedules.
 	CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error)
-	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
+	// Clawback removes the unvested tokens, or a part of them, from a
+	// ClawbackVestingAccount.
 	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
 	// UpdateVestingFunder updates the funder address of an existing
 	// ClawbackVestingAccount.
 	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to a Eth account
 	ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error)
+	// AmendVestingSchedule replaces the future lockup and/or vesting periods of
+	// an existing ClawbackVestingAccount without modifying the vested amount.
+	AmendVestingSchedule(ctx context.Context, in *MsgAmendVestingSchedule, opts ...grpc.CallOption) (*MsgAmendVestingScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -598,18 +748,31 @@ func (c *msgClient) ConvertVestingAccount(ctx context.Context, in *MsgConvertVes
 	return out, nil
 }
 
+func (c *msgClient) AmendVestingSchedule(ctx context.Context, in *MsgAmendVestingSchedule, opts ...grpc.CallOption) (*MsgAmendVestingScheduleResponse, error) {
+	out := new(MsgAmendVestingScheduleResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v1.Msg/AmendVestingSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateClawbackVestingAccount creats a vesting account that is subject to
 	// clawback and the configuration of vesting and lockup schedules.
 	CreateClawbackVestingAccount(context.Context, *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error)
-	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
+	// Clawback removes the unvested tokens, or a part of them, from a
+	// ClawbackVestingAccount.
 	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
 	// UpdateVestingFunder updates the funder address of an existing
 	// ClawbackVestingAccount.
 	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to a Eth account
 	ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error)
+	// AmendVestingSchedule replaces the future lockup and/or vesting periods of
+	// an existing ClawbackVestingAccount without modifying the vested amount.
+	AmendVestingSchedule(context.Context, *MsgAmendVestingSchedule) (*MsgAmendVestingScheduleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -628,6 +791,9 @@ func (*UnimplementedMsgServer) UpdateVestingFunder(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) ConvertVestingAccount(ctx context.Context, req *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertVestingAccount not implemented")
 }
+func (*UnimplementedMsgServer) AmendVestingSchedule(ctx context.Context, req *MsgAmendVestingSchedule) (*MsgAmendVestingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendVestingSchedule not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -705,6 +871,24 @@ func _Msg_ConvertVestingAccount_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AmendVestingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendVestingSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendVestingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v1.Msg/AmendVestingSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendVestingSchedule(ctx, req.(*MsgAmendVestingSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.vesting.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -725,6 +909,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConvertVestingAccount",
 			Handler:    _Msg_ConvertVestingAccount_Handler,
 		},
+		{
+			MethodName: "AmendVestingSchedule",
+			Handler:    _Msg_AmendVestingSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/vesting/v1/tx.proto",
@@ -856,6 +1044,20 @@ func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.DestAddress) > 0 {
 		i -= len(m.DestAddress)
 		copy(dAtA[i:], m.DestAddress)
@@ -1023,6 +1225,102 @@ func (m *MsgConvertVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAmendVestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendVestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendVestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendVestingScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendVestingScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendVestingScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1095,6 +1393,12 @@ func (m *MsgClawback) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1159,6 +1463,46 @@ func (m *MsgConvertVestingAccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAmendVestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAmendVestingScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1575,16 +1919,50 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 			}
 			m.DestAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			if (iNdEx + skippy) > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -1974,6 +2352,271 @@ func (m *MsgConvertVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgAmendVestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAmendVestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAmendVestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAmendVestingScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAmendVestingScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAmendVestingScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0